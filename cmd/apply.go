@@ -0,0 +1,662 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"spacectl/internal/api"
+	"spacectl/internal/manifest"
+	"spacectl/internal/models"
+	"spacectl/internal/names"
+	"spacectl/internal/output"
+	"spacectl/internal/parallel"
+
+	"github.com/spf13/cobra"
+)
+
+// applyCmd represents the apply command
+var applyCmd = &cobra.Command{
+	Use:   "apply -f <file|dir>",
+	Short: "Create or update the resources described by manifests",
+	Long: `Make organizations, projects, and tenants match the manifests in files, or in
+the .yaml, .yml, and .json files of a directory. Missing resources are created,
+and existing ones are changed where their fields differ from the manifests.
+Project members in the manifests are added or given the listed role; other
+members are left alone. With --prune, tenants of the projects in the manifests
+that no manifest describes are deleted.
+
+apply first prints a plan: the resources to create (+), change (~), and
+destroy (-), with the fields that change. Nothing happens until you confirm
+the plan, or pass --approve. With --plan, only the plan is printed.
+
+Cloud providers and regions of existing tenants cannot be changed; delete and
+recreate the tenant instead.
+
+Examples:
+  spacectl apply -f ./environments/staging --plan
+  spacectl apply -f ./environments/staging
+  spacectl apply -f tenant.yaml --values prod.yaml --approve
+  spacectl apply -f ./environments/staging --prune --plan -o json`,
+	Args: cobra.NoArgs,
+	RunE: runApply,
+}
+
+var (
+	applyFiles   []string
+	applyPlan    bool
+	applyApprove bool
+	applyPrune   bool
+)
+
+func init() {
+	rootCmd.AddCommand(applyCmd)
+
+	applyCmd.Flags().StringSliceVarP(&applyFiles, "filename", "f", nil, "Manifest files or directories to apply (use - for stdin)")
+	applyCmd.Flags().BoolVar(&applyPlan, "plan", false, "Print the plan without applying it")
+	applyCmd.Flags().BoolVar(&applyApprove, "approve", false, "Apply the plan without asking for confirmation")
+	applyCmd.Flags().BoolVar(&applyPrune, "prune", false, "Delete tenants of the projects in the manifests that no manifest describes")
+	addManifestValueFlags(applyCmd)
+	addConcurrencyFlag(applyCmd)
+	applyCmd.MarkFlagRequired("filename")
+}
+
+// planAction is what applying a step does to a resource
+type planAction string
+
+const (
+	planCreate planAction = "create"
+	planUpdate planAction = "update"
+	planDelete planAction = "delete"
+)
+
+// planChange is a field that applying a step sets or changes
+type planChange struct {
+	Field string      `json:"field" yaml:"field"`
+	Old   interface{} `json:"old,omitempty" yaml:"old,omitempty"`
+	New   interface{} `json:"new,omitempty" yaml:"new,omitempty"`
+}
+
+// planStep is a change to one resource
+type planStep struct {
+	Action  planAction   `json:"action" yaml:"action"`
+	Kind    resourceKind `json:"kind" yaml:"kind"`
+	Name    string       `json:"name" yaml:"name"`
+	Parent  string       `json:"parent,omitempty" yaml:"parent,omitempty"`
+	ID      string       `json:"id,omitempty" yaml:"id,omitempty"`
+	Changes []planChange `json:"changes,omitempty" yaml:"changes,omitempty"`
+
+	// parentID is the ID of an existing parent, or parentStep the step creating it
+	parentID   string
+	parentStep *planStep
+
+	project       *manifest.Project
+	tenantCreate  models.CreateTenantRequest
+	tenantUpdate  models.UpdateTenantRequest
+	projectUpdate models.PatchProjectRequest
+	addMembers    []manifest.Member
+	changeMembers []manifest.Member
+}
+
+func runApply(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	values, err := manifestValues()
+	if err != nil {
+		return err
+	}
+	var resources []interface{}
+	for _, path := range applyFiles {
+		decoded, err := manifest.ReadPath(path, values)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, decoded...)
+	}
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+
+	plan, err := planApply(ctx, client, resources)
+	if err != nil {
+		return err
+	}
+
+	// Structured formats get the plan as a list of steps
+	if output.Format(outputFmt) != output.FormatTable {
+		if plan == nil {
+			plan = []*planStep{}
+		}
+		if err := formatter.FormatData(plan); err != nil {
+			return err
+		}
+	} else {
+		annotator.StartSection("apply-plan", "Plan")
+		printPlan(plan)
+		annotator.EndSection("apply-plan")
+	}
+	if applyPlan || len(plan) == 0 {
+		return annotator.Summary("spacectl apply plan", applySummary(plan, -1, nil))
+	}
+
+	if ok, err := confirmTyped("\nDo you want to perform these actions?", "yes", "yes", applyApprove); err != nil || !ok {
+		return err
+	}
+
+	annotator.StartSection("apply", "Apply")
+	errs := make([]error, len(plan))
+	applied := 0
+	for start := 0; start < len(plan); {
+		end := batchEnd(plan, start)
+		batch := plan[start:end]
+		copy(errs[start:end], parallel.Map(batch, concurrency, func(step *planStep) error {
+			return applyStep(ctx, client, step)
+		}))
+
+		failed := 0
+		for i, step := range batch {
+			if err := errs[start+i]; err != nil {
+				failed++
+				fmt.Fprintf(os.Stderr, "Error: failed to %s %s %s: %v\n", step.Action, step.Kind, step.Name, err)
+				continue
+			}
+			applied++
+			if !quiet && output.Format(outputFmt) == output.FormatTable {
+				fmt.Printf("%s %s: %sd\n", step.Kind, step.Name, step.Action)
+			}
+		}
+		if failed > 0 {
+			annotator.EndSection("apply")
+			if summaryErr := annotator.Summary("spacectl apply", applySummary(plan, end, errs)); summaryErr != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", summaryErr)
+			}
+			return fmt.Errorf("failed to apply %d changes (%d of %d changes applied)", failed, applied, len(plan))
+		}
+		start = end
+	}
+	annotator.EndSection("apply")
+	if !quiet && output.Format(outputFmt) == output.FormatTable {
+		created, changed, destroyed := planCounts(plan)
+		fmt.Printf("\nApply complete! Resources: %d created, %d changed, %d destroyed.\n", created, changed, destroyed)
+	}
+	return annotator.Summary("spacectl apply", applySummary(plan, len(plan), errs))
+}
+
+// batchEnd returns the end of the batch of steps starting at start. Steps with
+// the same kind and action are applied --concurrency at a time; parents are
+// created in an earlier batch than their children
+func batchEnd(plan []*planStep, start int) int {
+	end := start
+	for end < len(plan) && plan[end].Kind == plan[start].Kind && plan[end].Action == plan[start].Action {
+		end++
+	}
+	return end
+}
+
+// planApply compares the manifests with the existing resources and returns the
+// steps to make them match: organizations, then projects, then tenants, then
+// pruned tenants
+func planApply(ctx context.Context, client *api.Client, resources []interface{}) ([]*planStep, error) {
+	var orgs []*manifest.Organization
+	var projects []*manifest.Project
+	var tenants []*manifest.Tenant
+	for _, r := range resources {
+		var name string
+		switch m := r.(type) {
+		case *manifest.Organization:
+			orgs, name = append(orgs, m), m.Metadata.Name
+		case *manifest.Project:
+			projects, name = append(projects, m), m.Metadata.Name
+		case *manifest.Tenant:
+			tenants, name = append(tenants, m), m.Metadata.Name
+		}
+		if name == "" {
+			return nil, fmt.Errorf("a manifest does not specify metadata.name")
+		}
+	}
+
+	var plan []*planStep
+	createdOrgs := make(map[string]*planStep)
+	for _, m := range orgs {
+		_, err := resolveOrganizationID(ctx, client, m.Metadata.Name, "")
+		if errors.Is(err, api.ErrNotFound) {
+			step := &planStep{Action: planCreate, Kind: kindOrganization, Name: m.Metadata.Name}
+			createdOrgs[m.Metadata.Name] = step
+			plan = append(plan, step)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	createdProjects := make(map[string]*planStep)
+	var managedProjects []*planStep // existing projects with a manifest, for --prune
+	for _, m := range projects {
+		step, err := planProject(ctx, client, m, createdOrgs)
+		if err != nil {
+			return nil, err
+		}
+		if step.Action == planCreate {
+			createdProjects[m.Metadata.Name] = step
+		} else {
+			managedProjects = append(managedProjects, step)
+		}
+		if step.Action == planCreate || len(step.Changes) > 0 {
+			plan = append(plan, step)
+		}
+	}
+
+	described := make(map[string]bool) // projectID/name of the tenants in the manifests
+	for _, m := range tenants {
+		step, err := planTenant(ctx, client, m, createdProjects)
+		if err != nil {
+			return nil, err
+		}
+		described[step.parentID+"/"+m.Metadata.Name] = true
+		if step.Action == planCreate || len(step.Changes) > 0 {
+			plan = append(plan, step)
+		}
+	}
+
+	if applyPrune {
+		tenantAPI := api.NewTenantAPI(client)
+		for _, project := range managedProjects {
+			existing, err := tenantAPI.ListProjectTenants(ctx, project.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list tenants in project %s: %w", project.Name, err)
+			}
+			for _, t := range existing {
+				if !described[project.ID+"/"+t.Name] {
+					plan = append(plan, &planStep{Action: planDelete, Kind: kindTenant, Name: t.Name, Parent: "project " + project.Name, ID: t.ID})
+				}
+			}
+		}
+	}
+	return plan, nil
+}
+
+// planProject plans the creation of a project, or the changes to an existing one
+func planProject(ctx context.Context, client *api.Client, m *manifest.Project, createdOrgs map[string]*planStep) (*planStep, error) {
+	projectAPI := api.NewProjectAPI(client)
+	step := &planStep{Kind: kindProject, Name: m.Metadata.Name, project: m}
+
+	if org := createdOrgs[m.Metadata.Organization]; org != nil && !orgFlagSet() {
+		step.parentStep, step.Parent = org, "organization "+org.Name
+	} else {
+		orgID, parent, err := manifestOrgID(ctx, client, m.Metadata.Organization)
+		if err != nil {
+			return nil, err
+		}
+		step.parentID, step.Parent = orgID, parent
+	}
+
+	var project *models.Project
+	if step.parentStep == nil {
+		id, err := resolveProjectID(ctx, client, m.Metadata.Name, "", step.parentID)
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			return nil, err
+		}
+		if err == nil {
+			if project, err = projectAPI.GetProject(ctx, id); err != nil {
+				return nil, fmt.Errorf("failed to get project: %w", err)
+			}
+		}
+	}
+
+	if project == nil {
+		if err := names.ValidateProject(m.Metadata.Name); err != nil {
+			return nil, err
+		}
+		step.Action = planCreate
+		step.Changes = []planChange{
+			{Field: "description", New: m.Spec.Description},
+			{Field: "max_tenants", New: m.Spec.MaxTenants},
+			{Field: "max_compute", New: m.Spec.MaxCompute},
+			{Field: "max_memory_gb", New: m.Spec.MaxMemoryGB},
+		}
+		if m.Spec.Description == "" {
+			step.Changes = step.Changes[1:]
+		}
+		for _, member := range m.Spec.Members {
+			step.Changes = append(step.Changes, planChange{Field: "members." + memberName(member), New: member.Role})
+		}
+		step.addMembers = m.Spec.Members
+		return step, nil
+	}
+
+	step.Action, step.ID = planUpdate, project.ID
+	description := ""
+	if project.Description != nil {
+		description = *project.Description
+	}
+	if m.Spec.Description != description {
+		step.projectUpdate.Description = &m.Spec.Description
+		step.Changes = append(step.Changes, planChange{Field: "description", Old: description, New: m.Spec.Description})
+	}
+	if m.Spec.MaxTenants != project.MaxTenants {
+		step.projectUpdate.MaxTenants = &m.Spec.MaxTenants
+		step.Changes = append(step.Changes, planChange{Field: "max_tenants", Old: project.MaxTenants, New: m.Spec.MaxTenants})
+	}
+	if m.Spec.MaxCompute != project.MaxCompute {
+		step.projectUpdate.MaxCompute = &m.Spec.MaxCompute
+		step.Changes = append(step.Changes, planChange{Field: "max_compute", Old: project.MaxCompute, New: m.Spec.MaxCompute})
+	}
+	if m.Spec.MaxMemoryGB != project.MaxMemoryGB {
+		step.projectUpdate.MaxMemoryGB = &m.Spec.MaxMemoryGB
+		step.Changes = append(step.Changes, planChange{Field: "max_memory_gb", Old: project.MaxMemoryGB, New: m.Spec.MaxMemoryGB})
+	}
+
+	if len(m.Spec.Members) > 0 {
+		members, err := projectAPI.ListProjectMembers(ctx, project.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list project members: %w", err)
+		}
+		roles := make(map[string]string, len(members))
+		for _, member := range members {
+			roles[member.UserID] = member.Role
+		}
+		var pending map[string]bool
+		for _, member := range m.Spec.Members {
+			// Members listed by email are found by it, as user IDs differ
+			// between environments
+			if member.Email != "" {
+				user, err := api.NewUserAPI(client).LookupUserByEmail(ctx, member.Email)
+				if err != nil {
+					return nil, fmt.Errorf("failed to look up user %s: %w", member.Email, err)
+				}
+				member.UserID = ""
+				if user != nil {
+					member.UserID = user.ID
+				}
+			}
+			if member.UserID == "" {
+				// Without an account the member is invited, once
+				if pending == nil {
+					if pending, err = pendingInvitations(ctx, projectAPI, project.ID); err != nil {
+						return nil, err
+					}
+				}
+				if !pending[strings.ToLower(member.Email)] {
+					step.addMembers = append(step.addMembers, member)
+					step.Changes = append(step.Changes, planChange{Field: "members." + member.Email, New: member.Role})
+				}
+				continue
+			}
+			role, ok := roles[member.UserID]
+			switch {
+			case !ok:
+				step.addMembers = append(step.addMembers, member)
+				step.Changes = append(step.Changes, planChange{Field: "members." + memberName(member), New: member.Role})
+			case role != member.Role:
+				step.changeMembers = append(step.changeMembers, member)
+				step.Changes = append(step.Changes, planChange{Field: "members." + memberName(member), Old: role, New: member.Role})
+			}
+		}
+	}
+	return step, nil
+}
+
+// planTenant plans the creation of a tenant, or the changes to an existing one
+func planTenant(ctx context.Context, client *api.Client, m *manifest.Tenant, createdProjects map[string]*planStep) (*planStep, error) {
+	tenantAPI := api.NewTenantAPI(client)
+	step := &planStep{Kind: kindTenant, Name: m.Metadata.Name}
+
+	if project := createdProjects[m.Metadata.Project]; project != nil && !projectFlagSet() {
+		step.parentStep, step.Parent = project, "project "+project.Name
+	} else {
+		projectID, parent, err := manifestProjectID(ctx, client, m.Metadata.Project)
+		if err != nil {
+			return nil, err
+		}
+		step.parentID, step.Parent = projectID, parent
+	}
+
+	var tenant *models.Tenant
+	if step.parentStep == nil {
+		id, err := resolveTenantID(ctx, client, m.Metadata.Name, "", step.parentID)
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			return nil, err
+		}
+		if err == nil {
+			if tenant, err = tenantAPI.GetTenant(ctx, id); err != nil {
+				return nil, fmt.Errorf("failed to get tenant: %w", err)
+			}
+		}
+	}
+
+	if tenant == nil {
+		if err := names.ValidateTenant(m.Metadata.Name); err != nil {
+			return nil, err
+		}
+		step.Action = planCreate
+		step.tenantCreate = models.CreateTenantRequest{
+			Name:              m.Metadata.Name,
+			CloudProvider:     m.Spec.CloudProvider,
+			Region:            m.Spec.Region,
+			KubernetesVersion: m.Spec.KubernetesVersion,
+			ComputeQuota:      m.Spec.ComputeQuota,
+			MemoryQuotaGB:     m.Spec.MemoryQuotaGB,
+			NamespaceSuffix:   m.Spec.NamespaceSuffix,
+		}
+		projectID, projectName := step.parentID, ""
+		if step.parentStep != nil {
+			projectName = step.parentStep.Name
+		}
+		projectDefaults, err := projectTenantDefaults(ctx, client, projectID, projectName)
+		if err != nil {
+			return nil, fmt.Errorf("tenant %s: %w", m.Metadata.Name, err)
+		}
+		if _, err := tenantCreateDefaults(ctx, tenantAPI, &step.tenantCreate, projectDefaults); err != nil {
+			return nil, fmt.Errorf("tenant %s: %w", m.Metadata.Name, err)
+		}
+		req := step.tenantCreate
+		step.Changes = []planChange{
+			{Field: "cloud_provider", New: req.CloudProvider},
+			{Field: "region", New: req.Region},
+			{Field: "kubernetes_version", New: req.KubernetesVersion},
+			{Field: "compute_quota", New: req.ComputeQuota},
+			{Field: "memory_quota_gb", New: req.MemoryQuotaGB},
+		}
+		if req.NamespaceSuffix != "" {
+			step.Changes = append(step.Changes, planChange{Field: "namespace_suffix", New: req.NamespaceSuffix})
+		}
+		return step, nil
+	}
+
+	step.Action, step.ID = planUpdate, tenant.ID
+	if m.Spec.CloudProvider != "" && m.Spec.CloudProvider != tenant.CloudProvider {
+		return nil, fmt.Errorf("tenant %s: cloud_provider cannot be changed from %q to %q; delete and recreate the tenant instead", tenant.Name, tenant.CloudProvider, m.Spec.CloudProvider)
+	}
+	if m.Spec.Region != "" && m.Spec.Region != tenant.Region {
+		return nil, fmt.Errorf("tenant %s: region cannot be changed from %q to %q; delete and recreate the tenant instead", tenant.Name, tenant.Region, m.Spec.Region)
+	}
+	if m.Spec.KubernetesVersion != "" && m.Spec.KubernetesVersion != tenant.KubernetesVersion {
+		step.tenantUpdate.KubernetesVersion = &m.Spec.KubernetesVersion
+		step.Changes = append(step.Changes, planChange{Field: "kubernetes_version", Old: tenant.KubernetesVersion, New: m.Spec.KubernetesVersion})
+	}
+	if m.Spec.ComputeQuota != 0 && m.Spec.ComputeQuota != tenant.ComputeQuota {
+		step.tenantUpdate.ComputeQuota = &m.Spec.ComputeQuota
+		step.Changes = append(step.Changes, planChange{Field: "compute_quota", Old: tenant.ComputeQuota, New: m.Spec.ComputeQuota})
+	}
+	if m.Spec.MemoryQuotaGB != 0 && m.Spec.MemoryQuotaGB != tenant.MemoryQuotaGB {
+		step.tenantUpdate.MemoryQuotaGB = &m.Spec.MemoryQuotaGB
+		step.Changes = append(step.Changes, planChange{Field: "memory_quota_gb", Old: tenant.MemoryQuotaGB, New: m.Spec.MemoryQuotaGB})
+	}
+	return step, nil
+}
+
+// applyStep carries out one step of the plan, recording the ID of created resources
+func applyStep(ctx context.Context, client *api.Client, step *planStep) error {
+	parentID := step.parentID
+	if step.parentStep != nil {
+		parentID = step.parentStep.ID
+	}
+
+	switch step.Kind {
+	case kindOrganization:
+		org, err := api.NewOrganizationAPI(client).CreateOrganization(ctx, step.Name, "")
+		if err != nil {
+			return err
+		}
+		step.ID = org.ID
+		return nil
+
+	case kindProject:
+		projectAPI := api.NewProjectAPI(client)
+		if step.Action == planCreate {
+			m := step.project
+			req := models.CreateProjectRequest{
+				Name:        m.Metadata.Name,
+				MaxTenants:  m.Spec.MaxTenants,
+				MaxCompute:  m.Spec.MaxCompute,
+				MaxMemoryGB: m.Spec.MaxMemoryGB,
+			}
+			if m.Spec.Description != "" {
+				req.Description = &m.Spec.Description
+			}
+			project, err := projectAPI.CreateProject(ctx, parentID, req)
+			if err != nil {
+				return err
+			}
+			step.ID = project.ID
+		} else if step.projectUpdate != (models.PatchProjectRequest{}) {
+			if _, err := projectAPI.PatchProject(ctx, step.ID, step.projectUpdate); err != nil {
+				return err
+			}
+		}
+		for _, member := range step.addMembers {
+			if _, err := importMember(ctx, client, step.ID, member); err != nil {
+				return fmt.Errorf("failed to add member %s: %w", memberName(member), err)
+			}
+		}
+		for _, member := range step.changeMembers {
+			if err := projectAPI.ChangeProjectUserRole(ctx, step.ID, member.UserID, member.Role); err != nil {
+				return fmt.Errorf("failed to change the role of member %s: %w", memberName(member), err)
+			}
+		}
+		return nil
+
+	default:
+		tenantAPI := api.NewTenantAPI(client)
+		switch step.Action {
+		case planCreate:
+			tenant, err := tenantAPI.CreateTenant(ctx, parentID, step.tenantCreate)
+			if err != nil {
+				return err
+			}
+			step.ID = tenant.ID
+			return nil
+		case planDelete:
+			return tenantAPI.DeleteTenant(ctx, step.ID)
+		default:
+			_, err := tenantAPI.UpdateTenant(ctx, step.ID, step.tenantUpdate)
+			return err
+		}
+	}
+}
+
+// printPlan prints a plan with a symbol per action and the fields that change
+func printPlan(plan []*planStep) {
+	if len(plan) == 0 {
+		fmt.Println("No changes. The resources match the manifests.")
+		return
+	}
+
+	fmt.Println("spacectl will perform the following actions:")
+	fmt.Println()
+	symbols := map[planAction]string{planCreate: "+", planUpdate: "~", planDelete: "-"}
+	for _, step := range plan {
+		fmt.Printf("  %s %s %s", symbols[step.Action], step.Kind, step.Name)
+		if step.Parent != "" {
+			fmt.Printf(" (%s)", step.Parent)
+		}
+		fmt.Println()
+
+		width := 0
+		for _, c := range step.Changes {
+			width = max(width, len(c.Field))
+		}
+		for _, c := range step.Changes {
+			if step.Action == planCreate || c.Old == nil {
+				fmt.Printf("      %-*s  %s\n", width+1, c.Field+":", planValue(c.New))
+			} else {
+				fmt.Printf("      %-*s  %s -> %s\n", width+1, c.Field+":", planValue(c.Old), planValue(c.New))
+			}
+		}
+	}
+
+	created, changed, destroyed := planCounts(plan)
+	fmt.Printf("\nPlan: %d to create, %d to change, %d to destroy.\n", created, changed, destroyed)
+}
+
+// applySummary describes a plan as Markdown for CI job summaries, with the
+// result of each step. The first attempted steps were applied, with the errors
+// in errs; attempted is -1 when the plan was only printed.
+func applySummary(plan []*planStep, attempted int, errs []error) string {
+	var b strings.Builder
+	created, changed, destroyed := planCounts(plan)
+	applied := 0
+	for i := 0; i < attempted; i++ {
+		if errs[i] == nil {
+			applied++
+		}
+	}
+	if attempted < 0 {
+		fmt.Fprintf(&b, "Plan: %d to create, %d to change, %d to destroy.\n", created, changed, destroyed)
+	} else if applied == len(plan) {
+		fmt.Fprintf(&b, "Apply complete! Resources: %d created, %d changed, %d destroyed.\n", created, changed, destroyed)
+	} else {
+		fmt.Fprintf(&b, "Apply failed after %d of %d changes.\n", applied, len(plan))
+	}
+	if len(plan) == 0 {
+		return b.String()
+	}
+
+	b.WriteString("\n| Action | Kind | Name | Parent | Result |\n|---|---|---|---|---|\n")
+	for i, step := range plan {
+		result := "planned"
+		switch {
+		case attempted < 0:
+		case i >= attempted:
+			result = "not applied"
+		case errs[i] != nil:
+			result = "**failed**"
+		default:
+			result = string(step.Action) + "d"
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n", step.Action, step.Kind, step.Name, step.Parent, result)
+	}
+	return b.String()
+}
+
+// planValue formats a field value in a plan, quoting strings
+func planValue(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprint(v)
+}
+
+// planCounts returns the number of resources a plan creates, changes, and destroys
+func planCounts(plan []*planStep) (created, changed, destroyed int) {
+	for _, step := range plan {
+		switch step.Action {
+		case planCreate:
+			created++
+		case planUpdate:
+			changed++
+		case planDelete:
+			destroyed++
+		}
+	}
+	return created, changed, destroyed
+}
@@ -0,0 +1,369 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"spacectl/internal/config"
+	"spacectl/internal/manifest"
+	"spacectl/internal/models"
+)
+
+// applyRoutes is an account with organization acme holding project web, whose
+// tenants are dev and old, and no organization newco
+func applyRoutes() map[string]fakeResponse {
+	web := models.Project{ID: "proj-1", OrganizationID: "org-1", Name: "web", MaxTenants: 5, MaxCompute: 10, MaxMemoryGB: 20}
+	tenant := func(id, name string) models.Tenant {
+		return models.Tenant{ID: id, ProjectID: "proj-1", Name: name, CloudProvider: "aws", Region: "eu-west-1", KubernetesVersion: "1.30", ComputeQuota: 2, MemoryQuotaGB: 4}
+	}
+	return map[string]fakeResponse{
+		"GET /api/v1/organizations":                            {Body: []models.OrganizationMembershipResponse{}},
+		"GET /api/v1/organizations/by-name/acme":               {Body: models.Organization{ID: "org-1", Name: "acme"}},
+		"GET /api/v1/organizations/by-name/newco":              notFound,
+		"GET /api/v1/organizations/org-1/projects":             {Body: []models.Project{web}},
+		"GET /api/v1/projects/proj-1":                          {Body: web},
+		"GET /api/v1/projects/proj-1/tenants":                  {Body: []models.Tenant{tenant("tenant-1", "dev"), tenant("tenant-2", "old")}},
+		"GET /api/v1/tenants/tenant-1":                         {Body: tenant("tenant-1", "dev")},
+		"GET /api/v1/tenants/kubernetes-versions":              {Body: []models.KubernetesVersion{{Version: "1.31", IsDefault: true}, {Version: "1.30"}}},
+		"GET /api/v1/projects/proj-1/users":                    {Body: []models.ProjectMember{{UserID: "user-1", Role: "admin"}, {UserID: "user-2", Role: "viewer"}}},
+		"GET /api/v1/projects/proj-1/invitations":              {Body: []models.ProjectInvitation{{InviteeEmail: "Pending@example.com", Status: "pending"}}},
+		"GET /api/v1/users/lookup?email=ann%40example.com":     {Body: models.User{ID: "user-1", Email: "ann@example.com"}},
+		"GET /api/v1/users/lookup?email=new%40example.com":     notFound,
+		"GET /api/v1/users/lookup?email=pending%40example.com": notFound,
+	}
+}
+
+// applyConfig is the config of the account in applyRoutes
+var applyConfig = config.Config{DefaultCloud: "aws", DefaultRegion: "eu-west-1"}
+
+func applyProject(org, name string, spec manifest.ProjectSpec) *manifest.Project {
+	return &manifest.Project{Metadata: manifest.Metadata{Name: name, Organization: org}, Spec: spec}
+}
+
+func applyTenant(project, name string, spec manifest.TenantSpec) *manifest.Tenant {
+	return &manifest.Tenant{Metadata: manifest.Metadata{Name: name, Project: project}, Spec: spec}
+}
+
+// stepSummary describes a step as "action kind name (parent)"
+func stepSummary(step *planStep) string {
+	return fmt.Sprintf("%s %s %s (%s)", step.Action, step.Kind, step.Name, step.Parent)
+}
+
+func TestPlanApply(t *testing.T) {
+	client, _ := newFakeAPI(t, applyConfig, applyRoutes())
+
+	webSpec := manifest.ProjectSpec{MaxTenants: 8, MaxCompute: 10, MaxMemoryGB: 20}
+	resources := []interface{}{
+		// Tenants and projects are planned after their parents, whatever the
+		// order of the manifests
+		applyTenant("acme/web", "dev", manifest.TenantSpec{KubernetesVersion: "1.31"}),
+		applyTenant("api", "svc", manifest.TenantSpec{}),
+		applyProject("newco", "api", manifest.ProjectSpec{MaxTenants: 3}),
+		applyTenant("acme/web", "staging", manifest.TenantSpec{}),
+		applyProject("acme", "web", webSpec),
+		manifest.NewOrganization("acme"),
+		manifest.NewOrganization("newco"),
+	}
+
+	plan, err := planApply(context.Background(), client, resources)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, step := range plan {
+		got = append(got, stepSummary(step))
+	}
+	want := []string{
+		"create organization newco ()",
+		"create project api (organization newco)",
+		"update project web (organization acme)",
+		"update tenant dev (project acme/web)",
+		"create tenant svc (project api)",
+		"create tenant staging (project acme/web)",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected plan\nwant: %q\ngot:  %q", want, got)
+	}
+
+	if plan[1].parentStep != plan[0] {
+		t.Errorf("expected project api to be created in the new organization")
+	}
+	if plan[4].parentStep != plan[1] {
+		t.Errorf("expected tenant svc to be created in the new project")
+	}
+	if want := []planChange{{Field: "max_tenants", Old: 5, New: 8}}; !reflect.DeepEqual(plan[2].Changes, want) {
+		t.Errorf("expected project web changes %v, got %v", want, plan[2].Changes)
+	}
+	if plan[3].ID != "tenant-1" {
+		t.Errorf("expected tenant dev to be updated by ID, got %q", plan[3].ID)
+	}
+
+	// Steps for the new staging tenant take the config defaults and the
+	// newest Kubernetes version
+	staging := plan[5].tenantCreate
+	if staging.CloudProvider != "aws" || staging.Region != "eu-west-1" || staging.KubernetesVersion != "1.31" {
+		t.Errorf("expected tenant staging to take the defaults, got %+v", staging)
+	}
+}
+
+func TestPlanApplyPrune(t *testing.T) {
+	resources := []interface{}{
+		applyProject("acme", "web", manifest.ProjectSpec{MaxTenants: 5, MaxCompute: 10, MaxMemoryGB: 20}),
+		applyTenant("acme/web", "dev", manifest.TenantSpec{}),
+	}
+
+	tests := []struct {
+		name  string
+		prune bool
+		want  []string
+	}{
+		{name: "without prune", prune: false, want: nil},
+		{name: "with prune", prune: true, want: []string{"delete tenant old (project web)"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, _ := newFakeAPI(t, applyConfig, applyRoutes())
+			setForTest(t, &applyPrune, tc.prune)
+
+			plan, err := planApply(context.Background(), client, resources)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []string
+			for _, step := range plan {
+				got = append(got, stepSummary(step))
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("unexpected plan\nwant: %q\ngot:  %q", tc.want, got)
+			}
+			if tc.prune && plan[0].ID != "tenant-2" {
+				t.Errorf("expected tenant old to be deleted by ID, got %q", plan[0].ID)
+			}
+		})
+	}
+}
+
+func TestPlanApplyMissingName(t *testing.T) {
+	client, fake := newFakeAPI(t, applyConfig, applyRoutes())
+
+	_, err := planApply(context.Background(), client, []interface{}{applyTenant("acme/web", "", manifest.TenantSpec{})})
+	if err == nil || !strings.Contains(err.Error(), "metadata.name") {
+		t.Fatalf("expected an error about metadata.name, got %v", err)
+	}
+	if got := fake.received(); len(got) > 0 {
+		t.Errorf("expected no requests before the manifests are checked, got %q", got)
+	}
+}
+
+func TestPlanProject(t *testing.T) {
+	spec := manifest.ProjectSpec{MaxTenants: 5, MaxCompute: 10, MaxMemoryGB: 20}
+
+	tests := []struct {
+		name        string
+		project     *manifest.Project
+		createdOrgs map[string]*planStep
+		wantAction  planAction
+		wantChanges []planChange
+		wantAdd     []manifest.Member
+		wantChange  []manifest.Member
+	}{
+		{
+			name:       "unchanged",
+			project:    applyProject("acme", "web", spec),
+			wantAction: planUpdate,
+		},
+		{
+			name: "quotas and description",
+			project: applyProject("acme", "web", manifest.ProjectSpec{
+				Description: "Web shop", MaxTenants: 5, MaxCompute: 12, MaxMemoryGB: 24,
+			}),
+			wantAction: planUpdate,
+			wantChanges: []planChange{
+				{Field: "description", Old: "", New: "Web shop"},
+				{Field: "max_compute", Old: 10, New: 12},
+				{Field: "max_memory_gb", Old: 20, New: 24},
+			},
+		},
+		{
+			// ann is already an admin and the pending invitation is not sent
+			// again; user-2 gets a new role and new@example.com is invited
+			name: "members",
+			project: applyProject("acme", "web", manifest.ProjectSpec{
+				MaxTenants: 5, MaxCompute: 10, MaxMemoryGB: 20,
+				Members: []manifest.Member{
+					{Email: "ann@example.com", Role: "admin"},
+					{UserID: "user-2", Role: "editor"},
+					{Email: "new@example.com", Role: "viewer"},
+					{Email: "pending@example.com", Role: "viewer"},
+					{UserID: "user-3", Role: "viewer"},
+				},
+			}),
+			wantAction: planUpdate,
+			wantChanges: []planChange{
+				{Field: "members.user-2", Old: "viewer", New: "editor"},
+				{Field: "members.new@example.com", New: "viewer"},
+				{Field: "members.user-3", New: "viewer"},
+			},
+			wantAdd: []manifest.Member{
+				{Email: "new@example.com", Role: "viewer"},
+				{UserID: "user-3", Role: "viewer"},
+			},
+			wantChange: []manifest.Member{{UserID: "user-2", Role: "editor"}},
+		},
+		{
+			name:       "new project",
+			project:    applyProject("acme", "shop", manifest.ProjectSpec{MaxTenants: 2, Members: []manifest.Member{{Email: "ann@example.com", Role: "admin"}}}),
+			wantAction: planCreate,
+			wantChanges: []planChange{
+				{Field: "max_tenants", New: 2},
+				{Field: "max_compute", New: 0},
+				{Field: "max_memory_gb", New: 0},
+				{Field: "members.ann@example.com", New: "admin"},
+			},
+			wantAdd: []manifest.Member{{Email: "ann@example.com", Role: "admin"}},
+		},
+		{
+			name:        "in a new organization",
+			project:     applyProject("newco", "web", spec),
+			createdOrgs: map[string]*planStep{"newco": {Action: planCreate, Kind: kindOrganization, Name: "newco"}},
+			wantAction:  planCreate,
+			wantChanges: []planChange{
+				{Field: "max_tenants", New: 5},
+				{Field: "max_compute", New: 10},
+				{Field: "max_memory_gb", New: 20},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, _ := newFakeAPI(t, applyConfig, applyRoutes())
+
+			step, err := planProject(context.Background(), client, tc.project, tc.createdOrgs)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if step.Action != tc.wantAction {
+				t.Errorf("expected action %s, got %s", tc.wantAction, step.Action)
+			}
+			if !reflect.DeepEqual(step.Changes, tc.wantChanges) {
+				t.Errorf("unexpected changes\nwant: %v\ngot:  %v", tc.wantChanges, step.Changes)
+			}
+			if !reflect.DeepEqual(step.addMembers, tc.wantAdd) {
+				t.Errorf("unexpected members to add\nwant: %v\ngot:  %v", tc.wantAdd, step.addMembers)
+			}
+			if !reflect.DeepEqual(step.changeMembers, tc.wantChange) {
+				t.Errorf("unexpected member roles to change\nwant: %v\ngot:  %v", tc.wantChange, step.changeMembers)
+			}
+		})
+	}
+}
+
+func TestPlanTenant(t *testing.T) {
+	tests := []struct {
+		name        string
+		tenant      *manifest.Tenant
+		wantAction  planAction
+		wantChanges []planChange
+		wantErr     string
+	}{
+		{
+			name:       "unchanged",
+			tenant:     applyTenant("acme/web", "dev", manifest.TenantSpec{CloudProvider: "aws", KubernetesVersion: "1.30", ComputeQuota: 2}),
+			wantAction: planUpdate,
+		},
+		{
+			name:       "version and quotas",
+			tenant:     applyTenant("acme/web", "dev", manifest.TenantSpec{KubernetesVersion: "1.31", ComputeQuota: 4, MemoryQuotaGB: 8}),
+			wantAction: planUpdate,
+			wantChanges: []planChange{
+				{Field: "kubernetes_version", Old: "1.30", New: "1.31"},
+				{Field: "compute_quota", Old: 2, New: 4},
+				{Field: "memory_quota_gb", Old: 4, New: 8},
+			},
+		},
+		{
+			name:    "region change",
+			tenant:  applyTenant("acme/web", "dev", manifest.TenantSpec{Region: "us-east-1"}),
+			wantErr: `region cannot be changed from "eu-west-1" to "us-east-1"`,
+		},
+		{
+			name:    "cloud change",
+			tenant:  applyTenant("acme/web", "dev", manifest.TenantSpec{CloudProvider: "gcp"}),
+			wantErr: `cloud_provider cannot be changed from "aws" to "gcp"`,
+		},
+		{
+			name:       "new tenant",
+			tenant:     applyTenant("acme/web", "staging", manifest.TenantSpec{ComputeQuota: 3, NamespaceSuffix: "stg"}),
+			wantAction: planCreate,
+			wantChanges: []planChange{
+				{Field: "cloud_provider", New: "aws"},
+				{Field: "region", New: "eu-west-1"},
+				{Field: "kubernetes_version", New: "1.31"},
+				{Field: "compute_quota", New: 3},
+				{Field: "memory_quota_gb", New: 4},
+				{Field: "namespace_suffix", New: "stg"},
+			},
+		},
+		{
+			name:    "invalid name",
+			tenant:  applyTenant("acme/web", "Staging_1", manifest.TenantSpec{}),
+			wantErr: "Staging_1",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, _ := newFakeAPI(t, applyConfig, applyRoutes())
+
+			step, err := planTenant(context.Background(), client, tc.tenant, nil)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if step.Action != tc.wantAction {
+				t.Errorf("expected action %s, got %s", tc.wantAction, step.Action)
+			}
+			if !reflect.DeepEqual(step.Changes, tc.wantChanges) {
+				t.Errorf("unexpected changes\nwant: %v\ngot:  %v", tc.wantChanges, step.Changes)
+			}
+		})
+	}
+}
+
+func TestBatchEnd(t *testing.T) {
+	step := func(action planAction, kind resourceKind) *planStep {
+		return &planStep{Action: action, Kind: kind}
+	}
+	plan := []*planStep{
+		step(planCreate, kindOrganization),
+		step(planCreate, kindProject),
+		step(planCreate, kindProject),
+		step(planUpdate, kindProject),
+		step(planCreate, kindTenant),
+		step(planUpdate, kindTenant),
+		step(planCreate, kindTenant),
+		step(planDelete, kindTenant),
+		step(planDelete, kindTenant),
+	}
+
+	var got [][2]int
+	for start := 0; start < len(plan); {
+		end := batchEnd(plan, start)
+		got = append(got, [2]int{start, end})
+		start = end
+	}
+	// Only consecutive steps are batched, so a step never runs before the
+	// steps planned ahead of it
+	want := [][2]int{{0, 1}, {1, 3}, {3, 4}, {4, 5}, {5, 6}, {6, 7}, {7, 9}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected batches %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,295 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// auditCmd represents the audit command
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Show and export an organization's audit log",
+	Long: `Show and export the audit log of an organization: who added or removed members,
+changed roles and settings, and created or deleted projects and tenants.
+
+The organization is the one given with --org or --org-name, or your default
+organization. With --project or --project-name, only entries about that project
+and its tenants are shown.`,
+}
+
+// auditListCmd represents the audit list command
+var auditListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List audit log entries",
+	Long: `List the audit log entries of an organization, oldest first.
+
+--since and --until take a date (2025-01-02), an RFC 3339 time
+(2025-01-02T15:04:05Z), or an age such as 7d or 12h.
+
+Examples:
+  spacectl audit list --since 7d
+  spacectl audit list --org-name acme --project-name web --since 2025-01-01 --until 2025-02-01
+  spacectl audit list --since 24h --filter action=tenant.*`,
+	Args: cobra.NoArgs,
+	RunE: runAuditList,
+}
+
+// auditExportCmd represents the audit export command
+var auditExportCmd = &cobra.Command{
+	Use:   "export --output-file <file>",
+	Short: "Export audit log entries to a file for archiving",
+	Long: `Write every audit log entry of an organization in a time range to a file, with
+all fields, for compliance archives.
+
+The format is the one given with -o (csv, json, or yaml), or else follows the
+file's extension, defaulting to CSV. --since and --until take a date, an
+RFC 3339 time, or an age such as 30d.
+
+Examples:
+  spacectl audit export --org-name acme --output-file audit.csv
+  spacectl audit export --since 2025-01-01 --until 2025-02-01 --output-file audit-2025-01.json
+  spacectl audit export --project-name web --since 90d -o csv --output-file web-audit.txt`,
+	Args: cobra.NoArgs,
+	RunE: runAuditExport,
+}
+
+var (
+	auditSince      string
+	auditUntil      string
+	auditOutputFile string
+)
+
+func init() {
+	rootCmd.AddCommand(auditCmd)
+	auditCmd.AddCommand(auditListCmd)
+	auditCmd.AddCommand(auditExportCmd)
+
+	for _, c := range []*cobra.Command{auditListCmd, auditExportCmd} {
+		addTimeRangeFlags(c, &auditSince, &auditUntil)
+	}
+	addListFlags(auditListCmd)
+
+	auditExportCmd.Flags().StringVar(&auditOutputFile, "output-file", "", "File to write the entries to")
+	auditExportCmd.MarkFlagRequired("output-file")
+}
+
+// addTimeRangeFlags registers --since and --until on a command
+func addTimeRangeFlags(cmd *cobra.Command, since, until *string) {
+	cmd.Flags().StringVar(since, "since", "", "Only include entries at or after this time (date, RFC 3339 time, or age like 7d)")
+	cmd.Flags().StringVar(until, "until", "", "Only include entries before this time (date, RFC 3339 time, or age like 7d)")
+}
+
+// parseTimeRange parses the values of --since and --until; empty values are zero times
+func parseTimeRange(since, until string) (time.Time, time.Time, error) {
+	now := time.Now()
+	var from, to time.Time
+	var err error
+	if since != "" {
+		if from, err = output.ParseTime(since, now); err != nil {
+			return from, to, fmt.Errorf("--since: %w", err)
+		}
+	}
+	if until != "" {
+		if to, err = output.ParseTime(until, now); err != nil {
+			return from, to, fmt.Errorf("--until: %w", err)
+		}
+	}
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return from, to, fmt.Errorf("--since must be before --until")
+	}
+	return from, to, nil
+}
+
+// inTimeRange reports whether t is in [since, until), where zero bounds are open
+func inTimeRange(t, since, until time.Time) bool {
+	return (since.IsZero() || !t.Before(since)) && (until.IsZero() || t.Before(until))
+}
+
+func runAuditList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	entries, err := fetchAuditLog(listContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	// Structured formats get the full entries
+	if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML {
+		return formatter.FormatData(entries)
+	}
+
+	rows := make([]map[string]interface{}, 0, len(entries))
+	for _, e := range entries {
+		rows = append(rows, auditRecord(e))
+	}
+	return formatter.FormatRows(rows, auditColumns)
+}
+
+func runAuditExport(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	format, err := auditExportFormat(cmd)
+	if err != nil {
+		return err
+	}
+	entries, err := fetchAuditLog(ctx)
+	if err != nil {
+		return err
+	}
+
+	var rows []map[string]interface{}
+	if format == output.FormatCSV {
+		rows = make([]map[string]interface{}, 0, len(entries))
+		for _, e := range entries {
+			rows = append(rows, auditExportRecord(e))
+		}
+	}
+
+	// Audit records include email and IP addresses, so only the user may read them
+	f, err := os.OpenFile(auditOutputFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", auditOutputFile, err)
+	}
+	if format == output.FormatCSV {
+		err = output.NewFormatter(format, noHeaders, f).FormatRows(rows, auditExportColumns)
+	} else {
+		err = output.NewFormatter(format, noHeaders, f).FormatData(entries)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", auditOutputFile, err)
+	}
+
+	if !quiet {
+		fmt.Printf("Exported %d audit log entries to %s\n", len(entries), auditOutputFile)
+	}
+	return nil
+}
+
+// fetchAuditLog lists the audit log entries selected by the organization,
+// project, and time range flags
+func fetchAuditLog(ctx context.Context) ([]models.AuditEntry, error) {
+	since, until, err := parseTimeRange(auditSince, auditUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create API client
+	client := apiClient()
+	orgID, err := requireOrgID(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only filter by project when one is given, not by the default project
+	filter := api.AuditFilter{Since: since, Until: until}
+	if projectFlagSet() {
+		filter.ProjectID = contextProjectID()
+	}
+	entries, err := api.NewAuditAPI(client).ListAuditLog(ctx, orgID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit log: %w", err)
+	}
+	return entries, nil
+}
+
+// auditExportFormat returns the format of an export: -o when given, or else the
+// one matching the file's extension, defaulting to CSV
+func auditExportFormat(cmd *cobra.Command) (output.Format, error) {
+	if cmd.Flags().Changed("output") {
+		switch format := output.Format(outputFmt); format {
+		case output.FormatCSV, output.FormatJSON, output.FormatYAML:
+			return format, nil
+		default:
+			return "", fmt.Errorf("audit logs can be exported as csv, json, or yaml, not %s", format)
+		}
+	}
+	switch strings.ToLower(filepath.Ext(auditOutputFile)) {
+	case ".json":
+		return output.FormatJSON, nil
+	case ".yaml", ".yml":
+		return output.FormatYAML, nil
+	default:
+		return output.FormatCSV, nil
+	}
+}
+
+// auditColumns are the columns of auditRecord rows in display order
+var auditColumns = []string{"time", "actor", "action", "resource", "details"}
+
+// auditRecord builds the table row for an audit log entry
+func auditRecord(e models.AuditEntry) map[string]interface{} {
+	actor := e.ActorEmail
+	if actor == "" {
+		actor = e.ActorID
+	}
+	resource := e.ResourceType + "/" + e.ResourceID
+	if e.ResourceName != "" {
+		resource = e.ResourceType + "/" + e.ResourceName
+	}
+	return map[string]interface{}{
+		"time":     e.CreatedAt.Local().Format("2006-01-02 15:04:05"),
+		"actor":    actor,
+		"action":   e.Action,
+		"resource": resource,
+		"details":  auditDetails(e.Details),
+	}
+}
+
+// auditExportColumns are the columns of auditExportRecord rows in display order
+var auditExportColumns = []string{"id", "time", "action", "actor_id", "actor_email", "resource_type", "resource_id", "resource_name", "project_id", "organization_id", "source_ip", "details"}
+
+// auditExportRecord builds the CSV row for an audit log entry, with every field
+func auditExportRecord(e models.AuditEntry) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              e.ID,
+		"time":            e.CreatedAt.UTC().Format(time.RFC3339),
+		"action":          e.Action,
+		"actor_id":        e.ActorID,
+		"actor_email":     e.ActorEmail,
+		"resource_type":   e.ResourceType,
+		"resource_id":     e.ResourceID,
+		"resource_name":   e.ResourceName,
+		"project_id":      e.ProjectID,
+		"organization_id": e.OrganizationID,
+		"source_ip":       e.SourceIP,
+		"details":         auditDetails(e.Details),
+	}
+}
+
+// auditDetails formats the details of an entry as sorted key=value pairs
+func auditDetails(details map[string]string) string {
+	keys := make([]string, 0, len(details))
+	for k := range details {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = k + "=" + details[k]
+	}
+	return strings.Join(pairs, ", ")
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// canICmd represents the auth can-i command
+var canICmd = &cobra.Command{
+	Use:   "can-i <action> <resource>",
+	Short: "Check whether you may take an action",
+	Long: `Ask the server whether you may take an action on a kind of resource, such as
+creating tenants in a project, before starting automation that would fail
+halfway. Prints "yes" or "no", with the reason (such as the role that grants
+or lacks the permission) on stderr. The exit code is 0 for yes and 4 for no.
+
+Resources: organizations (org), projects (proj), tenants, members
+
+Tenants are checked in the project given with --project or --project-name, or
+the default project. Projects and members are checked in that project when one
+is given, and otherwise in the organization given with --org or --org-name, or
+your default organization.
+
+Examples:
+  spacectl auth can-i create tenant --project-name web
+  spacectl auth can-i delete project -p web
+  spacectl auth can-i update members --org-name acme
+  spacectl auth can-i create tenant -p web -q && ./provision.sh`,
+	Args: cobra.ExactArgs(2),
+	RunE: runCanI,
+}
+
+// kindMember is the resource kind of organization and project members, which
+// only can-i accepts
+const kindMember resourceKind = "member"
+
+func init() {
+	authCmd.AddCommand(canICmd)
+}
+
+func runCanI(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	action := strings.ToLower(args[0])
+	kind, ok := resourceAliases[strings.ToLower(args[1])]
+	if strings.EqualFold(args[1], "member") || strings.EqualFold(args[1], "members") {
+		kind, ok = kindMember, true
+	}
+	if !ok {
+		return fmt.Errorf("unknown resource %q: use organizations, projects, tenants, or members", args[1])
+	}
+
+	// Create API client
+	client := apiClient()
+	req := models.AccessCheckRequest{Action: action, Resource: string(kind)}
+	var err error
+	switch kind {
+	case kindTenant:
+		req.ProjectID, err = requireProjectID()
+	case kindProject, kindMember:
+		if projectFlagSet() {
+			req.ProjectID = contextProjectID()
+		} else {
+			req.OrganizationID, err = requireOrgID(ctx, client)
+		}
+	case kindOrganization:
+		// Creating an organization is not checked in one
+		if action != "create" {
+			req.OrganizationID, err = requireOrgID(ctx, client)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	check, err := api.NewAuthAPI(client).CheckAccess(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to check permissions: %w", err)
+	}
+
+	switch {
+	case output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML:
+		if err := formatter.FormatData(check); err != nil {
+			return err
+		}
+	case !quiet:
+		answer := "no"
+		if check.Allowed {
+			answer = "yes"
+		}
+		fmt.Println(answer)
+		if check.Reason != "" {
+			fmt.Fprintln(os.Stderr, check.Reason)
+		}
+	}
+	if !check.Allowed {
+		// A "no" is an answer, not a usage error
+		cmd.SilenceUsage = true
+		return exitCodeError{code: ExitForbidden}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"strings"
+	"time"
+
+	"spacectl/internal/api"
+	"spacectl/internal/config"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// completionCacheAge is how long completions use cached API responses
+	// without asking the API again, so pressing tab stays fast
+	completionCacheAge = 10 * time.Minute
+	// completionTimeout bounds each API request made for a completion
+	completionTimeout = 3 * time.Second
+)
+
+// completionClient returns an API client for completing flag values, or nil
+// when not logged in. Completions run without the root command's setup, so
+// the client is built from the config and --api-url alone.
+func completionClient() *api.Client {
+	c, err := config.Load()
+	if err != nil || !c.IsAuthenticated() {
+		return nil
+	}
+	if apiURL != "" {
+		c.APIURL = apiURL
+	}
+	proxy, err := c.Proxy()
+	if err != nil {
+		return nil
+	}
+	tls, err := api.LoadTLSConfig(api.TLSOptions{
+		CACertFile:         c.CACert,
+		ClientCertFile:     c.ClientCert,
+		ClientKeyFile:      c.ClientKey,
+		InsecureSkipVerify: c.InsecureSkipTLSVerify,
+	})
+	if err != nil {
+		return nil
+	}
+
+	client := api.NewClient(c.APIURL, c, false)
+	client.SetProxy(proxy)
+	client.SetTLSConfig(tls)
+	client.SetTimeouts(completionTimeout, completionTimeout)
+	client.SetRetries(0)
+	client.EnableCache(api.DefaultCacheDir())
+	client.SetCacheMaxAge(completionCacheAge)
+	return client
+}
+
+// withPrefix returns the completions that start with toComplete
+func withPrefix(completions []string, toComplete string) []string {
+	var matched []string
+	for _, c := range completions {
+		if strings.HasPrefix(c, toComplete) {
+			matched = append(matched, c)
+		}
+	}
+	return matched
+}
+
+// completeClouds completes --cloud with the cloud providers tenants can run on
+func completeClouds(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	client := completionClient()
+	if client == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	clouds, err := api.NewTenantAPI(client).GetAvailableClouds(cmd.Context())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return withPrefix(clouds, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeRegions completes --region with the regions of the cloud given with
+// --cloud, or of the default cloud from the config. Without either, the
+// regions of every cloud are offered, described by their cloud.
+func completeRegions(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	client := completionClient()
+	if client == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	ctx := cmd.Context()
+	tenantAPI := api.NewTenantAPI(client)
+
+	cloud, _ := cmd.Flags().GetString("cloud")
+	if cloud == "" {
+		if c, err := config.Load(); err == nil {
+			cloud = c.DefaultCloud
+		}
+	}
+	if cloud != "" {
+		regions, err := tenantAPI.GetAvailableRegions(ctx, cloud)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return withPrefix(regions, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	clouds, err := tenantAPI.GetAvailableClouds(ctx)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var completions []string
+	for _, cloud := range clouds {
+		regions, err := tenantAPI.GetAvailableRegions(ctx, cloud)
+		if err != nil {
+			continue
+		}
+		for _, region := range regions {
+			completions = append(completions, region+"\t"+cloud)
+		}
+	}
+	return withPrefix(completions, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeKubernetesVersions completes --k8s-version with the available
+// Kubernetes versions, marking the default one
+func completeKubernetesVersions(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	client := completionClient()
+	if client == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	versions, err := api.NewTenantAPI(client).GetAvailableKubernetesVersions(cmd.Context())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	completions := make([]string, 0, len(versions))
+	for _, v := range versions {
+		if v.IsDefault {
+			completions = append(completions, v.Version+"\tdefault")
+		} else {
+			completions = append(completions, v.Version)
+		}
+	}
+	return withPrefix(completions, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeRoles returns a completion function for --role that offers the
+// roles of a scope, org or project, from the role catalog. Servers without
+// the catalog get the built-in admin and member roles.
+func completeRoles(scope string) func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		fallback := withPrefix([]string{"admin", "member"}, toComplete)
+		client := completionClient()
+		if client == nil {
+			return fallback, cobra.ShellCompDirectiveNoFileComp
+		}
+		roles, err := api.NewRoleAPI(client).ListRoles(cmd.Context(), scope)
+		if err != nil {
+			return fallback, cobra.ShellCompDirectiveNoFileComp
+		}
+		completions := make([]string, 0, len(roles))
+		for _, r := range roles {
+			if r.Description != "" {
+				completions = append(completions, r.Name+"\t"+r.Description)
+			} else {
+				completions = append(completions, r.Name)
+			}
+		}
+		return withPrefix(completions, toComplete), cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,16 @@
+package cmd
+
+import (
+	"spacectl/internal/parallel"
+
+	"github.com/spf13/cobra"
+)
+
+// concurrency bounds the number of requests made at once by commands that fan
+// out over many resources, such as listing the tenants of every project
+var concurrency = parallel.DefaultConcurrency
+
+// addConcurrencyFlag registers the --concurrency flag on a command that fans out
+func addConcurrencyFlag(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&concurrency, "concurrency", parallel.DefaultConcurrency, "Maximum number of requests to make at once")
+}
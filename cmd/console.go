@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// consoleCmd represents the console command
+var consoleCmd = &cobra.Command{
+	Use:   "console [org|project|tenant] [name]",
+	Short: "Open the Kubespaces web console in your browser",
+	Long: `Open the Kubespaces web console, or the console page of an organization,
+project, or tenant, in your default browser.
+
+Without a name, org opens the selected or default organization and project
+opens the selected or default project. Tenants are looked up by name in the
+selected or default project.
+
+The console address is taken from console_url in ~/.spacectl. When that is not
+set it is derived from api_url, with a leading "api." in the host name replaced
+by "app.".
+
+Examples:
+  spacectl console
+  spacectl console org acme
+  spacectl console project my-project
+  spacectl console tenant dev -p my-project
+  spacectl console tenant dev --print`,
+	Args:              cobra.RangeArgs(0, 2),
+	ValidArgsFunction: completeResourceKinds,
+	RunE:              runConsole,
+}
+
+var consolePrint bool
+
+func init() {
+	rootCmd.AddCommand(consoleCmd)
+
+	consoleCmd.Flags().BoolVar(&consolePrint, "print", false, "Print the console URL instead of opening it")
+}
+
+func runConsole(cmd *cobra.Command, args []string) error {
+	base, err := cfg.Console()
+	if err != nil {
+		return err
+	}
+
+	var path []string
+	if len(args) > 0 {
+		if path, err = consolePath(cmd, args); err != nil {
+			return err
+		}
+	}
+	consoleURL := base.JoinPath(path...).String()
+
+	if consolePrint {
+		fmt.Println(consoleURL)
+		return nil
+	}
+	if err := openBrowser(consoleURL); err != nil {
+		fmt.Fprintf(os.Stderr, "Could not open a browser: %v\n", err)
+		fmt.Printf("Please open this URL in your browser:\n%s\n", consoleURL)
+		return nil
+	}
+	if !quiet {
+		fmt.Printf("Opening %s\n", consoleURL)
+	}
+	return nil
+}
+
+// consolePath resolves the resource named by args to the path segments of its
+// console page
+func consolePath(cmd *cobra.Command, args []string) ([]string, error) {
+	ctx := cmd.Context()
+
+	kind, err := parseResourceKind(args[0])
+	if err != nil {
+		return nil, err
+	}
+	name := ""
+	if len(args) == 2 {
+		name = args[1]
+	}
+	if kind == kindTenant && name == "" {
+		return nil, fmt.Errorf("a tenant name is required")
+	}
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return nil, errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+
+	switch kind {
+	case kindOrganization:
+		var orgID string
+		if name != "" {
+			orgID, err = resolveOrganizationID(ctx, client, name, "")
+		} else {
+			orgID, err = requireOrgID(ctx, client)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []string{"organizations", orgID}, nil
+
+	case kindProject:
+		if name != "" {
+			if err := selectProject(ctx, name); err != nil {
+				return nil, err
+			}
+		}
+		projectID, err := requireProjectID()
+		if err != nil {
+			return nil, err
+		}
+		return []string{"projects", projectID}, nil
+
+	default:
+		projectID, err := requireProjectID()
+		if err != nil {
+			return nil, err
+		}
+		tenantID, err := resolveTenantID(ctx, client, name, "", projectID)
+		if err != nil {
+			return nil, err
+		}
+		return []string{"projects", projectID, "tenants", tenantID}, nil
+	}
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"spacectl/internal/api"
+
+	"github.com/spf13/pflag"
+)
+
+// The project and organization a command works on are chosen with global flags
+// and resolved to IDs once, before the command runs, so subcommands only need
+// contextProjectID and contextOrgID.
+var (
+	contextProjectFlag     string
+	contextProjectNameFlag string
+	contextOrgFlag         string
+	contextOrgNameFlag     string
+
+	// Resolved in PersistentPreRunE. projectChosen is false when the project
+	// is the configured default rather than one given on the command line.
+	selectedProjectID string
+	selectedOrgID     string
+	projectChosen     bool
+)
+
+// contextFlagAliases maps the per-command flag names of earlier versions to the global flags
+var contextFlagAliases = map[string]string{
+	"project-id": "project",
+	"org-id":     "org",
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&contextProjectFlag, "project", "", "Project ID to work in (defaults to the default project)")
+	rootCmd.PersistentFlags().StringVarP(&contextProjectNameFlag, "project-name", "p", "", "Project name, or organization/project, to work in (alternative to --project)")
+	rootCmd.PersistentFlags().StringVar(&contextOrgFlag, "org", "", "Organization ID to work in (defaults to your default organization)")
+	rootCmd.PersistentFlags().StringVar(&contextOrgNameFlag, "org-name", "", "Organization name to work in (alternative to --org)")
+	rootCmd.SetGlobalNormalizationFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
+		if alias, ok := contextFlagAliases[name]; ok {
+			name = alias
+		}
+		return pflag.NormalizedName(name)
+	})
+}
+
+// resolveContextFlags resolves the project and organization flags to IDs. Names
+// are looked up with the API, the project within the organization when both are
+// given; without a project flag the configured default project is used.
+func resolveContextFlags(ctx context.Context) error {
+	if contextProjectFlag != "" && contextProjectNameFlag != "" {
+		return fmt.Errorf("only one of --project or --project-name is allowed")
+	}
+	if contextOrgFlag != "" && contextOrgNameFlag != "" {
+		return fmt.Errorf("only one of --org or --org-name is allowed")
+	}
+
+	selectedOrgID = contextOrgFlag
+	selectedProjectID = contextProjectFlag
+	projectChosen = contextProjectFlag != "" || contextProjectNameFlag != ""
+	if contextOrgNameFlag == "" && contextProjectNameFlag == "" {
+		if selectedProjectID == "" {
+			selectedProjectID = cfg.DefaultProject
+		}
+		return nil
+	}
+
+	// Names can only be resolved when logged in
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+	client := apiClient()
+
+	var err error
+	if contextOrgNameFlag != "" {
+		if selectedOrgID, err = resolveOrganizationID(ctx, client, contextOrgNameFlag, ""); err != nil {
+			return err
+		}
+	}
+	if contextProjectNameFlag != "" {
+		if selectedProjectID, err = resolveProjectID(ctx, client, contextProjectNameFlag, "", selectedOrgID); err != nil {
+			return err
+		}
+	} else if selectedProjectID == "" {
+		selectedProjectID = cfg.DefaultProject
+	}
+	return nil
+}
+
+// selectProject makes the named project, within the selected organization if
+// any, the one the command works on
+func selectProject(ctx context.Context, name string) error {
+	projectID, err := resolveProjectID(ctx, apiClient(), name, "", selectedOrgID)
+	if err != nil {
+		return err
+	}
+	selectedProjectID = projectID
+	projectChosen = true
+	return nil
+}
+
+// projectFlagSet reports whether a project was chosen on the command line, as
+// opposed to coming from the default project
+func projectFlagSet() bool {
+	return projectChosen
+}
+
+// orgFlagSet reports whether an organization was chosen on the command line
+func orgFlagSet() bool {
+	return contextOrgFlag != "" || contextOrgNameFlag != ""
+}
+
+// contextProjectID returns the selected project ID, or "" if there is none
+func contextProjectID() string {
+	return selectedProjectID
+}
+
+// requireProjectID returns the selected project ID, or an error explaining how to choose one
+func requireProjectID() (string, error) {
+	if selectedProjectID == "" {
+		return "", fmt.Errorf("no project specified. Pass --project or --project-name, or set a default with 'spacectl project set-default'")
+	}
+	return selectedProjectID, nil
+}
+
+// requireExplicitProjectID returns the project chosen on the command line. It is
+// used by commands that should not silently act on the default project.
+func requireExplicitProjectID() (string, error) {
+	if !projectFlagSet() {
+		return "", fmt.Errorf("a project is required. Pass --project or --project-name")
+	}
+	return selectedProjectID, nil
+}
+
+// contextOrgID returns the selected organization ID, or "" if there is none
+func contextOrgID() string {
+	return selectedOrgID
+}
+
+// requireOrgID returns the selected organization ID, falling back to the user's
+// default organization
+func requireOrgID(ctx context.Context, client *api.Client) (string, error) {
+	if selectedOrgID != "" {
+		return selectedOrgID, nil
+	}
+	org, err := api.NewOrganizationAPI(client).GetDefaultOrganization(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get default organization: %w", err)
+	}
+	return org.ID, nil
+}
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// costCmd represents the cost command
+var costCmd = &cobra.Command{
+	Use:   "cost",
+	Short: "Show what tenants cost",
+	Long: `Show what the tenants of an organization cost, for chargeback to the teams
+that own them.`,
+}
+
+// costExportCmd represents the cost export command
+var costExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a per-tenant cost breakdown for a month",
+	Long: `Export what each tenant of an organization cost in a calendar month, with the
+core and memory hours it was billed for. Tenants deleted during the month are
+included. Use -o csv for chargeback spreadsheets.
+
+The organization is the one given with --org or --org-name, or your default
+organization. With --project or --project-name, only that project's tenants are
+included. --month defaults to the previous month.
+
+Examples:
+  spacectl cost export --org-name acme --month 2025-01 -o csv > costs-2025-01.csv
+  spacectl cost export --project-name web
+  spacectl cost export --month 2025-01 -o json | jq 'map(.total_cost) | add'`,
+	Args: cobra.NoArgs,
+	RunE: runCostExport,
+}
+
+var costMonth string
+
+func init() {
+	rootCmd.AddCommand(costCmd)
+	costCmd.AddCommand(costExportCmd)
+	costExportCmd.Flags().StringVar(&costMonth, "month", "", "Calendar month as YYYY-MM (default: the previous month)")
+}
+
+func runCostExport(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	month, err := parseMonth(costMonth, time.Now())
+	if err != nil {
+		return err
+	}
+
+	// Create API client
+	client := apiClient()
+	orgID, err := requireOrgID(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	// Only filter by project when one is given, not by the default project
+	projectID := ""
+	if projectFlagSet() {
+		projectID = contextProjectID()
+	}
+	costs, err := api.NewBillingAPI(client).ListTenantCosts(ctx, orgID, month, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to list tenant costs: %w", err)
+	}
+	sort.SliceStable(costs, func(i, j int) bool {
+		if costs[i].ProjectName != costs[j].ProjectName {
+			return costs[i].ProjectName < costs[j].ProjectName
+		}
+		return costs[i].TenantName < costs[j].TenantName
+	})
+
+	// Structured formats get the full entries
+	if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML {
+		return formatter.FormatData(costs)
+	}
+
+	if len(costs) == 0 {
+		if !quiet {
+			fmt.Printf("No tenant costs for %s\n", month.Format("2006-01"))
+		}
+		return nil
+	}
+
+	rows := make([]map[string]interface{}, 0, len(costs))
+	for _, c := range costs {
+		rows = append(rows, costRecord(c))
+	}
+	if err := formatter.FormatRows(rows, costColumns); err != nil {
+		return err
+	}
+
+	// A total row would break spreadsheet formulas, so only tables get one
+	if !quiet && output.Format(outputFmt) == output.FormatTable {
+		fmt.Printf("\nTotal: %s\n", costTotals(costs))
+	}
+	return nil
+}
+
+// parseMonth parses a YYYY-MM month, returning the first day of the month
+// before now when value is empty
+func parseMonth(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		year, month, _ := now.Date()
+		return time.Date(year, month-1, 1, 0, 0, 0, 0, time.UTC), nil
+	}
+	month, err := time.Parse("2006-01", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --month %q: use YYYY-MM, e.g. 2025-01", value)
+	}
+	return month, nil
+}
+
+// costColumns are the columns of costRecord rows in display order
+var costColumns = []string{"month", "project", "tenant", "tenant_id", "cpu_core_hours", "memory_gb_hours", "compute_cost", "memory_cost", "total_cost", "currency"}
+
+// costRecord builds the table and CSV row for a tenant's cost
+func costRecord(c models.TenantCost) map[string]interface{} {
+	return map[string]interface{}{
+		"month":           c.Month,
+		"project":         c.ProjectName,
+		"tenant":          c.TenantName,
+		"tenant_id":       c.TenantID,
+		"cpu_core_hours":  formatAmount(c.CPUCoreHours),
+		"memory_gb_hours": formatAmount(c.MemoryGBHours),
+		"compute_cost":    formatAmount(c.ComputeCost),
+		"memory_cost":     formatAmount(c.MemoryCost),
+		"total_cost":      formatAmount(c.TotalCost),
+		"currency":        c.Currency,
+	}
+}
+
+// costTotals sums the costs per currency, e.g. "51.86 EUR"
+func costTotals(costs []models.TenantCost) string {
+	totals := map[string]float64{}
+	for _, c := range costs {
+		totals[c.Currency] += c.TotalCost
+	}
+	currencies := make([]string, 0, len(totals))
+	for currency := range totals {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	parts := make([]string, len(currencies))
+	for i, currency := range currencies {
+		parts[i] = formatAmount(totals[currency]) + " " + currency
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatAmount formats hours and costs with two decimals, without thousands
+// separators so spreadsheets read them as numbers
+func formatAmount(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
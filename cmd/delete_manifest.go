@@ -0,0 +1,317 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"spacectl/internal/api"
+	"spacectl/internal/manifest"
+	"spacectl/internal/parallel"
+
+	"github.com/spf13/cobra"
+)
+
+// deleteWaitInterval is how often delete -f --wait checks whether resources are gone
+const deleteWaitInterval = 5 * time.Second
+
+// manifestTarget is an existing resource described by a manifest
+type manifestTarget struct {
+	kind   resourceKind
+	name   string
+	id     string
+	parent string
+}
+
+// deleteOrder is the order resources are deleted in: children before their parents
+var deleteOrder = map[resourceKind]int{
+	kindTenant:       0,
+	kindProject:      1,
+	kindOrganization: 2,
+}
+
+// runDeleteFile deletes the resources described by the manifests in the files
+// or directories given with -f, tenants first, then projects, then organizations
+func runDeleteFile(cmd *cobra.Command, paths []string) error {
+	ctx := cmd.Context()
+
+	values, err := manifestValues()
+	if err != nil {
+		return err
+	}
+	var resources []interface{}
+	for _, path := range paths {
+		decoded, err := manifest.ReadPath(path, values)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, decoded...)
+	}
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+
+	targets, err := resolveManifestTargets(ctx, client, resources)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		if !quiet {
+			fmt.Println("No resources to delete.")
+		}
+		return nil
+	}
+	sort.SliceStable(targets, func(i, j int) bool {
+		return deleteOrder[targets[i].kind] < deleteOrder[targets[j].kind]
+	})
+
+	// Ask for confirmation unless --force or --yes is used
+	var question strings.Builder
+	fmt.Fprintf(&question, "Are you sure you want to delete these %d resources, in this order? This action cannot be undone.\n", len(targets))
+	for _, t := range targets {
+		fmt.Fprintf(&question, "  %s\n", t)
+	}
+	if ok, err := confirmTyped(question.String(), "the number of resources", strconv.Itoa(len(targets)), verbForce); err != nil || !ok {
+		return err
+	}
+
+	// Record the outcome of each target for the CI job summary
+	results := make([]string, len(targets))
+	for i := range results {
+		results[i] = "not deleted"
+	}
+	defer func() {
+		if err := annotator.Summary("spacectl delete", deleteSummary(targets, results)); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+	}()
+
+	// Delete one kind at a time so that, with --wait, children are gone before
+	// their parents are deleted
+	for start := 0; start < len(targets); {
+		end := start
+		for end < len(targets) && targets[end].kind == targets[start].kind {
+			end++
+		}
+		batch, batchResults := targets[start:end], results[start:end]
+		start = end
+
+		// Resources of one kind are deleted --concurrency at a time
+		errs := parallel.Map(batch, concurrency, func(t manifestTarget) error {
+			return deleteManifestTarget(ctx, client, t)
+		})
+		var deleted []manifestTarget
+		for i, t := range batch {
+			if err := errs[i]; err != nil {
+				fmt.Fprintf(os.Stderr, "Error: failed to delete %s: %v\n", t, err)
+				annotator.Error("failed to delete "+string(t.kind)+" "+t.name, err.Error())
+				batchResults[i] = "**failed**"
+				continue
+			}
+			deleted = append(deleted, t)
+			batchResults[i] = "deleted"
+			if !quiet {
+				fmt.Printf("Successfully deleted %s\n", t)
+			}
+		}
+		if len(deleted) < len(batch) {
+			return fmt.Errorf("failed to delete %d of %d %ss; stopping before deleting their parents", len(batch)-len(deleted), len(batch), batch[0].kind)
+		}
+
+		if verbWait {
+			section := "delete-wait-" + string(batch[0].kind)
+			annotator.StartSection(section, fmt.Sprintf("Waiting for %s %s to be deleted", batch[0].kind, targetNames(deleted)))
+			err := waitForDeletion(ctx, client, deleted)
+			annotator.EndSection(section)
+			if err != nil {
+				return err
+			}
+			for i := range batchResults {
+				batchResults[i] = "gone"
+			}
+		}
+	}
+	return nil
+}
+
+// String describes the target for the preview and progress messages
+func (t manifestTarget) String() string {
+	if t.parent != "" {
+		return fmt.Sprintf("%s %s (%s, ID: %s)", t.kind, t.name, t.parent, t.id)
+	}
+	return fmt.Sprintf("%s %s (ID: %s)", t.kind, t.name, t.id)
+}
+
+// resolveManifestTargets looks up the resources described by the manifests.
+// Resources that do not exist are skipped with a warning, so that deleting the
+// same manifests twice succeeds.
+func resolveManifestTargets(ctx context.Context, client *api.Client, resources []interface{}) ([]manifestTarget, error) {
+	var targets []manifestTarget
+	seen := make(map[string]bool)
+	for _, r := range resources {
+		var target manifestTarget
+		var parentName string
+		switch m := r.(type) {
+		case *manifest.Organization:
+			target = manifestTarget{kind: kindOrganization, name: m.Metadata.Name}
+		case *manifest.Project:
+			target = manifestTarget{kind: kindProject, name: m.Metadata.Name}
+			parentName = m.Metadata.Organization
+		case *manifest.Tenant:
+			target = manifestTarget{kind: kindTenant, name: m.Metadata.Name}
+			parentName = m.Metadata.Project
+		default:
+			return nil, fmt.Errorf("unsupported manifest %T", r)
+		}
+		if target.name == "" {
+			return nil, fmt.Errorf("%s manifest without metadata.name", target.kind)
+		}
+
+		var err error
+		switch target.kind {
+		case kindOrganization:
+			target.id, err = resolveOrganizationID(ctx, client, target.name, "")
+		case kindProject:
+			var orgID string
+			if orgID, target.parent, err = manifestOrgID(ctx, client, parentName); err == nil {
+				target.id, err = resolveProjectID(ctx, client, target.name, "", orgID)
+			}
+		default:
+			var projectID string
+			if projectID, target.parent, err = manifestProjectID(ctx, client, parentName); err == nil {
+				target.id, err = resolveTenantID(ctx, client, target.name, "", projectID)
+			}
+		}
+		if errors.Is(err, api.ErrNotFound) {
+			fmt.Fprintf(os.Stderr, "Warning: skipping %s %s: %v\n", target.kind, target.name, err)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		key := string(target.kind) + "/" + target.id
+		if !seen[key] {
+			seen[key] = true
+			targets = append(targets, target)
+		}
+	}
+	return targets, nil
+}
+
+// manifestOrgID returns the ID of the organization named in a project manifest
+// and a label for it, falling back to the selected or default organization
+func manifestOrgID(ctx context.Context, client *api.Client, name string) (string, string, error) {
+	if name == "" || orgFlagSet() {
+		id, err := requireOrgID(ctx, client)
+		return id, "", err
+	}
+	id, err := resolveOrganizationID(ctx, client, name, "")
+	return id, "organization " + name, err
+}
+
+// manifestProjectID returns the ID of the project named in a tenant manifest
+// and a label for it, falling back to the selected or default project
+func manifestProjectID(ctx context.Context, client *api.Client, name string) (string, string, error) {
+	if name == "" || projectFlagSet() {
+		id, err := requireProjectID()
+		return id, "", err
+	}
+	id, err := resolveProjectID(ctx, client, name, "", contextOrgID())
+	return id, "project " + name, err
+}
+
+// deleteManifestTarget deletes a single resource
+func deleteManifestTarget(ctx context.Context, client *api.Client, t manifestTarget) error {
+	switch t.kind {
+	case kindOrganization:
+		return api.NewOrganizationAPI(client).DeleteOrganization(ctx, t.id)
+	case kindProject:
+		return api.NewProjectAPI(client).DeleteProject(ctx, t.id)
+	default:
+		return api.NewTenantAPI(client).DeleteTenant(ctx, t.id)
+	}
+}
+
+// waitForDeletion polls until every target is gone or --wait-timeout expires
+func waitForDeletion(ctx context.Context, client *api.Client, targets []manifestTarget) error {
+	if len(targets) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, verbWaitTimeout)
+	defer cancel()
+
+	if !quiet {
+		fmt.Printf("Waiting for %s %s to be deleted...\n", targets[0].kind, targetNames(targets))
+	}
+	pending := targets
+	for {
+		var remaining []manifestTarget
+		for _, t := range pending {
+			gone, err := manifestTargetGone(ctx, client, t)
+			if err != nil && ctx.Err() == nil {
+				return fmt.Errorf("failed to check %s: %w", t, err)
+			}
+			if !gone {
+				remaining = append(remaining, t)
+			}
+		}
+		if len(remaining) == 0 {
+			return nil
+		}
+		pending = remaining
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %s waiting for %s %s to be deleted", verbWaitTimeout, pending[0].kind, targetNames(pending))
+		case <-time.After(deleteWaitInterval):
+		}
+	}
+}
+
+// deleteSummary lists the targets and what happened to them as Markdown for
+// CI job summaries
+func deleteSummary(targets []manifestTarget, results []string) string {
+	var b strings.Builder
+	b.WriteString("| Kind | Name | Parent | ID | Result |\n|---|---|---|---|---|\n")
+	for i, t := range targets {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n", t.kind, t.name, t.parent, t.id, results[i])
+	}
+	return b.String()
+}
+
+// targetNames joins the names of targets for messages
+func targetNames(targets []manifestTarget) string {
+	names := make([]string, len(targets))
+	for i, t := range targets {
+		names[i] = t.name
+	}
+	return strings.Join(names, ", ")
+}
+
+// manifestTargetGone reports whether a deleted resource no longer exists
+func manifestTargetGone(ctx context.Context, client *api.Client, t manifestTarget) (bool, error) {
+	var err error
+	switch t.kind {
+	case kindOrganization:
+		_, err = api.NewOrganizationAPI(client).GetOrganization(ctx, t.id)
+	case kindProject:
+		_, err = api.NewProjectAPI(client).GetProject(ctx, t.id)
+	default:
+		_, err = api.NewTenantAPI(client).GetTenant(ctx, t.id)
+	}
+	if errors.Is(err, api.ErrNotFound) {
+		return true, nil
+	}
+	return false, err
+}
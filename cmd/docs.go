@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"spacectl/internal/version"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/cobra/doc"
+)
+
+// docsCmd represents the docs command
+var docsCmd = &cobra.Command{
+	Use:   "docs",
+	Short: "Generate documentation for spacectl",
+}
+
+// docsGenerateCmd represents the docs generate command
+var docsGenerateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generate man pages or a Markdown reference",
+	Long: `Generate documentation for every spacectl command, one file per command, from
+the same help text that spacectl prints. Man pages are written as section 1
+pages for packagers; Markdown files link to each other and form a reference
+that can be published as is.
+
+Examples:
+  spacectl docs generate --format man --dir ./man/man1
+  spacectl docs generate --format markdown --dir ./docs/reference`,
+	Args: cobra.NoArgs,
+	RunE: runDocsGenerate,
+}
+
+var (
+	docsFormat string
+	docsDir    string
+)
+
+func init() {
+	rootCmd.AddCommand(docsCmd)
+	docsCmd.AddCommand(docsGenerateCmd)
+
+	docsGenerateCmd.Flags().StringVar(&docsFormat, "format", "markdown", "Documentation format: man or markdown")
+	docsGenerateCmd.Flags().StringVar(&docsDir, "dir", "docs", "Directory to write the files to (created if missing)")
+}
+
+func runDocsGenerate(cmd *cobra.Command, args []string) error {
+	if err := os.MkdirAll(docsDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", docsDir, err)
+	}
+
+	// Keep the generated files reproducible across builds
+	rootCmd.DisableAutoGenTag = true
+
+	var err error
+	switch docsFormat {
+	case "man":
+		err = doc.GenManTree(rootCmd, &doc.GenManHeader{
+			Title:   "SPACECTL",
+			Section: "1",
+			Source:  "spacectl " + version.Version,
+			Manual:  "spacectl Manual",
+		}, docsDir)
+	case "markdown", "md":
+		err = doc.GenMarkdownTree(rootCmd, docsDir)
+	default:
+		return fmt.Errorf("unsupported format %q (expected man or markdown)", docsFormat)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to generate %s documentation: %w", docsFormat, err)
+	}
+
+	if !quiet {
+		fmt.Printf("Wrote %s documentation to %s\n", docsFormat, docsDir)
+	}
+	return nil
+}
@@ -0,0 +1,387 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+	"spacectl/internal/names"
+	"spacectl/internal/units"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// editCmd represents the edit command
+var editCmd = &cobra.Command{
+	Use:   "edit <resource> [name]",
+	Short: "Edit a resource in your editor",
+	Long: `Open the editable fields of an organization, project, or tenant as YAML in your
+editor and update the resource with the fields you changed when you save and quit.
+Leaving the file unchanged or empty cancels the edit.
+
+The editor is taken from SPACECTL_EDITOR, VISUAL, or EDITOR, in that order, and
+defaults to vi (notepad on Windows).
+
+Tenants are looked up in the selected or default project.
+
+Examples:
+  spacectl edit tenant dev
+  spacectl edit tenant --name dev -p my-project
+  spacectl edit project my-project
+  spacectl edit org --id <org-id>`,
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: completeResourceKinds,
+	RunE:              runEdit,
+}
+
+var (
+	editName string
+	editID   string
+)
+
+func init() {
+	rootCmd.AddCommand(editCmd)
+
+	editCmd.Flags().StringVar(&editName, "name", "", "Name of the resource")
+	editCmd.Flags().StringVar(&editID, "id", "", "ID of the resource")
+}
+
+// tenantEdit holds the fields of a tenant that can be edited
+type tenantEdit struct {
+	KubernetesVersion string      `yaml:"kubernetes_version"`
+	ComputeQuota      units.Cores `yaml:"compute_quota"`
+	MemoryQuota       units.GiB   `yaml:"memory_quota"`
+}
+
+// projectEdit holds the fields of a project that can be edited
+type projectEdit struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	MaxTenants  int    `yaml:"max_tenants"`
+	MaxCompute  int    `yaml:"max_compute"`
+	MaxMemoryGB int    `yaml:"max_memory_gb"`
+}
+
+// organizationEdit holds the fields of an organization that can be edited
+type organizationEdit struct {
+	Name string `yaml:"name"`
+}
+
+func runEdit(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	kind, err := parseResourceKind(args[0])
+	if err != nil {
+		return err
+	}
+	name := editName
+	if len(args) == 2 {
+		if name != "" {
+			return fmt.Errorf("pass the name either as an argument or with --name, not both")
+		}
+		name = args[1]
+	}
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+	if err := checkInteractive(); err != nil {
+		return fmt.Errorf("cannot open an editor: %w", err)
+	}
+
+	// Create API client
+	client := apiClient()
+
+	switch kind {
+	case kindOrganization:
+		return editOrganization(ctx, client, name)
+	case kindProject:
+		return editProject(ctx, client, name)
+	default:
+		return editTenant(ctx, client, name)
+	}
+}
+
+func editTenant(ctx context.Context, client *api.Client, name string) error {
+	tenantAPI := api.NewTenantAPI(client)
+
+	id, err := resolveOrPickTenantID(ctx, client, name, editID, contextProjectID())
+	if err != nil {
+		return err
+	}
+	tenant, err := tenantAPI.GetTenant(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	old := tenantEdit{
+		KubernetesVersion: tenant.KubernetesVersion,
+		ComputeQuota:      units.Cores(tenant.ComputeQuota),
+		MemoryQuota:       units.GiB(tenant.MemoryQuotaGB),
+	}
+	edited := old
+	ok, err := editInEditor(fmt.Sprintf("tenant %s (%s)", tenant.Name, tenant.ID), &edited)
+	if err != nil || !ok {
+		return err
+	}
+
+	var req models.UpdateTenantRequest
+	var changes []string
+	if edited.KubernetesVersion != old.KubernetesVersion {
+		if strings.TrimSpace(edited.KubernetesVersion) == "" {
+			return fmt.Errorf("kubernetes_version must not be empty")
+		}
+		req.KubernetesVersion = &edited.KubernetesVersion
+		changes = append(changes, describeChange("kubernetes_version", old.KubernetesVersion, edited.KubernetesVersion))
+	}
+	if edited.ComputeQuota != old.ComputeQuota {
+		if edited.ComputeQuota < 0 {
+			return fmt.Errorf("compute_quota must not be negative")
+		}
+		compute := int(edited.ComputeQuota)
+		req.ComputeQuota = &compute
+		changes = append(changes, describeChange("compute_quota", old.ComputeQuota, edited.ComputeQuota))
+	}
+	if edited.MemoryQuota != old.MemoryQuota {
+		if edited.MemoryQuota < 0 {
+			return fmt.Errorf("memory_quota must not be negative")
+		}
+		memory := int(edited.MemoryQuota)
+		req.MemoryQuotaGB = &memory
+		changes = append(changes, describeChange("memory_quota", old.MemoryQuota, edited.MemoryQuota))
+	}
+	if !reportEditChanges("tenant", tenant.Name, changes) {
+		return nil
+	}
+
+	if _, err := tenantAPI.UpdateTenant(ctx, tenant.ID, req); err != nil {
+		return fmt.Errorf("failed to update tenant: %w", err)
+	}
+	if !quiet {
+		fmt.Printf("Successfully updated tenant %s\n", tenant.Name)
+	}
+	return nil
+}
+
+func editProject(ctx context.Context, client *api.Client, name string) error {
+	projectAPI := api.NewProjectAPI(client)
+
+	id, err := resolveOrPickProjectID(ctx, client, name, editID, contextOrgID())
+	if err != nil {
+		return err
+	}
+	project, err := projectAPI.GetProject(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	old := projectEdit{
+		Name:        project.Name,
+		MaxTenants:  project.MaxTenants,
+		MaxCompute:  project.MaxCompute,
+		MaxMemoryGB: project.MaxMemoryGB,
+	}
+	if project.Description != nil {
+		old.Description = *project.Description
+	}
+	edited := old
+	ok, err := editInEditor(fmt.Sprintf("project %s (%s)", project.Name, project.ID), &edited)
+	if err != nil || !ok {
+		return err
+	}
+
+	var req models.PatchProjectRequest
+	var changes []string
+	if edited.Name != old.Name {
+		if err := names.ValidateProject(edited.Name); err != nil {
+			return err
+		}
+		req.Name = &edited.Name
+		changes = append(changes, describeChange("name", old.Name, edited.Name))
+	}
+	if edited.Description != old.Description {
+		req.Description = &edited.Description
+		changes = append(changes, describeChange("description", old.Description, edited.Description))
+	}
+	if edited.MaxTenants != old.MaxTenants {
+		req.MaxTenants = &edited.MaxTenants
+		changes = append(changes, describeChange("max_tenants", old.MaxTenants, edited.MaxTenants))
+	}
+	if edited.MaxCompute != old.MaxCompute {
+		req.MaxCompute = &edited.MaxCompute
+		changes = append(changes, describeChange("max_compute", old.MaxCompute, edited.MaxCompute))
+	}
+	if edited.MaxMemoryGB != old.MaxMemoryGB {
+		req.MaxMemoryGB = &edited.MaxMemoryGB
+		changes = append(changes, describeChange("max_memory_gb", old.MaxMemoryGB, edited.MaxMemoryGB))
+	}
+	if !reportEditChanges("project", project.Name, changes) {
+		return nil
+	}
+
+	updated, err := projectAPI.PatchProject(ctx, project.ID, req)
+	if err != nil {
+		return fmt.Errorf("failed to update project: %w", err)
+	}
+	if !quiet {
+		fmt.Printf("Successfully updated project %s\n", updated.Name)
+	}
+	return nil
+}
+
+func editOrganization(ctx context.Context, client *api.Client, name string) error {
+	orgAPI := api.NewOrganizationAPI(client)
+
+	id, err := resolveOrPickOrganizationID(ctx, client, name, editID)
+	if err != nil {
+		return err
+	}
+	org, err := orgAPI.GetOrganization(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+
+	old := organizationEdit{Name: org.Name}
+	edited := old
+	ok, err := editInEditor(fmt.Sprintf("organization %s (%s)", org.Name, org.ID), &edited)
+	if err != nil || !ok {
+		return err
+	}
+
+	var changes []string
+	if edited.Name != old.Name {
+		if strings.TrimSpace(edited.Name) == "" {
+			return fmt.Errorf("organization name must not be empty")
+		}
+		changes = append(changes, describeChange("name", old.Name, edited.Name))
+	}
+	if !reportEditChanges("organization", org.Name, changes) {
+		return nil
+	}
+
+	updated, err := orgAPI.UpdateOrganization(ctx, org.ID, edited.Name)
+	if err != nil {
+		return fmt.Errorf("failed to update organization: %w", err)
+	}
+	if !quiet {
+		fmt.Printf("Successfully updated organization %s\n", updated.Name)
+	}
+	return nil
+}
+
+// describeChange formats a changed field for the summary shown before updating
+func describeChange(field string, old, new interface{}) string {
+	return fmt.Sprintf("%s: %v -> %v", field, old, new)
+}
+
+// reportEditChanges prints the changes about to be submitted and reports
+// whether there are any
+func reportEditChanges(kind, name string, changes []string) bool {
+	if len(changes) == 0 {
+		printEditCancelled()
+		return false
+	}
+	if !quiet {
+		fmt.Printf("Updating %s %s:\n", kind, name)
+		for _, change := range changes {
+			fmt.Printf("  %s\n", change)
+		}
+	}
+	return true
+}
+
+// printEditCancelled tells the user that nothing was updated
+func printEditCancelled() {
+	if !quiet {
+		fmt.Println("Edit cancelled, no changes made.")
+	}
+}
+
+// editInEditor writes v as YAML to a temporary file, opens it in the user's
+// editor, and decodes the result back into v. It returns false, after telling
+// the user, when the file was left unchanged or emptied. If the result cannot
+// be decoded the file is kept so the edits are not lost.
+func editInEditor(title string, v interface{}) (bool, error) {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode %s: %w", title, err)
+	}
+	original := fmt.Sprintf("# Editing %s\n# Change the fields below, then save and quit to update it.\n# Leave the file unchanged or empty it to cancel.\n%s", title, data)
+
+	f, err := os.CreateTemp("", "spacectl-edit-*.yaml")
+	if err != nil {
+		return false, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	path := f.Name()
+	_, err = f.WriteString(original)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return false, fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if err := runEditor(path); err != nil {
+		os.Remove(path)
+		return false, err
+	}
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		os.Remove(path)
+		return false, fmt.Errorf("failed to read edited file: %w", err)
+	}
+	if bytes.Equal(edited, []byte(original)) {
+		os.Remove(path)
+		printEditCancelled()
+		return false, nil
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(edited))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(v); err != nil {
+		if errors.Is(err, io.EOF) {
+			os.Remove(path)
+			printEditCancelled()
+			return false, nil
+		}
+		return false, fmt.Errorf("invalid YAML: %w (your changes are saved in %s)", err, path)
+	}
+	os.Remove(path)
+	return true, nil
+}
+
+// runEditor opens path in the user's editor and waits for it to exit
+func runEditor(path string) error {
+	editor := ""
+	for _, env := range []string{"SPACECTL_EDITOR", "VISUAL", "EDITOR"} {
+		if editor = strings.TrimSpace(os.Getenv(env)); editor != "" {
+			break
+		}
+	}
+	if editor == "" {
+		editor = "vi"
+		if runtime.GOOS == "windows" {
+			editor = "notepad"
+		}
+	}
+
+	// The editor may come with arguments, e.g. "code --wait"
+	fields := strings.Fields(editor)
+	c := exec.Command(fields[0], append(fields[1:], path)...)
+	c.Stdin, c.Stdout, c.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("editor %q failed: %w", editor, err)
+	}
+	return nil
+}
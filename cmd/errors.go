@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"spacectl/internal/api"
+)
+
+// errNotAuthenticated is returned by commands that need a logged-in user
+var errNotAuthenticated = errors.New("not authenticated. Please run 'spacectl login' first")
+
+// Process exit codes, so scripts can branch on the kind of failure
+const (
+	ExitOK              = 0
+	ExitError           = 1
+	ExitUnauthenticated = 3
+	ExitForbidden       = 4
+	ExitNotFound        = 5
+	ExitConflict        = 6
+	ExitQuotaExceeded   = 7
+	ExitUnsupported     = 8
+	ExitInterrupted     = 130
+)
+
+// exitCodeError makes spacectl exit with a specific code without printing an
+// error, e.g. to pass on the exit status of a plugin
+type exitCodeError struct {
+	code int
+}
+
+func (e exitCodeError) Error() string {
+	return fmt.Sprintf("exit status %d", e.code)
+}
+
+// ExitCode returns the process exit code for an error returned by Execute
+func ExitCode(err error) int {
+	var exitErr exitCodeError
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.As(err, &exitErr):
+		return exitErr.code
+	case errors.Is(err, context.Canceled):
+		return ExitInterrupted
+	case errors.Is(err, errNotAuthenticated), errors.Is(err, api.ErrUnauthenticated):
+		return ExitUnauthenticated
+	case errors.Is(err, api.ErrQuotaExceeded):
+		return ExitQuotaExceeded
+	case errors.Is(err, api.ErrForbidden):
+		return ExitForbidden
+	case errors.Is(err, api.ErrNotFound):
+		return ExitNotFound
+	case errors.Is(err, api.ErrConflict):
+		return ExitConflict
+	case errors.Is(err, api.ErrUnsupported):
+		return ExitUnsupported
+	default:
+		return ExitError
+	}
+}
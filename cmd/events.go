@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// eventsCmd represents the events command
+var eventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Show tenant and project lifecycle events",
+	Long: `Show recent tenant and project lifecycle events, such as tenants being
+created, becoming ready, or failing, and projects being archived. Use the
+global --project or --org flags to only show events of one project or
+organization.
+
+With --follow, events are streamed from the API as they happen instead of
+polling. The stream reconnects and resumes automatically if the connection
+drops. Press Ctrl-C to stop.
+
+Examples:
+  spacectl events
+  spacectl events --follow
+  spacectl events --follow --project-name my-project
+  spacectl events --follow --org-name my-org -o json`,
+	Args: cobra.NoArgs,
+	RunE: runEvents,
+}
+
+var eventsFollow bool
+
+func init() {
+	rootCmd.AddCommand(eventsCmd)
+	eventsCmd.Flags().BoolVarP(&eventsFollow, "follow", "f", false, "Stream new events as they happen")
+	addListFlags(eventsCmd)
+}
+
+func runEvents(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	if eventsFollow && listWatch {
+		return fmt.Errorf("only one of --follow or --watch is allowed")
+	}
+
+	// Create API client
+	client := apiClient()
+	eventAPI := api.NewEventAPI(client)
+
+	// Only filter by project when one is given, not by the default project
+	filter := api.EventFilter{OrganizationID: contextOrgID()}
+	if projectFlagSet() {
+		filter.ProjectID = contextProjectID()
+	}
+
+	if !eventsFollow {
+		events, err := eventAPI.ListEvents(listContext(ctx), filter)
+		if err != nil {
+			return fmt.Errorf("failed to list events: %w", err)
+		}
+
+		// Structured formats get the full event objects
+		if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML {
+			return formatter.FormatData(events)
+		}
+
+		var rows []map[string]interface{}
+		for _, e := range events {
+			rows = append(rows, eventRecord(e))
+		}
+		return formatter.FormatRows(rows, eventColumns)
+	}
+
+	printer, err := newEventPrinter(os.Stdout, output.Format(outputFmt), noHeaders)
+	if err != nil {
+		return err
+	}
+	err = eventAPI.StreamEvents(ctx, filter, printer.print)
+	if ctx.Err() != nil {
+		// Stop cleanly on Ctrl-C
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to stream events: %w", err)
+	}
+	return nil
+}
+
+// eventRecord builds the table/CSV row for an event
+func eventRecord(e models.Event) map[string]interface{} {
+	resource := e.ResourceType + "/" + e.ResourceID
+	if e.ResourceName != "" {
+		resource = e.ResourceType + "/" + e.ResourceName
+	}
+	return map[string]interface{}{
+		"time":     e.CreatedAt.Local().Format("2006-01-02 15:04:05"),
+		"type":     e.Type,
+		"resource": resource,
+		"status":   e.Status,
+		"message":  e.Message,
+	}
+}
+
+// eventPrinter writes streamed events one at a time, since a table or document
+// cannot be rendered as a whole while the stream is open
+type eventPrinter struct {
+	w         io.Writer
+	format    output.Format
+	noHeaders bool
+	csv       *csv.Writer
+	started   bool
+}
+
+func newEventPrinter(w io.Writer, format output.Format, noHeaders bool) (*eventPrinter, error) {
+	switch format {
+	case output.FormatTable, output.FormatJSON, output.FormatYAML, output.FormatCSV:
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+	return &eventPrinter{w: w, format: format, noHeaders: noHeaders, csv: csv.NewWriter(w)}, nil
+}
+
+// eventColumns are the columns of eventRecord rows in display order
+var eventColumns = []string{"time", "type", "resource", "status", "message"}
+
+func (p *eventPrinter) print(e models.Event) error {
+	first := !p.started
+	p.started = true
+
+	switch p.format {
+	case output.FormatJSON:
+		// One object per line, so the stream can be piped to jq
+		return json.NewEncoder(p.w).Encode(e)
+
+	case output.FormatYAML:
+		data, err := yaml.Marshal(e)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(p.w, "---\n%s", data)
+		return err
+
+	case output.FormatCSV:
+		if first && !p.noHeaders {
+			if err := p.csv.Write(eventColumns); err != nil {
+				return err
+			}
+		}
+		record := eventRecord(e)
+		var row []string
+		for _, column := range eventColumns {
+			row = append(row, fmt.Sprintf("%v", record[column]))
+		}
+		if err := p.csv.Write(row); err != nil {
+			return err
+		}
+		p.csv.Flush()
+		return p.csv.Error()
+
+	default:
+		// Fixed-width columns keep rows aligned without buffering the stream
+		const row = "%-19s  %-24s  %-32s  %-12s  %s\n"
+		if first && !p.noHeaders {
+			fmt.Fprintf(p.w, row, "TIME", "TYPE", "RESOURCE", "STATUS", "MESSAGE")
+		}
+		record := eventRecord(e)
+		_, err := fmt.Fprintf(p.w, row, record["time"], record["type"], record["resource"], record["status"], record["message"])
+		return err
+	}
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"spacectl/internal/manifest"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// explainCmd represents the explain command
+var explainCmd = &cobra.Command{
+	Use:   "explain <kind>[.field...]",
+	Short: "Describe the fields of a manifest kind",
+	Long: `Show the fields of a manifest kind with their types and descriptions, to help
+author the YAML read by 'spacectl project import' and 'spacectl tenant create -f'.
+Follow the kind with a dotted field path to describe a single field.
+
+Kinds: project, tenant
+
+Examples:
+  spacectl explain tenant
+  spacectl explain tenant.spec
+  spacectl explain project.spec.members
+  spacectl explain tenant --recursive`,
+	Args: cobra.ExactArgs(1),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return []string{"project", "tenant"}, cobra.ShellCompDirectiveNoFileComp
+	},
+	RunE: runExplain,
+}
+
+var explainRecursive bool
+
+func init() {
+	rootCmd.AddCommand(explainCmd)
+
+	explainCmd.Flags().BoolVar(&explainRecursive, "recursive", false, "Show the nested fields of objects too")
+}
+
+func runExplain(cmd *cobra.Command, args []string) error {
+	kindName, path, _ := strings.Cut(args[0], ".")
+	// Accept the resource aliases of the verbs, e.g. "tenants" or "proj"
+	if kind, err := parseResourceKind(kindName); err == nil {
+		kindName = string(kind)
+	}
+	kind, field, err := manifest.Explain(kindName, path)
+	if err != nil {
+		return err
+	}
+
+	// Structured formats get the schema as a single document
+	if output.Format(outputFmt) != output.FormatTable {
+		return formatter.FormatData(field)
+	}
+
+	fmt.Printf("KIND:     %s\n", kind)
+	fmt.Printf("VERSION:  %s\n", manifest.APIVersion)
+	if path != "" {
+		fmt.Printf("\nFIELD:    %s <%s>\n", field.Name, field.Type)
+	}
+	fmt.Printf("\nDESCRIPTION:\n  %s\n", valueOrNone(field.Description))
+
+	if len(field.Fields) == 0 {
+		return nil
+	}
+	fmt.Println("\nFIELDS:")
+	printExplainFields(field.Fields, 1)
+	return nil
+}
+
+// printExplainFields prints fields at the given depth of indentation, descending
+// into objects with --recursive
+func printExplainFields(fields []manifest.Field, depth int) {
+	indent := strings.Repeat("  ", depth)
+	for _, f := range fields {
+		required := ""
+		if f.Required {
+			required = " -required-"
+		}
+		fmt.Printf("%s%s\t<%s>%s\n", indent, f.Name, f.Type, required)
+		if explainRecursive {
+			printExplainFields(f.Fields, depth+1)
+			continue
+		}
+		if f.Description != "" {
+			fmt.Printf("%s  %s\n", indent, f.Description)
+		}
+		fmt.Println()
+	}
+}
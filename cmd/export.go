@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"spacectl/internal/api"
+	"spacectl/internal/manifest"
+	"spacectl/internal/models"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export --output-dir <dir>",
+	Short: "Export an organization's projects and tenants as manifests",
+	Long: `Write a point-in-time snapshot of an organization to a new directory: one
+manifest for the organization, one per project (with its members), and one per
+tenant. 'spacectl apply -f <dir>' re-creates whatever is missing from the
+snapshot, here or in another environment.
+
+The members of each project are also written to members/<project>.yaml, in the
+format read by 'spacectl project members sync -f'. The apply command skips that
+subdirectory.
+
+The organization is the one given with --org or --org-name, or your default
+organization. The output directory must not exist or be empty, so a snapshot
+never mixes with an older one.
+
+Examples:
+  spacectl export --output-dir ./snapshot
+  spacectl export --org-name acme --output-dir ./snapshots/$(date +%F)`,
+	Args: cobra.NoArgs,
+	RunE: runExport,
+}
+
+var exportOutputDir string
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().StringVar(&exportOutputDir, "output-dir", "", "Directory to write the manifests to (must not exist or be empty)")
+	addConcurrencyFlag(exportCmd)
+	exportCmd.MarkFlagRequired("output-dir")
+}
+
+// unsafeFileChars matches characters that are replaced in snapshot file names
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+func runExport(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+	if entries, err := os.ReadDir(exportOutputDir); err == nil && len(entries) > 0 {
+		return fmt.Errorf("output directory %s is not empty", exportOutputDir)
+	}
+
+	// Create API client
+	client := apiClient()
+	orgAPI := api.NewOrganizationAPI(client)
+	projectAPI := api.NewProjectAPI(client)
+	tenantAPI := api.NewTenantAPI(client)
+
+	orgID, err := requireOrgID(ctx, client)
+	if err != nil {
+		return err
+	}
+	org, err := orgAPI.GetOrganization(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+	projects, err := projectAPI.ListOrganizationProjects(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to list organization projects: %w", err)
+	}
+
+	// Fetch everything before writing, so a failure leaves no partial snapshot
+	projectIDs := make([]string, len(projects))
+	for i, p := range projects {
+		projectIDs[i] = p.ID
+	}
+	tenants, failed := fetchProjectTenants(ctx, tenantAPI, projectIDs)
+	for _, p := range projects {
+		if failed[p.ID] {
+			return fmt.Errorf("failed to list tenants in project %s", p.Name)
+		}
+	}
+	members := make(map[string][]projectMemberView, len(projects))
+	for _, p := range projects {
+		list, err := projectAPI.ListProjectMembers(ctx, p.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list members of project %s: %w", p.Name, err)
+		}
+		members[p.ID] = projectMemberViews(ctx, client, list)
+	}
+
+	if err := os.MkdirAll(filepath.Join(exportOutputDir, "members"), 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", exportOutputDir, err)
+	}
+	if err := writeSnapshotFile(manifest.NewOrganization(org.Name), "organization.yaml"); err != nil {
+		return err
+	}
+
+	tenantCount := 0
+	for _, p := range projects {
+		project := p
+		m := projectManifest(&project, members[p.ID])
+		m.Metadata.Organization = org.Name
+		if err := writeSnapshotFile(m, "project-"+fileName(p.Name)+".yaml"); err != nil {
+			return err
+		}
+
+		if err := writeSnapshotFile(exportMembersFile(members[p.ID]), filepath.Join("members", fileName(p.Name)+".yaml")); err != nil {
+			return err
+		}
+
+		for _, t := range tenants[p.ID] {
+			if err := writeSnapshotFile(tenantManifest(t, p.Name), "tenant-"+fileName(p.Name)+"-"+fileName(t.Name)+".yaml"); err != nil {
+				return err
+			}
+			tenantCount++
+		}
+	}
+
+	if !quiet {
+		fmt.Printf("Exported organization %s with %d projects and %d tenants to %s\n", org.Name, len(projects), tenantCount, exportOutputDir)
+	}
+	return nil
+}
+
+// tenantManifest converts a tenant to a manifest
+func tenantManifest(t models.Tenant, projectName string) *manifest.Tenant {
+	m := manifest.NewTenant(t.Name)
+	m.Metadata.Project = projectName
+	m.Spec = manifest.TenantSpec{
+		CloudProvider:     t.CloudProvider,
+		Region:            t.Region,
+		KubernetesVersion: t.KubernetesVersion,
+		ComputeQuota:      t.ComputeQuota,
+		MemoryQuotaGB:     t.MemoryQuotaGB,
+	}
+	return m
+}
+
+// exportMembersFile lists a project's members for 'project members sync',
+// by email where it is known and otherwise by user ID
+func exportMembersFile(members []projectMemberView) membersFile {
+	file := membersFile{Members: make([]declaredMember, len(members))}
+	for i, m := range members {
+		file.Members[i] = declaredMember{Email: m.Email, Role: m.Role}
+		if m.Email == "" {
+			file.Members[i].UserID = m.UserID
+		}
+	}
+	return file
+}
+
+// writeSnapshotFile writes v as YAML to a file in the output directory
+func writeSnapshotFile(v interface{}, name string) error {
+	path := filepath.Join(exportOutputDir, name)
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	err = output.NewFormatter(output.FormatYAML, noHeaders, f).FormatData(v)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// fileName makes a resource name safe to use in a file name
+func fileName(name string) string {
+	return unsafeFileChars.ReplaceAllString(name, "_")
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestExportMembersFileSyncs(t *testing.T) {
+	setForTest(t, &exportOutputDir, t.TempDir())
+
+	// A member whose email could not be looked up is listed by user ID
+	members := []projectMemberView{
+		{UserID: "user-1", Email: "ann@example.com", Role: "admin"},
+		{UserID: "user-2", Role: "viewer"},
+	}
+	if err := writeSnapshotFile(exportMembersFile(members), "web.yaml"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := readMembersFile(filepath.Join(exportOutputDir, "web.yaml"))
+	if err != nil {
+		t.Fatalf("expected the members file to be read by sync, got %v", err)
+	}
+	want := []declaredMember{
+		{Email: "ann@example.com", Role: "admin"},
+		{UserID: "user-2", Role: "viewer"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected members\nwant: %+v\ngot:  %+v", want, got)
+	}
+}
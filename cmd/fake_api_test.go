@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"spacectl/internal/api"
+	"spacectl/internal/config"
+)
+
+// fakeAccessToken is the token the fake API server expects on every request
+const fakeAccessToken = "test-access-token"
+
+// fakeResponse is the reply of the fake API server to one request. A zero
+// Status replies 200 OK.
+type fakeResponse struct {
+	Status int
+	Body   interface{}
+}
+
+// fakeAPI is a fake Kubespaces API server for command tests, replying to each
+// "METHOD /path?query" in its routes with a canned response
+type fakeAPI struct {
+	mu       sync.Mutex
+	requests []string
+}
+
+// newFakeAPI starts a fake API server with the given routes and logs in to it
+// for the duration of the test: cfg holds an access token and the settings,
+// prompts are disabled, and the returned client talks to the server.
+// Requests without a route fail the test.
+func newFakeAPI(t *testing.T, settings config.Config, routes map[string]fakeResponse) (*api.Client, *fakeAPI) {
+	t.Helper()
+
+	fake := &fakeAPI{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "Bearer "+fakeAccessToken {
+			t.Errorf("expected the access token to be sent, got Authorization %q", auth)
+		}
+		route := r.Method + " " + r.URL.RequestURI()
+		fake.mu.Lock()
+		fake.requests = append(fake.requests, route)
+		fake.mu.Unlock()
+
+		resp, ok := routes[route]
+		if !ok {
+			t.Errorf("unexpected request: %s", route)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		status := resp.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if resp.Body != nil {
+			json.NewEncoder(w).Encode(resp.Body)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	settings.AccessToken = fakeAccessToken
+	settings.APIURL = server.URL
+	setForTest(t, &cfg, &settings)
+	setForTest(t, &nonInteractive, true)
+
+	client := api.NewClient(server.URL, cfg, false)
+	client.SetRetries(0)
+	return client, fake
+}
+
+// received returns the requests the server received, as "METHOD /path?query"
+func (f *fakeAPI) received() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.requests...)
+}
+
+// notFound is the reply of the API to a resource that does not exist
+var notFound = fakeResponse{Status: http.StatusNotFound, Body: map[string]string{"error": "not found"}}
+
+// setForTest sets a package variable, such as a flag, until the end of the test
+func setForTest[T any](t *testing.T, p *T, v T) {
+	t.Helper()
+
+	old := *p
+	*p = v
+	t.Cleanup(func() { *p = old })
+}
@@ -38,8 +38,10 @@ func init() {
 }
 
 func runGithubLogin(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	authAPI := api.NewAuthAPI(client)
 
 	// Create a channel to receive the tokens
@@ -54,7 +56,7 @@ func runGithubLogin(cmd *cobra.Command, args []string) error {
 	server := startCallbackServer(githubCallbackPort, tokenChan)
 
 	// Get GitHub OAuth URL
-	authURL, err := authAPI.GetGithubAuthURL(githubCallbackPort)
+	authURL, err := authAPI.GetGithubAuthURL(ctx, githubCallbackPort)
 	if err != nil {
 		return fmt.Errorf("failed to get GitHub auth URL: %w", err)
 	}
@@ -97,6 +99,14 @@ func runGithubLogin(cmd *cobra.Command, args []string) error {
 
 		return nil
 
+	case <-ctx.Done():
+		if server != nil {
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+			server.Shutdown(shutdownCtx)
+		}
+		return fmt.Errorf("authentication cancelled")
+
 	case <-time.After(5 * time.Minute):
 		if server != nil {
 			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
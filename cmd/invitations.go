@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"spacectl/internal/api"
+	"spacectl/internal/tui"
+
+	"github.com/spf13/cobra"
+)
+
+// invitationsCmd represents the invitations command
+var invitationsCmd = &cobra.Command{
+	Use:   "invitations",
+	Short: "Manage your pending invitations",
+	Long:  `List, accept, and decline organization and project invitations sent to you.`,
+}
+
+func init() {
+	rootCmd.AddCommand(invitationsCmd)
+}
+
+// pendingInvitation is an organization or project invitation addressed to the current user
+type pendingInvitation struct {
+	ID      string
+	Type    string // "organization" or "project"
+	Target  string
+	Role    string
+	Expires time.Time
+}
+
+func (p pendingInvitation) label() string {
+	return fmt.Sprintf("%s %s as %s (%s)", p.Type, p.Target, p.Role, p.ID)
+}
+
+// listPendingInvitations merges the user's organization and project invitations
+func listPendingInvitations(ctx context.Context, client *api.Client) ([]pendingInvitation, error) {
+	orgAPI := api.NewOrganizationAPI(client)
+	projectAPI := api.NewProjectAPI(client)
+
+	orgInvitations, err := orgAPI.ListUserInvitations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization invitations: %w", err)
+	}
+	projectInvitations, err := projectAPI.ListUserProjectInvitations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list project invitations: %w", err)
+	}
+
+	var pending []pendingInvitation
+	for _, inv := range orgInvitations {
+		if inv.Status != "" && !strings.EqualFold(inv.Status, "pending") {
+			continue
+		}
+		pending = append(pending, pendingInvitation{
+			ID:      inv.ID,
+			Type:    "organization",
+			Target:  inv.Organization.Name,
+			Role:    inv.Role,
+			Expires: inv.ExpiresAt,
+		})
+	}
+	for _, inv := range projectInvitations {
+		if inv.Status != "" && !strings.EqualFold(inv.Status, "pending") {
+			continue
+		}
+		pending = append(pending, pendingInvitation{
+			ID:      inv.ID,
+			Type:    "project",
+			Target:  inv.Project.Name,
+			Role:    inv.Role,
+			Expires: inv.ExpiresAt,
+		})
+	}
+
+	return pending, nil
+}
+
+// invitationsListCmd represents the invitations list command
+var invitationsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List pending invitations",
+	Long:  `List pending organization and project invitations for the logged-in user.`,
+	Args:  cobra.NoArgs,
+	RunE:  runInvitationsList,
+}
+
+func init() {
+	invitationsCmd.AddCommand(invitationsListCmd)
+	addListFlags(invitationsListCmd)
+}
+
+func runInvitationsList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+
+	pending, err := listPendingInvitations(listContext(ctx), client)
+	if err != nil {
+		return err
+	}
+	pending = limitTotal(pending)
+
+	var rows []map[string]interface{}
+	for _, inv := range pending {
+		rows = append(rows, map[string]interface{}{
+			"id":      inv.ID,
+			"type":    inv.Type,
+			"target":  inv.Target,
+			"role":    inv.Role,
+			"expires": inv.Expires.Format(time.RFC3339),
+		})
+	}
+	return formatter.FormatRows(rows, []string{"id", "type", "target", "role", "expires"})
+}
+
+// invitationsAcceptCmd represents the invitations accept command
+var invitationsAcceptCmd = &cobra.Command{
+	Use:   "accept [invitation-id]",
+	Short: "Accept an invitation",
+	Long: `Accept an organization or project invitation by ID.
+
+Without an ID, your pending invitations are listed in the terminal: move with
+the arrow keys, mark invitations with a (accept), d (decline), or space, and
+confirm with enter. Enter alone accepts the selected invitation.
+
+Examples:
+  spacectl invitations accept
+  spacectl invitations accept inv-123`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return respondToInvitation(cmd.Context(), args, true)
+	},
+}
+
+// invitationsDeclineCmd represents the invitations decline command
+var invitationsDeclineCmd = &cobra.Command{
+	Use:   "decline [invitation-id]",
+	Short: "Decline an invitation",
+	Long: `Decline an organization or project invitation by ID.
+
+Without an ID, your pending invitations are listed in the terminal: move with
+the arrow keys, mark invitations with a (accept), d (decline), or space, and
+confirm with enter. Enter alone declines the selected invitation.
+
+Examples:
+  spacectl invitations decline
+  spacectl invitations decline inv-123`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return respondToInvitation(cmd.Context(), args, false)
+	},
+}
+
+func init() {
+	invitationsCmd.AddCommand(invitationsAcceptCmd)
+	invitationsCmd.AddCommand(invitationsDeclineCmd)
+}
+
+// respondToInvitation accepts or declines the invitation given in args, or one picked interactively
+func respondToInvitation(ctx context.Context, args []string, accept bool) error {
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+
+	pending, err := listPendingInvitations(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 1 {
+		for _, inv := range pending {
+			if inv.ID == args[0] {
+				return answerInvitation(ctx, client, inv, accept)
+			}
+		}
+		return fmt.Errorf("no pending invitation with ID %q", args[0])
+	}
+
+	if len(pending) == 0 {
+		return fmt.Errorf("you have no pending invitations")
+	}
+	if err := promptUnavailable("an invitation", "pass the invitation ID as an argument"); err != nil {
+		return err
+	}
+
+	// Let the user answer any of the invitations, preset to the command's answer
+	labels := make([]string, len(pending))
+	for i, inv := range pending {
+		labels[i] = inv.label()
+	}
+	preset := tui.Decline
+	if accept {
+		preset = tui.Accept
+	}
+	responses, err := tui.Respond(ctx, "Pending invitations", labels, preset)
+	if errors.Is(err, tui.ErrCanceled) {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Answer every marked invitation, even when one fails
+	var errs []error
+	for i, response := range responses {
+		if response == tui.NoResponse {
+			continue
+		}
+		if err := answerInvitation(ctx, client, pending[i], response == tui.Accept); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// answerInvitation accepts or declines a pending invitation
+func answerInvitation(ctx context.Context, client *api.Client, inv pendingInvitation, accept bool) error {
+	orgAPI := api.NewOrganizationAPI(client)
+	projectAPI := api.NewProjectAPI(client)
+
+	var err error
+	switch {
+	case inv.Type == "organization" && accept:
+		err = orgAPI.AcceptInvitation(ctx, inv.ID)
+	case inv.Type == "organization":
+		err = orgAPI.DeclineInvitation(ctx, inv.ID)
+	case accept:
+		err = projectAPI.AcceptProjectInvitation(ctx, inv.ID)
+	default:
+		err = projectAPI.DeclineProjectInvitation(ctx, inv.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to respond to invitation to %s %s: %w", inv.Type, inv.Target, err)
+	}
+
+	// Output success message
+	if !quiet {
+		verb := "declined"
+		if accept {
+			verb = "accepted"
+		}
+		fmt.Printf("Successfully %s invitation to %s %s\n", verb, inv.Type, inv.Target)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// kubectlPluginName is the executable name kubectl runs for "kubectl spaces".
+// The same binary installed under this name, e.g. through krew, behaves as a
+// kubectl plugin.
+const kubectlPluginName = "kubectl-spaces"
+
+// isKubectlPlugin reports whether spacectl was invoked as kubectl-spaces
+func isKubectlPlugin(argv0 string) bool {
+	name := filepath.Base(argv0)
+	name = strings.TrimSuffix(strings.ToLower(name), ".exe")
+	return name == kubectlPluginName
+}
+
+// useKubectlPluginName makes help, usage, and completion refer to "kubectl spaces"
+func useKubectlPluginName() {
+	if rootCmd.Annotations == nil {
+		rootCmd.Annotations = map[string]string{}
+	}
+	rootCmd.Annotations[cobra.CommandDisplayNameAnnotation] = "kubectl spaces"
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"spacectl/internal/api"
+
+	"github.com/spf13/cobra"
+)
+
+// listOptions are the shared list flags. Each list command registers them via
+// addListFlags: sorting and filtering are applied by the formatter so all
+// listings filter and sort alike, and pagination is applied to the API
+// requests made with listContext.
+type listOptions struct {
+	SortBy   string
+	Filters  []string
+	Limit    int
+	Page     int
+	AllPages bool
+}
+
+var listOpts = listOptions{AllPages: true}
+
+// listTruncated is set by list requests made with listContext that stopped
+// before the last item, so the listing can say that more results exist
+var listTruncated atomic.Bool
+
+// paginating is set while a list command runs, so list commands run by it,
+// such as the ones behind 'get', leave the truncation note to it
+var paginating bool
+
+// addListFlags registers the shared filtering, sorting, pagination, and watch
+// flags on a list command
+func addListFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&listOpts.SortBy, "sort-by", "", "Sort by column (prefix with - for descending, e.g. -tenant_count)")
+	cmd.Flags().StringArrayVar(&listOpts.Filters, "filter", nil, "Filter rows by column=value or column!=value (repeatable, supports * wildcards)")
+	cmd.Flags().IntVar(&listOpts.Limit, "limit", 0, "Maximum number of items to fetch, or the page size with --page (0 for no limit)")
+	cmd.Flags().IntVar(&listOpts.Page, "page", 0, "Fetch only this page of results, counting from 1")
+	cmd.Flags().BoolVar(&listOpts.AllPages, "all-pages", true, "Fetch every page of results (use --all-pages=false for only the first page)")
+	cmd.Flags().BoolVarP(&listWatch, "watch", "w", false, "Re-fetch and re-render the list periodically, highlighting changes")
+	cmd.Flags().DurationVar(&listWatchInterval, "interval", 5*time.Second, "Refresh interval when using --watch")
+	cmd.MarkFlagsMutuallyExclusive("page", "all-pages")
+	cmd.RunE = watchable(paginated(cmd.RunE))
+}
+
+// paginated wraps a list command's RunE so that a listing cut short by the
+// pagination flags is followed by a note on stderr
+func paginated(run func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if paginating {
+			return run(cmd, args)
+		}
+		if listOpts.Limit < 0 {
+			return fmt.Errorf("--limit must not be negative")
+		}
+		if listOpts.Page < 0 {
+			return fmt.Errorf("--page must be 1 or more")
+		}
+		paginating = true
+		defer func() { paginating = false }()
+
+		listTruncated.Store(false)
+		if err := run(cmd, args); err != nil {
+			return err
+		}
+		// A watch shows the note with each refresh
+		if note := truncationNote(); note != "" && !quiet && !watching {
+			fmt.Fprintln(os.Stderr, note)
+		}
+		return nil
+	}
+}
+
+// truncationNote returns the note shown below a listing that has more results
+// than were fetched, or "" when every result was fetched
+func truncationNote() string {
+	if !listTruncated.Load() {
+		return ""
+	}
+	switch {
+	case listOpts.Page > 0:
+		return fmt.Sprintf("More results are on later pages; use --page %d for the next page.", listOpts.Page+1)
+	case listOpts.Limit > 0:
+		return fmt.Sprintf("Showing only the first %d results; raise --limit, or use --limit 0 to show all.", listOpts.Limit)
+	default:
+		return "Showing the first page of results; use --all-pages to show all."
+	}
+}
+
+// listContext returns a context whose list requests honor --limit, --page, and
+// --all-pages, and record in listTruncated whether results were left out
+func listContext(ctx context.Context) context.Context {
+	return api.WithPageOptions(ctx, api.PageOptions{
+		Limit:         listOpts.Limit,
+		FirstPageOnly: !listOpts.AllPages,
+		Page:          listOpts.Page,
+		Truncated:     &listTruncated,
+	})
+}
+
+// limitTotal applies --limit to a listing merged from several list requests,
+// each of which honors the limit on its own, and records in listTruncated
+// whether items were left out. With --page the limit is the page size of each
+// request, so the listing is left as is.
+func limitTotal[T any](items []T) []T {
+	if listOpts.Limit <= 0 || listOpts.Page > 0 || len(items) <= listOpts.Limit {
+		return items
+	}
+	listTruncated.Store(true)
+	return items[:listOpts.Limit]
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLimitTotal(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          listOptions
+		want          []int
+		wantTruncated bool
+	}{
+		{name: "no limit", opts: listOptions{}, want: []int{1, 2, 3, 4, 5}},
+		{name: "within the limit", opts: listOptions{Limit: 5}, want: []int{1, 2, 3, 4, 5}},
+		{name: "over the limit", opts: listOptions{Limit: 2}, want: []int{1, 2}, wantTruncated: true},
+		// The limit is the page size of each request
+		{name: "page", opts: listOptions{Limit: 2, Page: 1}, want: []int{1, 2, 3, 4, 5}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			setForTest(t, &listOpts, tc.opts)
+			listTruncated.Store(false)
+			t.Cleanup(func() { listTruncated.Store(false) })
+
+			// Two requests of at most --limit items each, merged
+			got := limitTotal([]int{1, 2, 3, 4, 5})
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+			if listTruncated.Load() != tc.wantTruncated {
+				t.Errorf("expected truncated %v, got %v", tc.wantTruncated, listTruncated.Load())
+			}
+		})
+	}
+}
@@ -1,13 +1,15 @@
 package cmd
 
 import (
-	"bufio"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"syscall"
 
 	"spacectl/internal/api"
+	"spacectl/internal/models"
 
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
@@ -18,17 +20,29 @@ var loginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "Login to Kubespaces",
 	Long: `Login to Kubespaces using your email and password.
-If email and password are not provided as flags, you will be prompted for them.
+If email and password are not provided as flags, you will be prompted for them,
+and asked again up to 3 times if they are wrong.
 
-For GitHub OAuth authentication, use: spacectl auth login --github`,
+Accounts must verify their email address before logging in; see
+'spacectl auth verify'.
+
+For GitHub OAuth authentication, use: spacectl auth login --github
+
+For CI jobs, --password-stdin reads the password from stdin instead of a flag,
+and --print-token prints only the access token without saving the session, so
+short-lived credentials never touch the disk:
+
+  echo "$PASSWORD" | spacectl auth login --email ci@example.com --password-stdin --print-token`,
 	RunE: runLogin,
 }
 
 var (
-	loginEmail          string
-	loginPassword       string
-	loginGithub         bool
-	loginCallbackPort   string
+	loginEmail         string
+	loginPassword      string
+	loginGithub        bool
+	loginCallbackPort  string
+	loginPasswordStdin bool
+	loginPrintToken    bool
 )
 
 func init() {
@@ -38,9 +52,30 @@ func init() {
 	loginCmd.Flags().StringVar(&loginPassword, "password", "", "Password")
 	loginCmd.Flags().BoolVar(&loginGithub, "github", false, "Use GitHub OAuth authentication")
 	loginCmd.Flags().StringVar(&loginCallbackPort, "callback-port", "8081", "Port for OAuth callback server (used with --github)")
+	loginCmd.Flags().BoolVar(&loginPasswordStdin, "password-stdin", false, "Read the password from stdin")
+	loginCmd.Flags().BoolVar(&loginPrintToken, "print-token", false, "Print only the access token instead of saving the session")
 }
 
 func runLogin(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if loginGithub && (loginPasswordStdin || loginPrintToken) {
+		return fmt.Errorf("--password-stdin and --print-token cannot be used with --github")
+	}
+	if loginPasswordStdin {
+		if loginPassword != "" {
+			return fmt.Errorf("only one of --password or --password-stdin is allowed")
+		}
+		if loginEmail == "" {
+			return fmt.Errorf("--password-stdin requires --email")
+		}
+		password, err := readPasswordStdin()
+		if err != nil {
+			return err
+		}
+		loginPassword = password
+	}
+
 	// If --github flag is set, use GitHub OAuth
 	if loginGithub {
 		// Set the callback port for GitHub login
@@ -48,37 +83,43 @@ func runLogin(cmd *cobra.Command, args []string) error {
 		return runGithubLogin(cmd, args)
 	}
 
-	// Email/password login flow
-	// Get email if not provided
-	if loginEmail == "" {
-		fmt.Print("Email: ")
-		reader := bufio.NewReader(os.Stdin)
-		email, err := reader.ReadString('\n')
-		if err != nil {
-			return fmt.Errorf("failed to read email: %w", err)
+	// Email/password login flow. Credentials that were prompted for are asked
+	// for again when they are wrong, up to loginAttempts times.
+	promptEmail, promptPassword := loginEmail == "", loginPassword == ""
+
+	// Create API client
+	client := apiClient()
+	authAPI := api.NewAuthAPI(client)
+
+	var loginResp *models.LoginResponse
+	for attempt := 1; ; attempt++ {
+		if err := promptLoginCredentials(promptEmail, promptPassword); err != nil {
+			return err
 		}
-		loginEmail = strings.TrimSpace(email)
-	}
 
-	// Get password if not provided
-	if loginPassword == "" {
-		fmt.Print("Password: ")
-		passwordBytes, err := term.ReadPassword(int(syscall.Stdin))
-		if err != nil {
-			return fmt.Errorf("failed to read password: %w", err)
+		// Attempt login
+		var err error
+		loginResp, err = authAPI.Login(ctx, loginEmail, loginPassword)
+		if err == nil {
+			break
+		}
+		switch {
+		case errors.Is(err, api.ErrEmailNotVerified):
+			return fmt.Errorf("login failed: %s is not verified yet; enter the code from the verification email with 'spacectl auth verify --email %s', or get a new code with 'spacectl auth verify resend --email %s'",
+				loginEmail, loginEmail, loginEmail)
+		case errors.Is(err, api.ErrUnauthenticated) && promptPassword && attempt < loginAttempts:
+			fmt.Fprintln(os.Stderr, "Invalid email or password, please try again (or log in with GitHub using 'spacectl auth login --github').")
+		case errors.Is(err, api.ErrUnauthenticated):
+			return fmt.Errorf("login failed: invalid email or password (to log in with GitHub, use 'spacectl auth login --github'): %w", err)
+		default:
+			return fmt.Errorf("login failed: %w", err)
 		}
-		fmt.Println() // New line after password input
-		loginPassword = string(passwordBytes)
 	}
 
-	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
-	authAPI := api.NewAuthAPI(client)
-
-	// Attempt login
-	loginResp, err := authAPI.Login(loginEmail, loginPassword)
-	if err != nil {
-		return fmt.Errorf("login failed: %w", err)
+	// Print the token for the caller to use, leaving the saved session alone
+	if loginPrintToken {
+		fmt.Println(loginResp.AccessToken)
+		return nil
 	}
 
 	// Update config with tokens
@@ -96,3 +137,52 @@ func runLogin(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// loginAttempts is how many times interactive login asks for the password before
+// giving up on invalid credentials
+const loginAttempts = 3
+
+// promptLoginCredentials asks for the email address and password that were not
+// given as flags. When asking again after a failed attempt, the previous email
+// address is the default.
+func promptLoginCredentials(promptEmail, promptPassword bool) error {
+	// Get email if not provided
+	if promptEmail {
+		if err := promptUnavailable("an email address", "pass --email"); err != nil {
+			return err
+		}
+		email, err := promptString("Email", loginEmail)
+		if err != nil {
+			return err
+		}
+		loginEmail = email
+	}
+
+	// Get password if not provided
+	if promptPassword {
+		if err := promptUnavailable("a password", "pass --password"); err != nil {
+			return err
+		}
+		fmt.Print("Password: ")
+		passwordBytes, err := term.ReadPassword(int(syscall.Stdin))
+		if err != nil {
+			return fmt.Errorf("failed to read password: %w", err)
+		}
+		fmt.Println() // New line after password input
+		loginPassword = string(passwordBytes)
+	}
+	return nil
+}
+
+// readPasswordStdin reads a password piped to stdin, without the trailing newline
+func readPasswordStdin() (string, error) {
+	data, err := io.ReadAll(stdinReader)
+	if err != nil {
+		return "", fmt.Errorf("failed to read password from stdin: %w", err)
+	}
+	password := strings.TrimRight(string(data), "\r\n")
+	if password == "" {
+		return "", fmt.Errorf("no password given on stdin")
+	}
+	return password, nil
+}
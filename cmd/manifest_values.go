@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"spacectl/internal/manifest"
+
+	"github.com/spf13/cobra"
+)
+
+// Variables substituted into the manifests read by apply-style commands
+var (
+	manifestSets        []string
+	manifestValuesFiles []string
+)
+
+// addManifestValueFlags registers the --set and --values flags on a command that reads manifests
+func addManifestValueFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(&manifestSets, "set", nil, "Set a manifest variable used as ${key} (key=value, repeatable)")
+	cmd.Flags().StringArrayVar(&manifestValuesFiles, "values", nil, "YAML file of manifest variables (repeatable; later files and --set take precedence)")
+}
+
+// manifestValues returns the variables given with --values and --set
+func manifestValues() (manifest.Values, error) {
+	return manifest.LoadValues(manifestValuesFiles, manifestSets)
+}
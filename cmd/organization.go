@@ -1,12 +1,14 @@
 package cmd
 
 import (
-	"bufio"
+	"context"
 	"fmt"
-	"os"
 	"strings"
 
 	"spacectl/internal/api"
+	"spacectl/internal/models"
+	"spacectl/internal/output"
+	"spacectl/internal/parallel"
 
 	"github.com/spf13/cobra"
 )
@@ -26,32 +28,117 @@ func init() {
 var orgListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List organizations",
-	Long:  `List all organizations the current user belongs to.`,
-	RunE:  runOrgList,
+	Long: `List all organizations the current user belongs to, with how many projects
+and tenants each has. Counting takes a few requests per organization; use
+--no-counts for a faster listing.
+
+Examples:
+  spacectl org list
+  spacectl org list --sort-by -tenant_count
+  spacectl org list --no-counts`,
+	RunE: runOrgList,
 }
 
+var orgListNoCounts bool
+
 func init() {
 	orgCmd.AddCommand(orgListCmd)
+	addListFlags(orgListCmd)
+	orgListCmd.Flags().BoolVar(&orgListNoCounts, "no-counts", false, "Skip fetching project and tenant counts for faster listings")
+	addConcurrencyFlag(orgListCmd)
+}
+
+// countedOrganization is an organization membership with the organization's
+// project and tenant counts
+type countedOrganization struct {
+	models.OrganizationMembershipResponse `yaml:",inline"`
+	ProjectCount                          int `json:"project_count" yaml:"project_count"`
+	TenantCount                           int `json:"tenant_count" yaml:"tenant_count"`
 }
 
 func runOrgList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	orgAPI := api.NewOrganizationAPI(client)
 
 	// Get organizations
-	orgs, err := orgAPI.ListUserOrganizations()
+	orgs, err := orgAPI.ListUserOrganizations(listContext(ctx))
 	if err != nil {
 		return fmt.Errorf("failed to list organizations: %w", err)
 	}
+	if orgListNoCounts {
+		return formatter.FormatData(orgs)
+	}
+
+	// Count each organization's projects and tenants
+	orgIDs := make([]string, len(orgs))
+	for i, org := range orgs {
+		orgIDs[i] = org.Organization.ID
+	}
+	projectCounts, tenantCounts := fetchOrgCounts(ctx, client, orgIDs)
+
+	counted := make([]countedOrganization, len(orgs))
+	for i, org := range orgs {
+		counted[i] = countedOrganization{
+			OrganizationMembershipResponse: org,
+			ProjectCount:                   projectCounts[org.Organization.ID],
+			TenantCount:                    tenantCounts[org.Organization.ID],
+		}
+	}
+
+	// Structured formats get the memberships with all their fields
+	if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML {
+		return formatter.FormatData(counted)
+	}
+	rows := make([]map[string]interface{}, 0, len(counted))
+	for _, org := range counted {
+		rows = append(rows, map[string]interface{}{
+			"organization":  org.Organization.Name,
+			"role":          org.Role,
+			"is_default":    org.IsDefault,
+			"project_count": org.ProjectCount,
+			"tenant_count":  org.TenantCount,
+		})
+	}
+	return formatter.FormatRows(rows, []string{"organization", "role", "is_default", "project_count", "tenant_count"})
+}
+
+// fetchOrgCounts fetches the project and tenant counts of the given
+// organizations, listing their projects and then the projects' tenants
+// --concurrency at a time. Organizations or projects that cannot be listed
+// count as empty.
+func fetchOrgCounts(ctx context.Context, client *api.Client, orgIDs []string) (projectCounts, tenantCounts map[string]int) {
+	projectAPI := api.NewProjectAPI(client)
+	orgProjects := parallel.Map(orgIDs, concurrency, func(orgID string) []models.Project {
+		projects, _ := projectAPI.ListOrganizationProjects(ctx, orgID)
+		return projects
+	})
+
+	projectOrgs := make(map[string]string)
+	projectCounts = make(map[string]int, len(orgIDs))
+	for i, orgID := range orgIDs {
+		projectCounts[orgID] = len(orgProjects[i])
+		for _, project := range orgProjects[i] {
+			projectOrgs[project.ID] = orgID
+		}
+	}
 
-	// Output organizations
-	return formatter.FormatData(orgs)
+	projectIDs := make([]string, 0, len(projectOrgs))
+	for id := range projectOrgs {
+		projectIDs = append(projectIDs, id)
+	}
+	tenantCounts = make(map[string]int, len(orgIDs))
+	for id, totals := range fetchTenantTotals(ctx, api.NewTenantAPI(client), projectIDs) {
+		tenantCounts[projectOrgs[id]] += totals.count
+	}
+	return projectCounts, tenantCounts
 }
 
 // orgCreateCmd represents the org create command
@@ -71,19 +158,21 @@ func init() {
 }
 
 func runOrgCreate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	name := args[0]
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	orgAPI := api.NewOrganizationAPI(client)
 
 	// Create organization
-	org, err := orgAPI.CreateOrganization(name, orgCreateDescription)
+	org, err := orgAPI.CreateOrganization(ctx, name, orgCreateDescription)
 	if err != nil {
 		return fmt.Errorf("failed to create organization: %w", err)
 	}
@@ -116,23 +205,25 @@ func init() {
 }
 
 func runOrgGet(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	orgAPI := api.NewOrganizationAPI(client)
 
 	// Resolve organization
-	resolvedID, err := resolveOrganizationID(client, orgGetName, orgGetID)
+	resolvedID, err := resolveOrPickOrganizationID(ctx, client, orgGetName, orgGetID)
 	if err != nil {
 		return err
 	}
 
 	// Get organization
-	org, err := orgAPI.GetOrganization(resolvedID)
+	org, err := orgAPI.GetOrganization(ctx, resolvedID)
 	if err != nil {
 		return fmt.Errorf("failed to get organization: %w", err)
 	}
@@ -145,9 +236,13 @@ func runOrgGet(cmd *cobra.Command, args []string) error {
 var orgUpdateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "Update an organization",
-	Long:  `Update an organization's name.`,
-	Args:  cobra.NoArgs,
-	RunE:  runOrgUpdate,
+	Long: `Update an organization's name. Choose the organization with the global
+--org or --org-name flag.
+
+Examples:
+  spacectl org update --org-name acme --name acme-inc`,
+	Args: cobra.NoArgs,
+	RunE: runOrgUpdate,
 }
 
 var orgUpdateName string
@@ -158,34 +253,33 @@ func init() {
 	orgUpdateCmd.MarkFlagRequired("name")
 }
 
-var (
-	orgUpdateTargetName string
-	orgUpdateTargetID   string
-)
-
-func init() {
-	orgUpdateCmd.Flags().StringVar(&orgUpdateTargetName, "org-name", "", "Organization name to update")
-	orgUpdateCmd.Flags().StringVar(&orgUpdateTargetID, "org-id", "", "Organization ID to update")
-}
-
 func runOrgUpdate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	orgAPI := api.NewOrganizationAPI(client)
 
-	// Resolve organization to update
-	resolvedID, err := resolveOrganizationID(client, orgUpdateTargetName, orgUpdateTargetID)
-	if err != nil {
-		return err
+	// The organization to update must be chosen explicitly, or picked when
+	// the user can be prompted
+	resolvedID := contextOrgID()
+	if !orgFlagSet() {
+		if checkInteractive() != nil {
+			return fmt.Errorf("either --org or --org-name must be provided")
+		}
+		var err error
+		if resolvedID, err = pickOrganization(ctx, client); err != nil {
+			return err
+		}
 	}
 
 	// Update organization
-	org, err := orgAPI.UpdateOrganization(resolvedID, orgUpdateName)
+	org, err := orgAPI.UpdateOrganization(ctx, resolvedID, orgUpdateName)
 	if err != nil {
 		return fmt.Errorf("failed to update organization: %w", err)
 	}
@@ -198,9 +292,14 @@ func runOrgUpdate(cmd *cobra.Command, args []string) error {
 var orgDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete an organization",
-	Long:  `Delete an organization. This action cannot be undone.`,
-	Args:  cobra.NoArgs,
-	RunE:  runOrgDelete,
+	Long: `Delete an organization. This action cannot be undone.
+
+The projects and tenants that would be deleted along with the organization are
+listed first, and you must type the organization name to confirm unless
+--force or --yes is given. Use --cascade=false to refuse deletion when the
+organization still has projects.`,
+	Args: cobra.NoArgs,
+	RunE: runOrgDelete,
 }
 
 func init() {
@@ -208,59 +307,83 @@ func init() {
 }
 
 var (
-	orgDeleteName  string
-	orgDeleteID    string
-	orgDeleteForce bool
+	orgDeleteName    string
+	orgDeleteID      string
+	orgDeleteForce   bool
+	orgDeleteCascade bool
 )
 
 func init() {
 	orgDeleteCmd.Flags().StringVar(&orgDeleteName, "name", "", "Organization name")
 	orgDeleteCmd.Flags().StringVar(&orgDeleteID, "id", "", "Organization ID")
 	orgDeleteCmd.Flags().BoolVar(&orgDeleteForce, "force", false, "Skip confirmation prompt")
+	orgDeleteCmd.Flags().BoolVar(&orgDeleteCascade, "cascade", true, "Also delete the organization's projects and tenants (use --cascade=false to fail if any exist)")
 }
 
 func runOrgDelete(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	orgAPI := api.NewOrganizationAPI(client)
 
 	// Resolve organization
-	resolvedID, err := resolveOrganizationID(client, orgDeleteName, orgDeleteID)
+	resolvedID, err := resolveOrPickOrganizationID(ctx, client, orgDeleteName, orgDeleteID)
 	if err != nil {
 		return err
 	}
 
 	// Get organization details for confirmation
-	org, err := orgAPI.GetOrganization(resolvedID)
+	org, err := orgAPI.GetOrganization(ctx, resolvedID)
 	if err != nil {
 		return fmt.Errorf("failed to get organization details: %w", err)
 	}
 
-	// Ask for confirmation unless --force is used
-	if !orgDeleteForce {
-		fmt.Printf("Are you sure you want to delete organization '%s' (ID: %s)? This action cannot be undone.\n", org.Name, resolvedID)
-		fmt.Print("Type 'yes' to confirm: ")
-
-		reader := bufio.NewReader(os.Stdin)
-		response, err := reader.ReadString('\n')
+	// Collect the projects and tenants that would be deleted with the organization
+	projectAPI := api.NewProjectAPI(client)
+	tenantAPI := api.NewTenantAPI(client)
+	projects, err := projectAPI.ListOrganizationProjects(ctx, resolvedID)
+	if err != nil {
+		return fmt.Errorf("failed to list organization projects: %w", err)
+	}
+	tenantsByProject := make(map[string][]models.Tenant, len(projects))
+	tenantCount := 0
+	for _, p := range projects {
+		tenants, err := tenantAPI.ListProjectTenants(ctx, p.ID)
 		if err != nil {
-			return fmt.Errorf("failed to read input: %w", err)
+			return fmt.Errorf("failed to list tenants for project %s: %w", p.Name, err)
 		}
+		tenantsByProject[p.ID] = tenants
+		tenantCount += len(tenants)
+	}
 
-		response = strings.TrimSpace(strings.ToLower(response))
-		if response != "yes" {
-			fmt.Println("Deletion cancelled.")
-			return nil
+	if !orgDeleteCascade && len(projects) > 0 {
+		return fmt.Errorf("organization '%s' still has %d project(s) and %d tenant(s); delete them first or omit --cascade=false", org.Name, len(projects), tenantCount)
+	}
+
+	// Ask for confirmation unless --force or --yes is used, listing everything that goes with the organization
+	var question strings.Builder
+	if len(projects) > 0 {
+		fmt.Fprintf(&question, "Deleting organization '%s' will also delete %d project(s) and %d tenant(s):\n", org.Name, len(projects), tenantCount)
+		for _, p := range projects {
+			fmt.Fprintf(&question, "  project %s\n", p.Name)
+			for _, t := range tenantsByProject[p.ID] {
+				fmt.Fprintf(&question, "    tenant %s [%s]\n", t.Name, t.Status)
+			}
 		}
 	}
+	fmt.Fprintf(&question, "Are you sure you want to delete organization '%s' (ID: %s)? This action cannot be undone.", org.Name, resolvedID)
+	if ok, err := confirmTyped(question.String(), "the organization name", org.Name, orgDeleteForce); err != nil || !ok {
+		return err
+	}
 
 	// Delete organization
-	err = orgAPI.DeleteOrganization(resolvedID)
+	err = orgAPI.DeleteOrganization(ctx, resolvedID)
 	if err != nil {
 		return fmt.Errorf("failed to delete organization: %w", err)
 	}
@@ -297,23 +420,25 @@ func init() {
 }
 
 func runOrgSetDefault(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	orgAPI := api.NewOrganizationAPI(client)
 
 	// Resolve organization
-	resolvedID, err := resolveOrganizationID(client, orgDefaultName, orgDefaultID)
+	resolvedID, err := resolveOrPickOrganizationID(ctx, client, orgDefaultName, orgDefaultID)
 	if err != nil {
 		return err
 	}
 
 	// Set default organization
-	err = orgAPI.SetDefaultOrganization(resolvedID)
+	err = orgAPI.SetDefaultOrganization(ctx, resolvedID)
 	if err != nil {
 		return fmt.Errorf("failed to set default organization: %w", err)
 	}
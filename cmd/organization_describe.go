@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+	"spacectl/internal/output"
+	"spacectl/internal/units"
+
+	"github.com/spf13/cobra"
+)
+
+// orgDescribeCmd represents the org describe command
+var orgDescribeCmd = &cobra.Command{
+	Use:   "describe",
+	Short: "Show an organization as a tree of projects and tenants",
+	Long: `Show an organization as an indented tree of its projects and their tenants,
+including statuses and quota usage.
+
+Examples:
+  spacectl org describe --name my-org
+  spacectl org describe --id abc123 -o yaml`,
+	Args: cobra.NoArgs,
+	RunE: runOrgDescribe,
+}
+
+var (
+	orgDescribeName string
+	orgDescribeID   string
+)
+
+func init() {
+	orgCmd.AddCommand(orgDescribeCmd)
+	orgDescribeCmd.Flags().StringVar(&orgDescribeName, "name", "", "Organization name")
+	orgDescribeCmd.Flags().StringVar(&orgDescribeID, "id", "", "Organization ID")
+}
+
+// orgTree is the structured form of org describe used for JSON/YAML output
+type orgTree struct {
+	Organization models.Organization `json:"organization" yaml:"organization"`
+	Projects     []orgTreeProject    `json:"projects" yaml:"projects"`
+}
+
+type orgTreeProject struct {
+	Project models.Project           `json:"project" yaml:"project"`
+	Usage   []map[string]interface{} `json:"usage" yaml:"usage"`
+	Tenants []models.Tenant          `json:"tenants" yaml:"tenants"`
+}
+
+func runOrgDescribe(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+	orgAPI := api.NewOrganizationAPI(client)
+	projectAPI := api.NewProjectAPI(client)
+	tenantAPI := api.NewTenantAPI(client)
+
+	// Resolve organization
+	orgID, err := resolveOrPickOrganizationID(ctx, client, orgDescribeName, orgDescribeID)
+	if err != nil {
+		return err
+	}
+
+	org, err := orgAPI.GetOrganization(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to get organization: %w", err)
+	}
+	projects, err := projectAPI.ListOrganizationProjects(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("failed to list organization projects: %w", err)
+	}
+
+	tree := orgTree{Organization: *org}
+	for i := range projects {
+		tenants, err := tenantAPI.ListProjectTenants(ctx, projects[i].ID)
+		if err != nil {
+			return fmt.Errorf("failed to list tenants for project %s: %w", projects[i].Name, err)
+		}
+		tree.Projects = append(tree.Projects, orgTreeProject{
+			Project: projects[i],
+			Usage:   projectUsageRecords(&projects[i], tenants),
+			Tenants: tenants,
+		})
+	}
+
+	// Structured formats get the whole tree as a single document
+	if output.Format(outputFmt) != output.FormatTable {
+		return formatter.FormatData(tree)
+	}
+
+	fmt.Print(renderOrgTree(tree))
+	return nil
+}
+
+// renderOrgTree draws the organization as an indented tree
+func renderOrgTree(tree orgTree) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (%s)\n", tree.Organization.Name, tree.Organization.ID)
+	if len(tree.Projects) == 0 {
+		b.WriteString("└── (no projects)\n")
+		return b.String()
+	}
+
+	for i, p := range tree.Projects {
+		lastProject := i == len(tree.Projects)-1
+		branch, indent := "├── ", "│   "
+		if lastProject {
+			branch, indent = "└── ", "    "
+		}
+
+		var usage []string
+		for _, u := range p.Usage {
+			usage = append(usage, fmt.Sprintf("%s %v/%v", u["resource"], u["used"], u["limit"]))
+		}
+		fmt.Fprintf(&b, "%s%s [%s] %s\n", branch, p.Project.Name, p.Project.DisplayStatus(), strings.Join(usage, ", "))
+
+		if len(p.Tenants) == 0 {
+			fmt.Fprintf(&b, "%s└── (no tenants)\n", indent)
+			continue
+		}
+		for j, t := range p.Tenants {
+			tenantBranch := "├── "
+			if j == len(p.Tenants)-1 {
+				tenantBranch = "└── "
+			}
+			fmt.Fprintf(&b, "%s%s%s [%s] %s/%s %s, %d cores, %s\n",
+				indent, tenantBranch, t.Name, t.Status, t.CloudProvider, t.Region, t.KubernetesVersion, t.ComputeQuota, units.GiB(t.MemoryQuotaGB))
+		}
+	}
+	return b.String()
+}
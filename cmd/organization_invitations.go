@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"spacectl/internal/api"
+	"spacectl/internal/output"
+	"spacectl/internal/parallel"
+
+	"github.com/spf13/cobra"
+)
+
+// orgInvitationsCmd represents the org invitations command
+var orgInvitationsCmd = &cobra.Command{
+	Use:   "invitations",
+	Short: "Manage invitations to an organization",
+	Long: `Manage the invitations an organization sends to new members. To answer
+invitations you received, use 'spacectl invitations'.`,
+}
+
+// orgInvitationsSendCmd represents the org invitations send command
+var orgInvitationsSendCmd = &cobra.Command{
+	Use:   "send (--email <email> | --from-file <file>)",
+	Short: "Invite people to an organization",
+	Long: `Invite people to the organization selected with --org or --org-name, or your
+default organization, by email.
+
+With --from-file, everyone listed in a CSV file is invited, for onboarding a
+whole team at once. Each line holds an email address and optionally a role;
+lines without a role get --role. A header line "email,role" and lines starting
+with # are skipped. Use - to read the file from stdin. The invitations are sent
+a few at a time and each one's result is shown in a table; if any fail, the
+command exits with an error after sending the rest.
+
+Examples:
+  spacectl org invitations send --email alice@example.com --role admin
+  spacectl org invitations send --from-file members.csv
+  spacectl org invitations send --from-file members.csv --org-name acme -o json`,
+	Args: cobra.NoArgs,
+	RunE: runOrgInvitationsSend,
+}
+
+var (
+	orgInvitationsEmail    string
+	orgInvitationsRole     string
+	orgInvitationsFromFile string
+)
+
+func init() {
+	orgCmd.AddCommand(orgInvitationsCmd)
+	orgInvitationsCmd.AddCommand(orgInvitationsSendCmd)
+	orgInvitationsSendCmd.Flags().StringVar(&orgInvitationsEmail, "email", "", "Email address to invite")
+	orgInvitationsSendCmd.Flags().StringVar(&orgInvitationsRole, "role", "member", "Role of the invited members (admin, member)")
+	orgInvitationsSendCmd.RegisterFlagCompletionFunc("role", completeRoles("org"))
+	orgInvitationsSendCmd.Flags().StringVarP(&orgInvitationsFromFile, "from-file", "f", "", "CSV file of email,role lines to invite (use - for stdin)")
+	addConcurrencyFlag(orgInvitationsSendCmd)
+}
+
+// invitee is a person to invite, from the command line or a line of a CSV file
+type invitee struct {
+	Email string
+	Role  string
+	// Line is the line of the CSV file, or 0 for --email
+	Line int
+}
+
+func runOrgInvitationsSend(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	var invitees []invitee
+	switch {
+	case orgInvitationsEmail != "" && orgInvitationsFromFile != "":
+		return fmt.Errorf("only one of --email or --from-file is allowed")
+	case orgInvitationsEmail != "":
+		invitees = []invitee{{Email: orgInvitationsEmail, Role: orgInvitationsRole}}
+	case orgInvitationsFromFile != "":
+		var err error
+		if invitees, err = readInvitees(orgInvitationsFromFile, orgInvitationsRole); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("either --email or --from-file must be provided")
+	}
+
+	// Create API client
+	client := apiClient()
+	orgAPI := api.NewOrganizationAPI(client)
+
+	orgID, err := requireOrgID(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	// Send the invitations --concurrency at a time, keeping the results in file order
+	results := parallel.Map(invitees, concurrency, func(inv invitee) error {
+		return orgAPI.SendInvitation(ctx, orgID, inv.Email, inv.Role)
+	})
+
+	failed := 0
+	rows := make([]map[string]interface{}, 0, len(invitees))
+	for i, inv := range invitees {
+		result := "sent"
+		if results[i] != nil {
+			failed++
+			result = "failed: " + results[i].Error()
+		}
+		row := map[string]interface{}{
+			"email":  inv.Email,
+			"role":   inv.Role,
+			"result": result,
+		}
+		if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML {
+			if inv.Line > 0 {
+				row["line"] = inv.Line
+			}
+			row["sent"] = results[i] == nil
+			if results[i] != nil {
+				row["result"] = results[i].Error()
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	if !quiet || failed > 0 {
+		if err := formatter.FormatRows(rows, []string{"email", "role", "result"}); err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to send %d of %d invitations", failed, len(invitees))
+	}
+	return nil
+}
+
+// readInvitees reads the people to invite from a CSV file of email,role lines,
+// or stdin for "-". Lines without a role get defaultRole.
+func readInvitees(path, defaultRole string) ([]invitee, error) {
+	var r io.Reader = stdinReader
+	if path != stdinArg {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", path, err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var invitees []invitee
+	seen := make(map[string]int)
+	var errs []error
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		email := strings.TrimSpace(record[0])
+		role := defaultRole
+		if len(record) > 1 && strings.TrimSpace(record[1]) != "" {
+			role = strings.TrimSpace(record[1])
+		}
+		switch {
+		case len(invitees) == 0 && len(errs) == 0 && strings.EqualFold(email, "email"):
+			// Header line
+			continue
+		case email == "" && len(record) == 1:
+			continue
+		case len(record) > 2:
+			errs = append(errs, fmt.Errorf("line %d: expected email,role but got %d fields", line, len(record)))
+		case !strings.Contains(email, "@"):
+			errs = append(errs, fmt.Errorf("line %d: %q is not an email address", line, email))
+		case seen[strings.ToLower(email)] != 0:
+			errs = append(errs, fmt.Errorf("line %d: %s is already listed on line %d", line, email, seen[strings.ToLower(email)]))
+		default:
+			seen[strings.ToLower(email)] = line
+			invitees = append(invitees, invitee{Email: email, Role: role, Line: line})
+		}
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid invitations in %s:\n%w", path, errors.Join(errs...))
+	}
+	if len(invitees) == 0 {
+		return nil, fmt.Errorf("no invitations found in %s", path)
+	}
+	return invitees, nil
+}
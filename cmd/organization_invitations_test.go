@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadInvitees(t *testing.T) {
+	tests := []struct {
+		name    string
+		csv     string
+		want    []invitee
+		wantErr []string
+	}{
+		{
+			name: "header, comments, and blank lines",
+			csv: `email,role
+# platform team
+ann@example.com,admin
+
+bob@example.com, viewer
+`,
+			want: []invitee{
+				{Email: "ann@example.com", Role: "admin", Line: 3},
+				{Email: "bob@example.com", Role: "viewer", Line: 5},
+			},
+		},
+		{
+			name: "default role",
+			csv:  "ann@example.com\nbob@example.com,\ncarol@example.com,admin\n",
+			want: []invitee{
+				{Email: "ann@example.com", Role: "member", Line: 1},
+				{Email: "bob@example.com", Role: "member", Line: 2},
+				{Email: "carol@example.com", Role: "admin", Line: 3},
+			},
+		},
+		{
+			name:    "duplicates",
+			csv:     "ann@example.com,admin\nbob@example.com\nAnn@Example.com,viewer\n",
+			wantErr: []string{"line 3: Ann@Example.com is already listed on line 1"},
+		},
+		{
+			name:    "extra fields",
+			csv:     "ann@example.com,admin,team-a\n",
+			wantErr: []string{"line 1: expected email,role but got 3 fields"},
+		},
+		{
+			// Every problem is reported at once, and a header is only
+			// skipped on the first line
+			name:    "several problems",
+			csv:     "ann@example.com\nemail,role\nbob,admin\nann@example.com\n",
+			wantErr: []string{`line 2: "email" is not an email address`, `line 3: "bob" is not an email address`, "line 4: ann@example.com is already listed on line 1"},
+		},
+		{
+			name:    "no invitations",
+			csv:     "email,role\n# nobody yet\n",
+			wantErr: []string{"no invitations found"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "invitees.csv")
+			if err := os.WriteFile(path, []byte(tc.csv), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := readInvitees(path, "member")
+			if tc.wantErr != nil {
+				if err == nil {
+					t.Fatalf("expected an error, got invitees %v", got)
+				}
+				for _, want := range tc.wantErr {
+					if !strings.Contains(err.Error(), want) {
+						t.Errorf("expected the error to contain %q, got:\n%v", want, err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("unexpected invitees\nwant: %+v\ngot:  %+v", tc.want, got)
+			}
+		})
+	}
+}
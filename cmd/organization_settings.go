@@ -0,0 +1,210 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// orgSettingsCmd represents the org settings command
+var orgSettingsCmd = &cobra.Command{
+	Use:   "settings",
+	Short: "Manage organization settings",
+	Long: `Manage organization-wide settings such as the default Kubernetes version,
+allowed cloud providers and regions, and default tenant quotas.`,
+}
+
+func init() {
+	orgCmd.AddCommand(orgSettingsCmd)
+}
+
+// orgSettingsGetCmd represents the org settings get command
+var orgSettingsGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Show organization settings",
+	Long: `Show an organization's settings.
+
+Examples:
+  spacectl org settings get --name my-org
+  spacectl org settings get --id abc123 -o yaml`,
+	Args: cobra.NoArgs,
+	RunE: runOrgSettingsGet,
+}
+
+var (
+	orgSettingsGetName string
+	orgSettingsGetID   string
+)
+
+func init() {
+	orgSettingsCmd.AddCommand(orgSettingsGetCmd)
+	orgSettingsGetCmd.Flags().StringVar(&orgSettingsGetName, "name", "", "Organization name")
+	orgSettingsGetCmd.Flags().StringVar(&orgSettingsGetID, "id", "", "Organization ID")
+}
+
+func runOrgSettingsGet(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+	orgAPI := api.NewOrganizationAPI(client)
+
+	// Resolve organization
+	resolvedID, err := resolveOrPickOrganizationID(ctx, client, orgSettingsGetName, orgSettingsGetID)
+	if err != nil {
+		return err
+	}
+
+	settings, err := orgAPI.GetOrganizationSettings(ctx, resolvedID)
+	if err != nil {
+		return fmt.Errorf("failed to get organization settings: %w", err)
+	}
+
+	return formatOrgSettings(settings)
+}
+
+// orgSettingsSetCmd represents the org settings set command
+var orgSettingsSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Update organization settings",
+	Long: `Update an organization's settings. Only the settings passed as flags are sent;
+all other settings are left unchanged. Pass an empty list (e.g. --allowed-regions "")
+to remove a restriction.
+
+Examples:
+  spacectl org settings set --name my-org --default-k8s-version 1.31
+  spacectl org settings set --name my-org --allowed-clouds aws,gcp --allowed-regions eu-west-1,europe-west4
+  spacectl org settings set --id abc123 --default-compute 4 --default-memory 8`,
+	Args: cobra.NoArgs,
+	RunE: runOrgSettingsSet,
+}
+
+var (
+	orgSettingsSetName           string
+	orgSettingsSetID             string
+	orgSettingsSetK8sVersion     string
+	orgSettingsSetAllowedClouds  []string
+	orgSettingsSetAllowedRegions []string
+	orgSettingsSetDefaultCompute int
+	orgSettingsSetDefaultMemory  int
+)
+
+func init() {
+	orgSettingsCmd.AddCommand(orgSettingsSetCmd)
+	orgSettingsSetCmd.Flags().StringVar(&orgSettingsSetName, "name", "", "Organization name")
+	orgSettingsSetCmd.Flags().StringVar(&orgSettingsSetID, "id", "", "Organization ID")
+	orgSettingsSetCmd.Flags().StringVar(&orgSettingsSetK8sVersion, "default-k8s-version", "", "Default Kubernetes version for new tenants")
+	orgSettingsSetCmd.Flags().StringSliceVar(&orgSettingsSetAllowedClouds, "allowed-clouds", nil, "Cloud providers tenants may use (comma-separated)")
+	orgSettingsSetCmd.Flags().StringSliceVar(&orgSettingsSetAllowedRegions, "allowed-regions", nil, "Regions tenants may use (comma-separated)")
+	orgSettingsSetCmd.Flags().IntVar(&orgSettingsSetDefaultCompute, "default-compute", 0, "Default tenant compute quota")
+	orgSettingsSetCmd.Flags().IntVar(&orgSettingsSetDefaultMemory, "default-memory", 0, "Default tenant memory quota (GB)")
+}
+
+func runOrgSettingsSet(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Build request from the flags that were explicitly set
+	var req models.UpdateOrganizationSettingsRequest
+	changed := false
+	if cmd.Flags().Changed("default-k8s-version") {
+		req.DefaultKubernetesVersion = &orgSettingsSetK8sVersion
+		changed = true
+	}
+	if cmd.Flags().Changed("allowed-clouds") {
+		clouds := nonEmpty(orgSettingsSetAllowedClouds)
+		req.AllowedCloudProviders = &clouds
+		changed = true
+	}
+	if cmd.Flags().Changed("allowed-regions") {
+		regions := nonEmpty(orgSettingsSetAllowedRegions)
+		req.AllowedRegions = &regions
+		changed = true
+	}
+	if cmd.Flags().Changed("default-compute") {
+		req.DefaultTenantComputeQuota = &orgSettingsSetDefaultCompute
+		changed = true
+	}
+	if cmd.Flags().Changed("default-memory") {
+		req.DefaultTenantMemoryQuotaGB = &orgSettingsSetDefaultMemory
+		changed = true
+	}
+	if !changed {
+		return fmt.Errorf("no settings to update. Use --default-k8s-version, --allowed-clouds, --allowed-regions, --default-compute, or --default-memory")
+	}
+
+	// Create API client
+	client := apiClient()
+	orgAPI := api.NewOrganizationAPI(client)
+
+	// Resolve organization
+	resolvedID, err := resolveOrPickOrganizationID(ctx, client, orgSettingsSetName, orgSettingsSetID)
+	if err != nil {
+		return err
+	}
+
+	// Update settings
+	settings, err := orgAPI.UpdateOrganizationSettings(ctx, resolvedID, req)
+	if err != nil {
+		return fmt.Errorf("failed to update organization settings: %w", err)
+	}
+
+	if !quiet && output.Format(outputFmt) == output.FormatTable {
+		fmt.Printf("Successfully updated organization settings\n")
+	}
+
+	return formatOrgSettings(settings)
+}
+
+// formatOrgSettings prints settings as setting/value rows in table and CSV output
+func formatOrgSettings(settings *models.OrganizationSettings) error {
+	if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML {
+		return formatter.FormatData(settings)
+	}
+
+	version := settings.DefaultKubernetesVersion
+	if version == "" {
+		version = "-"
+	}
+	rows := []map[string]interface{}{
+		{"setting": "default_kubernetes_version", "value": version},
+		{"setting": "allowed_cloud_providers", "value": joinOrAny(settings.AllowedCloudProviders)},
+		{"setting": "allowed_regions", "value": joinOrAny(settings.AllowedRegions)},
+		{"setting": "default_tenant_compute_quota", "value": settings.DefaultTenantComputeQuota},
+		{"setting": "default_tenant_memory_quota_gb", "value": settings.DefaultTenantMemoryQuotaGB},
+	}
+	return formatter.FormatRows(rows, []string{"setting", "value"})
+}
+
+// joinOrAny joins a restriction list, where an empty list means no restriction
+func joinOrAny(values []string) string {
+	if len(values) == 0 {
+		return "(any)"
+	}
+	return strings.Join(values, ",")
+}
+
+// nonEmpty drops blank entries so that an empty flag value clears a list
+func nonEmpty(values []string) []string {
+	result := []string{}
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+
+	"spacectl/internal/api"
+
+	"github.com/spf13/cobra"
+)
+
+// orgTransferOwnerCmd represents the org transfer-owner command
+var orgTransferOwnerCmd = &cobra.Command{
+	Use:   "transfer-owner",
+	Short: "Transfer organization ownership",
+	Long: `Transfer ownership of an organization to another member, identified by email.
+You must type the organization name to confirm unless --force or --yes is given.
+
+Examples:
+  spacectl org transfer-owner --name my-org --to-user alice@example.com`,
+	Args: cobra.NoArgs,
+	RunE: runOrgTransferOwner,
+}
+
+var (
+	orgTransferName   string
+	orgTransferID     string
+	orgTransferToUser string
+	orgTransferForce  bool
+)
+
+func init() {
+	orgCmd.AddCommand(orgTransferOwnerCmd)
+	orgTransferOwnerCmd.Flags().StringVar(&orgTransferName, "name", "", "Organization name")
+	orgTransferOwnerCmd.Flags().StringVar(&orgTransferID, "id", "", "Organization ID")
+	orgTransferOwnerCmd.Flags().StringVar(&orgTransferToUser, "to-user", "", "Email of the new owner")
+	orgTransferOwnerCmd.Flags().BoolVar(&orgTransferForce, "force", false, "Skip confirmation prompt")
+	orgTransferOwnerCmd.MarkFlagRequired("to-user")
+}
+
+func runOrgTransferOwner(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+	orgAPI := api.NewOrganizationAPI(client)
+
+	// Resolve organization
+	resolvedID, err := resolveOrPickOrganizationID(ctx, client, orgTransferName, orgTransferID)
+	if err != nil {
+		return err
+	}
+
+	org, err := orgAPI.GetOrganization(ctx, resolvedID)
+	if err != nil {
+		return fmt.Errorf("failed to get organization details: %w", err)
+	}
+
+	// Ask for confirmation unless --force or --yes is used
+	question := fmt.Sprintf("Transfer ownership of organization '%s' (ID: %s) to %s? You may lose owner privileges.", org.Name, resolvedID, orgTransferToUser)
+	if ok, err := confirmTyped(question, "the organization name", org.Name, orgTransferForce); err != nil || !ok {
+		return err
+	}
+
+	// Transfer ownership
+	if _, err := orgAPI.TransferOwnership(ctx, resolvedID, orgTransferToUser); err != nil {
+		return fmt.Errorf("failed to transfer organization ownership: %w", err)
+	}
+
+	// Output success message
+	if !quiet {
+		fmt.Printf("Successfully transferred ownership of organization %s to %s\n", org.Name, orgTransferToUser)
+	}
+
+	return nil
+}
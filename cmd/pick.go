@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+)
+
+// resolveOrPickOrganizationID resolves the organization given with --name or
+// --id, like resolveOrganizationID. When neither is given and the user can be
+// prompted, they pick one of their organizations instead. Commands call this
+// for their own flags; names from manifests or other commands must not prompt.
+func resolveOrPickOrganizationID(ctx context.Context, client *api.Client, name, id string) (string, error) {
+	if name != "" || id != "" || checkInteractive() != nil {
+		return resolveOrganizationID(ctx, client, name, id)
+	}
+	return pickOrganization(ctx, client)
+}
+
+// resolveOrPickProjectID resolves the project given with --name or --id, like
+// resolveProjectID, or lets the user pick one of the projects searched when
+// neither is given and they can be prompted
+func resolveOrPickProjectID(ctx context.Context, client *api.Client, name, id, orgID string) (string, error) {
+	if name != "" || id != "" || checkInteractive() != nil {
+		return resolveProjectID(ctx, client, name, id, orgID)
+	}
+	return pickProject(ctx, client, orgID)
+}
+
+// resolveOrPickTenantID resolves the tenant given with --name or --id, like
+// resolveTenantID, or lets the user pick one of the tenants of the project when
+// neither is given and they can be prompted
+func resolveOrPickTenantID(ctx context.Context, client *api.Client, name, id, projectID string) (string, error) {
+	if name != "" || id != "" || checkInteractive() != nil {
+		return resolveTenantID(ctx, client, name, id, projectID)
+	}
+	if projectID == "" {
+		projectID = cfg.DefaultProject
+	}
+	if projectID == "" {
+		return "", fmt.Errorf("project is required to pick a tenant (pass --project or run 'spacectl project set-default')")
+	}
+	return pickTenant(ctx, client, projectID)
+}
+
+// pickOrganization lets the user pick one of their organizations, starting at
+// the default one
+func pickOrganization(ctx context.Context, client *api.Client) (string, error) {
+	memberships, err := api.NewOrganizationAPI(client).ListUserOrganizations(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list organizations: %w", err)
+	}
+	if len(memberships) == 0 {
+		return "", api.NotFoundError("you are not a member of any organization")
+	}
+	options := make([]string, len(memberships))
+	def := 0
+	for i, m := range memberships {
+		options[i] = fmt.Sprintf("%s (%s)", m.Organization.Name, m.Organization.ID)
+		if m.IsDefault {
+			def = i
+		}
+	}
+	idx, err := promptSelect("Select an organization", options, def)
+	if err != nil {
+		return "", err
+	}
+	return memberships[idx].Organization.ID, nil
+}
+
+// pickProject lets the user pick a project of an organization, or of any of
+// their organizations if orgID is empty, starting at the default project
+func pickProject(ctx context.Context, client *api.Client, orgID string) (string, error) {
+	projectAPI := api.NewProjectAPI(client)
+	var projects []models.Project
+	if orgID != "" {
+		list, err := projectAPI.ListOrganizationProjects(ctx, orgID)
+		if err != nil {
+			return "", fmt.Errorf("failed to list projects in organization: %w", err)
+		}
+		projects = list
+	} else {
+		memberships, err := projectAPI.ListUserProjects(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to list user projects: %w", err)
+		}
+		for _, m := range memberships {
+			projects = append(projects, m.Project)
+		}
+	}
+	if len(projects) == 0 {
+		return "", api.NotFoundError("no projects found")
+	}
+
+	// Qualify the names with their organization when the projects span several
+	orgNames := map[string]string{}
+	if orgID == "" {
+		if orgs, err := api.NewOrganizationAPI(client).ListUserOrganizations(ctx); err == nil && len(orgs) > 1 {
+			for _, m := range orgs {
+				orgNames[m.Organization.ID] = m.Organization.Name
+			}
+		}
+	}
+	options := make([]string, len(projects))
+	def := 0
+	for i, p := range projects {
+		name := p.Name
+		if org, ok := orgNames[p.OrganizationID]; ok {
+			name = org + "/" + p.Name
+		}
+		options[i] = fmt.Sprintf("%s (%s)", name, p.ID)
+		if p.ID == cfg.DefaultProject {
+			def = i
+		}
+	}
+	idx, err := promptSelect("Select a project", options, def)
+	if err != nil {
+		return "", err
+	}
+	return projects[idx].ID, nil
+}
+
+// pickTenant lets the user pick one of the tenants of a project
+func pickTenant(ctx context.Context, client *api.Client, projectID string) (string, error) {
+	tenants, err := api.NewTenantAPI(client).ListProjectTenants(ctx, projectID)
+	if err != nil {
+		return "", fmt.Errorf("failed to list tenants in project: %w", err)
+	}
+	if len(tenants) == 0 {
+		return "", api.NotFoundError("no tenants found in project")
+	}
+	options := make([]string, len(tenants))
+	for i, t := range tenants {
+		options[i] = fmt.Sprintf("%s (%s, %s)", t.Name, t.Status, t.ID)
+	}
+	idx, err := promptSelect("Select a tenant", options, 0)
+	if err != nil {
+		return "", err
+	}
+	return tenants[idx].ID, nil
+}
@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"strings"
+	"testing"
+
+	"spacectl/internal/api"
+	"spacectl/internal/config"
+	"spacectl/internal/manifest"
+	"spacectl/internal/models"
+)
+
+// pickRoutes is an account in organizations acme, the default, and beta, with
+// projects web in acme and api in beta, and tenants dev and prod in web
+func pickRoutes() map[string]fakeResponse {
+	return map[string]fakeResponse{
+		"GET /api/v1/organizations": {Body: []models.OrganizationMembershipResponse{
+			{Organization: models.Organization{ID: "org-1", Name: "acme"}, IsDefault: true},
+			{Organization: models.Organization{ID: "org-2", Name: "beta"}},
+		}},
+		"GET /api/v1/projects": {Body: []models.ProjectMembership{
+			{Project: models.Project{ID: "proj-1", OrganizationID: "org-1", Name: "web"}},
+			{Project: models.Project{ID: "proj-2", OrganizationID: "org-2", Name: "api"}},
+		}},
+		"GET /api/v1/organizations/org-2/projects": {Body: []models.Project{{ID: "proj-2", OrganizationID: "org-2", Name: "api"}}},
+		"GET /api/v1/projects/proj-1/tenants": {Body: []models.Tenant{
+			{ID: "tenant-1", ProjectID: "proj-1", Name: "dev", Status: "running"},
+			{ID: "tenant-2", ProjectID: "proj-1", Name: "prod", Status: "running"},
+		}},
+		"GET /api/v1/projects/proj-2/tenants": {Body: []models.Tenant{}},
+	}
+}
+
+// answerPrompts makes the user promptable for the rest of the test, answering
+// the prompts with the lines of input
+func answerPrompts(t *testing.T, input string) {
+	t.Helper()
+
+	setForTest(t, &nonInteractive, false)
+	setForTest(t, &stdinIsTerminal, func() bool { return true })
+	setForTest(t, &stdinReader, bufio.NewReader(strings.NewReader(input)))
+}
+
+func TestResolveOrPick(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings config.Config
+		input    string
+		resolve  func(ctx context.Context, client *api.Client) (string, error)
+		want     string
+		wantErr  string
+	}{
+		{
+			name:  "organization starts at the default",
+			input: "\n",
+			resolve: func(ctx context.Context, client *api.Client) (string, error) {
+				return resolveOrPickOrganizationID(ctx, client, "", "")
+			},
+			want: "org-1",
+		},
+		{
+			name:  "project of any organization",
+			input: "2\n",
+			resolve: func(ctx context.Context, client *api.Client) (string, error) {
+				return resolveOrPickProjectID(ctx, client, "", "", "")
+			},
+			want: "proj-2",
+		},
+		{
+			name:  "project of an organization",
+			input: "1\n",
+			resolve: func(ctx context.Context, client *api.Client) (string, error) {
+				return resolveOrPickProjectID(ctx, client, "", "", "org-2")
+			},
+			want: "proj-2",
+		},
+		{
+			// Answers out of range are asked again
+			name:     "tenant of the default project",
+			settings: config.Config{DefaultProject: "proj-1"},
+			input:    "3\n2\n",
+			resolve: func(ctx context.Context, client *api.Client) (string, error) {
+				return resolveOrPickTenantID(ctx, client, "", "", "")
+			},
+			want: "tenant-2",
+		},
+		{
+			name:  "tenant without a project",
+			input: "1\n",
+			resolve: func(ctx context.Context, client *api.Client) (string, error) {
+				return resolveOrPickTenantID(ctx, client, "", "", "")
+			},
+			wantErr: "project is required to pick a tenant",
+		},
+		{
+			name:  "project without tenants",
+			input: "1\n",
+			resolve: func(ctx context.Context, client *api.Client) (string, error) {
+				return resolveOrPickTenantID(ctx, client, "", "", "proj-2")
+			},
+			wantErr: "no tenants found in project",
+		},
+		{
+			// A name is resolved without prompting
+			name:  "tenant by name",
+			input: "1\n",
+			resolve: func(ctx context.Context, client *api.Client) (string, error) {
+				return resolveOrPickTenantID(ctx, client, "prod", "", "proj-1")
+			},
+			want: "tenant-2",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, _ := newFakeAPI(t, tc.settings, pickRoutes())
+			answerPrompts(t, tc.input)
+
+			got, err := tc.resolve(context.Background(), client)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestResolveOrPickNonInteractive(t *testing.T) {
+	tests := []struct {
+		name    string
+		resolve func(ctx context.Context, client *api.Client) (string, error)
+		wantErr string
+	}{
+		{
+			name: "organization",
+			resolve: func(ctx context.Context, client *api.Client) (string, error) {
+				return resolveOrPickOrganizationID(ctx, client, "", "")
+			},
+			wantErr: "either --name or --id must be provided",
+		},
+		{
+			name: "project",
+			resolve: func(ctx context.Context, client *api.Client) (string, error) {
+				return resolveOrPickProjectID(ctx, client, "", "", "org-1")
+			},
+			wantErr: "either --name or --id must be provided for project",
+		},
+		{
+			name: "tenant",
+			resolve: func(ctx context.Context, client *api.Client) (string, error) {
+				return resolveOrPickTenantID(ctx, client, "", "", "proj-1")
+			},
+			wantErr: "either --name or --id must be provided for tenant",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, fake := newFakeAPI(t, config.Config{}, pickRoutes())
+
+			_, err := tc.resolve(context.Background(), client)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+			}
+			if got := fake.received(); len(got) != 0 {
+				t.Errorf("expected no requests, got %v", got)
+			}
+		})
+	}
+}
+
+// TestResolveManifestTargetsWithoutName checks that a manifest without a name
+// fails before anything is resolved, rather than prompting for the resource
+func TestResolveManifestTargetsWithoutName(t *testing.T) {
+	resources := []interface{}{
+		&manifest.Organization{},
+		&manifest.Project{Metadata: manifest.Metadata{Organization: "acme"}},
+		&manifest.Tenant{},
+	}
+	for _, r := range resources {
+		client, fake := newFakeAPI(t, config.Config{DefaultProject: "proj-1"}, pickRoutes())
+		answerPrompts(t, "1\n")
+
+		_, err := resolveManifestTargets(context.Background(), client, []interface{}{r})
+		if err == nil || !strings.Contains(err.Error(), "manifest without metadata.name") {
+			t.Errorf("%T: expected a missing name error, got %v", r, err)
+		}
+		if got := fake.received(); len(got) != 0 {
+			t.Errorf("%T: expected no requests, got %v", r, got)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package cmd
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"spacectl/internal/api"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// pingCmd represents the ping command
+var pingCmd = &cobra.Command{
+	Use:   "ping",
+	Short: "Check that the API is reachable and healthy",
+	Long: `Check the Kubespaces API's health endpoint and report how long it took, the
+TLS connection and server certificate, the server version, and who you are
+authenticated as. Use it to tell whether a problem is on your side (network,
+proxy, certificates, expired login) or the platform's.
+
+The health check is sent once, without retries. The command fails when the API
+cannot be reached or reports that it is not healthy; a failed version or
+identity lookup is shown but does not fail it. Servers older than 1.5.0 have no
+health check and are only reported as reachable.
+
+Examples:
+  spacectl ping
+  spacectl ping --api-url https://api.staging.kubespaces.io
+  spacectl ping -o json`,
+	Args: cobra.NoArgs,
+	RunE: runPing,
+}
+
+func init() {
+	rootCmd.AddCommand(pingCmd)
+}
+
+// Health statuses: ok from a healthy API, or reachable from a server too old to
+// have a health check
+const (
+	healthStatusOK        = "ok"
+	healthStatusReachable = "reachable"
+)
+
+// pingReport is the structured form of ping used for JSON/YAML output
+type pingReport struct {
+	APIURL         string            `json:"api_url" yaml:"api_url"`
+	Status         string            `json:"status" yaml:"status"`
+	Checks         map[string]string `json:"checks,omitempty" yaml:"checks,omitempty"`
+	Error          string            `json:"error,omitempty" yaml:"error,omitempty"`
+	Note           string            `json:"note,omitempty" yaml:"note,omitempty"`
+	LatencyMS      int64             `json:"latency_ms" yaml:"latency_ms"`
+	ConnectMS      int64             `json:"connect_ms,omitempty" yaml:"connect_ms,omitempty"`
+	TLSHandshakeMS int64             `json:"tls_handshake_ms,omitempty" yaml:"tls_handshake_ms,omitempty"`
+	Protocol       string            `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	TLS            *pingTLS          `json:"tls,omitempty" yaml:"tls,omitempty"`
+	ServerVersion  string            `json:"server_version,omitempty" yaml:"server_version,omitempty"`
+	User           string            `json:"user,omitempty" yaml:"user,omitempty"`
+	UserError      string            `json:"user_error,omitempty" yaml:"user_error,omitempty"`
+}
+
+// pingTLS describes the TLS connection to the API and its certificate
+type pingTLS struct {
+	Version     string    `json:"version" yaml:"version"`
+	CipherSuite string    `json:"cipher_suite" yaml:"cipher_suite"`
+	Subject     string    `json:"subject,omitempty" yaml:"subject,omitempty"`
+	Issuer      string    `json:"issuer,omitempty" yaml:"issuer,omitempty"`
+	NotAfter    time.Time `json:"not_after" yaml:"not_after"`
+}
+
+func runPing(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// An unhealthy API is not a usage error
+	cmd.SilenceUsage = true
+
+	// Create API client
+	client := apiClient()
+	versionAPI := api.NewVersionAPI(client)
+	report := pingReport{APIURL: cfg.APIURL}
+
+	check, healthErr := versionAPI.CheckHealth(ctx)
+	if check != nil {
+		report.LatencyMS = check.Latency.Milliseconds()
+		report.ConnectMS = check.Connect.Milliseconds()
+		report.TLSHandshakeMS = check.TLSHandshake.Milliseconds()
+		report.Protocol = check.Proto
+		report.TLS = newPingTLS(check.TLS)
+		if check.Health != nil {
+			report.Status = check.Health.Status
+			report.Checks = check.Health.Checks
+		}
+	}
+	switch {
+	case errors.Is(healthErr, api.ErrUnsupported):
+		// The server answered, it just has no health check to report
+		report.Status = healthStatusReachable
+		report.Note = healthErr.Error()
+	case healthErr != nil && check == nil:
+		report.Status = "unreachable"
+		report.Error = healthErr.Error()
+	case healthErr != nil:
+		report.Status = "unhealthy"
+		report.Error = healthErr.Error()
+	}
+
+	// The rest only helps when the API answered
+	if check != nil {
+		report.ServerVersion = client.ServerVersion(ctx)
+		if cfg.IsAuthenticated() {
+			if user, err := api.NewAuthAPI(client).GetUserInfo(ctx); err != nil {
+				report.UserError = err.Error()
+			} else {
+				report.User = user.Email
+			}
+		}
+	}
+
+	if output.Format(outputFmt) != output.FormatTable {
+		if err := formatter.FormatData(report); err != nil {
+			return err
+		}
+	} else {
+		printPingReport(report)
+	}
+
+	if report.Error != "" {
+		return fmt.Errorf("the API at %s is %s", cfg.APIURL, report.Status)
+	}
+	if report.Status != healthStatusOK && report.Status != healthStatusReachable {
+		return fmt.Errorf("the API at %s reports status %q", cfg.APIURL, report.Status)
+	}
+	return nil
+}
+
+// printPingReport prints a ping report as aligned lines
+func printPingReport(r pingReport) {
+	fmt.Printf("API:            %s\n", r.APIURL)
+	switch {
+	case r.Error != "":
+		fmt.Printf("Status:         %s: %s\n", r.Status, r.Error)
+	case r.Note != "":
+		fmt.Printf("Status:         %s (%s)\n", r.Status, r.Note)
+	default:
+		fmt.Printf("Status:         %s\n", r.Status)
+	}
+	if len(r.Checks) > 0 {
+		fmt.Printf("Checks:         %s\n", formatHealthChecks(r.Checks))
+	}
+	if r.Protocol == "" {
+		return
+	}
+
+	timing := fmt.Sprintf("%dms", r.LatencyMS)
+	var phases []string
+	if r.ConnectMS > 0 {
+		phases = append(phases, fmt.Sprintf("connect %dms", r.ConnectMS))
+	}
+	if r.TLSHandshakeMS > 0 {
+		phases = append(phases, fmt.Sprintf("TLS handshake %dms", r.TLSHandshakeMS))
+	}
+	if len(phases) > 0 {
+		timing += " (" + strings.Join(phases, ", ") + ")"
+	}
+	fmt.Printf("Latency:        %s\n", timing)
+	fmt.Printf("Protocol:       %s\n", r.Protocol)
+
+	if r.TLS == nil {
+		fmt.Println("TLS:            none (plain HTTP)")
+	} else {
+		fmt.Printf("TLS:            %s, %s\n", r.TLS.Version, r.TLS.CipherSuite)
+		if r.TLS.Subject != "" {
+			days := int(time.Until(r.TLS.NotAfter).Hours() / 24)
+			fmt.Printf("Certificate:    %s, issued by %s\n", r.TLS.Subject, r.TLS.Issuer)
+			fmt.Printf("Expires:        %s (in %d days)\n", r.TLS.NotAfter.Local().Format("2006-01-02"), days)
+		}
+	}
+
+	fmt.Printf("Server version: %s\n", valueOrUnknown(r.ServerVersion))
+	switch {
+	case r.UserError != "":
+		fmt.Printf("User:           %s\n", r.UserError)
+	case r.User != "":
+		fmt.Printf("User:           %s\n", r.User)
+	default:
+		fmt.Println("User:           not logged in")
+	}
+}
+
+// newPingTLS describes a TLS connection, or returns nil for plain HTTP
+func newPingTLS(state *tls.ConnectionState) *pingTLS {
+	if state == nil {
+		return nil
+	}
+	info := &pingTLS{
+		Version:     tls.VersionName(state.Version),
+		CipherSuite: tls.CipherSuiteName(state.CipherSuite),
+	}
+	if len(state.PeerCertificates) > 0 {
+		cert := state.PeerCertificates[0]
+		info.Subject = certificateName(cert.Subject.CommonName, cert.DNSNames)
+		info.Issuer = certificateName(cert.Issuer.CommonName, nil)
+		if info.Issuer == "" {
+			info.Issuer = cert.Issuer.String()
+		}
+		info.NotAfter = cert.NotAfter
+	}
+	return info
+}
+
+// certificateName names a certificate by its common name, or its first DNS name
+func certificateName(commonName string, dnsNames []string) string {
+	if commonName == "" && len(dnsNames) > 0 {
+		return dnsNames[0]
+	}
+	return commonName
+}
+
+// formatHealthChecks renders dependency checks as "database ok, queue degraded"
+func formatHealthChecks(checks map[string]string) string {
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = name + " " + checks[name]
+	}
+	return strings.Join(parts, ", ")
+}
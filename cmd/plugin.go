@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+
+	"spacectl/internal/config"
+	"spacectl/internal/plugin"
+
+	"github.com/spf13/cobra"
+)
+
+// pluginCmd represents the plugin command
+var pluginCmd = &cobra.Command{
+	Use:   "plugin",
+	Short: "Work with spacectl plugins",
+	Long: `Plugins extend spacectl without changing it. Any executable on your PATH
+named spacectl-<name> can be run as "spacectl <name>"; spacectl-foo-bar runs as
+"spacectl foo bar", and an underscore stands for a dash, so spacectl-foo_bar runs
+as "spacectl foo-bar". Built-in commands take precedence over plugins.
+
+Plugins receive their arguments unchanged, plus these environment variables:
+  SPACECTL_API_URL        the API URL from the config
+  SPACECTL_ACCESS_TOKEN   the access token of the logged-in user, if any
+  SPACECTL_PROJECT        the default project ID, if set
+  SPACECTL_BIN            the path of the spacectl executable, to call back into it`,
+}
+
+// pluginListCmd represents the plugin list command
+var pluginListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List plugins found on PATH",
+	Args:  cobra.NoArgs,
+	RunE:  runPluginList,
+}
+
+func init() {
+	rootCmd.AddCommand(pluginCmd)
+	pluginCmd.AddCommand(pluginListCmd)
+}
+
+func runPluginList(cmd *cobra.Command, args []string) error {
+	plugins := plugin.List()
+	if len(plugins) == 0 {
+		if !quiet {
+			fmt.Fprintln(os.Stderr, "No plugins found on PATH")
+		}
+		return nil
+	}
+
+	var rows []map[string]interface{}
+	for _, p := range plugins {
+		if isBuiltinCommand(strings.Fields(p.Name)) {
+			fmt.Fprintf(os.Stderr, "Warning: %s is shadowed by the built-in command \"spacectl %s\" and cannot be run\n", p.Path, p.Name)
+		}
+		rows = append(rows, map[string]interface{}{
+			"name": p.Name,
+			"path": p.Path,
+		})
+	}
+	return formatter.FormatRows(rows, []string{"name", "path"})
+}
+
+// isBuiltinCommand reports whether args start with a spacectl command
+func isBuiltinCommand(args []string) bool {
+	switch args[0] {
+	case "help", "completion", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return true
+	}
+	c, _, err := rootCmd.Find(args)
+	return err == nil && c != rootCmd
+}
+
+// findPlugin returns the plugin to run for a command line that does not start
+// with a built-in command
+func findPlugin(args []string) (string, []string, bool) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") || isBuiltinCommand(args) {
+		return "", nil, false
+	}
+	return plugin.Find(args)
+}
+
+// runPlugin runs a plugin with the user's API settings in its environment and
+// waits for it. A non-zero exit status is passed on as spacectl's own.
+func runPlugin(path string, args []string) error {
+	env := os.Environ()
+	if c, err := config.Load(); err == nil {
+		env = append(env, "SPACECTL_API_URL="+c.APIURL)
+		if c.IsAuthenticated() {
+			env = append(env, "SPACECTL_ACCESS_TOKEN="+c.AccessToken)
+		}
+		if c.DefaultProject != "" {
+			env = append(env, "SPACECTL_PROJECT="+c.DefaultProject)
+		}
+	}
+	if self, err := os.Executable(); err == nil {
+		env = append(env, "SPACECTL_BIN="+self)
+	}
+
+	// Ctrl-C reaches the plugin directly; keep spacectl alive until it exits
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt)
+	defer signal.Stop(sigs)
+
+	plug := exec.Command(path, args...)
+	plug.Stdin, plug.Stdout, plug.Stderr = os.Stdin, os.Stdout, os.Stderr
+	plug.Env = env
+	err := plug.Run()
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitCodeError{code: exitErr.ExitCode()}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to run plugin %s: %w", path, err)
+	}
+	return nil
+}
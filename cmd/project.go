@@ -1,13 +1,17 @@
 package cmd
 
 import (
-	"bufio"
+	"context"
 	"fmt"
 	"os"
-	"strings"
+	"time"
 
 	"spacectl/internal/api"
 	"spacectl/internal/models"
+	"spacectl/internal/names"
+	"spacectl/internal/output"
+	"spacectl/internal/parallel"
+	"spacectl/internal/units"
 
 	"github.com/spf13/cobra"
 )
@@ -27,197 +31,315 @@ func init() {
 var projectListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List projects",
-	Long:  `List projects. Use --org to filter by organization.`,
-	RunE:  runProjectList,
+	Long: `List projects. Use --org to filter by organization, --mine to only show
+projects you are a member of, and --filter/--sort-by to refine the listing.
+
+Each project is shown with its tenant count and the compute and memory
+allocated to its tenants against its limits, e.g. 12/64. These take one request
+per project; --no-counts and --no-usage leave them out.
+
+Examples:
+  spacectl project list --all --mine
+  spacectl project list --filter name=web-* --sort-by -tenant_count
+  spacectl project list --no-counts --no-usage`,
+	RunE: runProjectList,
 }
 
-var projectListOrg string
-var projectListOrgName string
 var projectListAll bool
+var projectListMine bool
+var projectListNoCounts bool
+var projectListNoUsage bool
 
 func init() {
 	projectCmd.AddCommand(projectListCmd)
-	projectListCmd.Flags().StringVar(&projectListOrg, "org", "", "Organization ID to filter projects")
-	projectListCmd.Flags().StringVar(&projectListOrgName, "org-name", "", "Organization name to filter projects")
 	projectListCmd.Flags().BoolVar(&projectListAll, "all", false, "List projects from all organizations")
+	addConcurrencyFlag(projectListCmd)
+	projectListCmd.Flags().BoolVar(&projectListMine, "mine", false, "Only list projects you are a member of")
+	projectListCmd.Flags().BoolVar(&projectListNoCounts, "no-counts", false, "Skip fetching tenant counts for faster listings")
+	projectListCmd.Flags().BoolVar(&projectListNoUsage, "no-usage", false, "Skip fetching the compute and memory allocated to tenants for faster listings")
+	addListFlags(projectListCmd)
 }
 
 func runProjectList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl auth login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	projectAPI := api.NewProjectAPI(client)
 	orgAPI := api.NewOrganizationAPI(client)
 	tenantAPI := api.NewTenantAPI(client)
 
 	// Validate flags
-	if projectListOrgName != "" && projectListOrg != "" {
-		return fmt.Errorf("only one of --org or --org-name is allowed")
-	}
-	if projectListAll && (projectListOrg != "" || projectListOrgName != "") {
+	if projectListAll && orgFlagSet() {
 		return fmt.Errorf("--all cannot be used with --org or --org-name")
 	}
 
+	// Collect the user's own memberships when only their projects are wanted
+	var memberships map[string]string
+	if projectListMine {
+		userProjects, err := projectAPI.ListUserProjects(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list user projects: %w", err)
+		}
+		memberships = make(map[string]string, len(userProjects))
+		for _, m := range userProjects {
+			memberships[m.Project.ID] = m.Role
+		}
+	}
+
 	if projectListAll {
 		// List projects from all organizations with tenant counts
-		return runProjectListAll(client, projectAPI, orgAPI, tenantAPI)
+		return runProjectListAll(ctx, client, projectAPI, orgAPI, tenantAPI, memberships)
 	}
 
-	// Determine target organization
-	var targetOrgID string
-	if projectListOrgName != "" {
-		org, err := orgAPI.GetOrganizationByName(projectListOrgName)
-		if err != nil {
-			return fmt.Errorf("failed to resolve organization by name: %w", err)
-		}
-		targetOrgID = org.ID
-	} else if projectListOrg != "" {
-		targetOrgID = projectListOrg
-	} else {
-		// Use default organization
-		defOrg, err := orgAPI.GetDefaultOrganization()
-		if err != nil {
-			return fmt.Errorf("failed to get default organization: %w", err)
-		}
-		targetOrgID = defOrg.ID
+	// Determine target organization, defaulting to the user's default organization
+	targetOrgID, err := requireOrgID(ctx, client)
+	if err != nil {
+		return err
 	}
 
 	// List projects in target organization with tenant counts
-	return runProjectListForOrg(client, projectAPI, tenantAPI, targetOrgID)
+	return runProjectListForOrg(ctx, client, projectAPI, tenantAPI, targetOrgID, memberships)
 }
 
-// runProjectListForOrg lists projects in a specific organization with tenant counts
-func runProjectListForOrg(client *api.Client, projectAPI *api.ProjectAPI, tenantAPI *api.TenantAPI, orgID string) error {
+// runProjectListForOrg lists projects in a specific organization with tenant counts.
+// When memberships is non-nil, only projects the user is a member of are listed.
+func runProjectListForOrg(ctx context.Context, client *api.Client, projectAPI *api.ProjectAPI, tenantAPI *api.TenantAPI, orgID string, memberships map[string]string) error {
 	// Get projects in organization
-	projects, err := projectAPI.ListOrganizationProjects(orgID)
+	projects, err := projectAPI.ListOrganizationProjects(listContext(ctx), orgID)
 	if err != nil {
 		return fmt.Errorf("failed to list organization projects: %w", err)
 	}
 
-	// Create enhanced project list with tenant counts
+	// Create enhanced project list
 	var enhancedProjects []map[string]interface{}
+	var listed []models.Project
 	for _, project := range projects {
-		// Get tenant count for this project
-		tenants, err := tenantAPI.ListProjectTenants(project.ID)
-		if err != nil {
-			// If we can't get tenant count, continue with 0
-			tenants = []models.Tenant{}
+		role := "admin" // Default role for org projects
+		if memberships != nil {
+			var ok bool
+			if role, ok = memberships[project.ID]; !ok {
+				continue
+			}
 		}
 
 		enhancedProject := map[string]interface{}{
-			"id":           project.ID,
-			"name":         project.Name,
-			"role":         "admin", // Default role for org projects
-			"tenant_count": len(tenants),
+			"id":     project.ID,
+			"name":   project.Name,
+			"role":   role,
+			"status": project.DisplayStatus(),
 		}
 		enhancedProjects = append(enhancedProjects, enhancedProject)
+		listed = append(listed, project)
 	}
 
-	return formatter.FormatData(enhancedProjects)
+	addTenantTotals(ctx, tenantAPI, enhancedProjects, listed)
+	return formatter.FormatRows(enhancedProjects, projectColumns)
 }
 
-// runProjectListAll lists projects from all organizations with tenant counts
-func runProjectListAll(client *api.Client, projectAPI *api.ProjectAPI, orgAPI *api.OrganizationAPI, tenantAPI *api.TenantAPI) error {
+// runProjectListAll lists projects from all organizations with tenant counts.
+// When memberships is non-nil, only projects the user is a member of are listed.
+func runProjectListAll(ctx context.Context, client *api.Client, projectAPI *api.ProjectAPI, orgAPI *api.OrganizationAPI, tenantAPI *api.TenantAPI, memberships map[string]string) error {
 	// Get all user organizations
-	orgs, err := orgAPI.ListUserOrganizations()
+	orgs, err := orgAPI.ListUserOrganizations(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to list user organizations: %w", err)
 	}
 
-	// Collect all projects with tenant counts
-	var allProjects []map[string]interface{}
-	for _, orgMembership := range orgs {
-		projects, err := projectAPI.ListOrganizationProjects(orgMembership.Organization.ID)
+	// Collect all projects, listing the organizations' projects --concurrency at a time
+	orgProjects := parallel.Map(orgs, concurrency, func(m models.OrganizationMembershipResponse) []models.Project {
+		projects, err := projectAPI.ListOrganizationProjects(listContext(ctx), m.Organization.ID)
 		if err != nil {
 			// Skip organizations where we can't list projects
-			continue
+			return nil
 		}
-
-		for _, project := range projects {
-			// Get tenant count for this project
-			tenants, err := tenantAPI.ListProjectTenants(project.ID)
-			if err != nil {
-				// If we can't get tenant count, continue with 0
-				tenants = []models.Tenant{}
+		return projects
+	})
+	var allProjects []map[string]interface{}
+	var listed []models.Project
+	for i, orgMembership := range orgs {
+		for _, project := range orgProjects[i] {
+			role := orgMembership.Role
+			if memberships != nil {
+				var ok bool
+				if role, ok = memberships[project.ID]; !ok {
+					continue
+				}
 			}
 
 			enhancedProject := map[string]interface{}{
 				"id":           project.ID,
 				"organization": orgMembership.Organization.Name,
 				"name":         project.Name,
-				"role":         orgMembership.Role,
-				"tenant_count": len(tenants),
+				"role":         role,
+				"status":       project.DisplayStatus(),
 			}
 			allProjects = append(allProjects, enhancedProject)
+			listed = append(listed, project)
+		}
+	}
+	allProjects = limitTotal(allProjects)
+	listed = listed[:len(allProjects)]
+
+	addTenantTotals(ctx, tenantAPI, allProjects, listed)
+	return formatter.FormatRows(allProjects, projectColumns)
+}
+
+// projectColumns are the columns of project list rows in display order
+var projectColumns = []string{"id", "name", "organization", "role", "status", "tenant_count", "compute", "memory"}
+
+// addTenantTotals adds the tenant count and the compute and memory allocated to
+// tenants to the rows of the listed projects, unless --no-counts and --no-usage
+// turn them off. Tables show the allocation against the limits, e.g. "12/64";
+// structured formats get the numbers.
+func addTenantTotals(ctx context.Context, tenantAPI *api.TenantAPI, rows []map[string]interface{}, projects []models.Project) {
+	if projectListNoCounts && projectListNoUsage {
+		return
+	}
+	projectIDs := make([]string, len(projects))
+	for i, p := range projects {
+		projectIDs[i] = p.ID
+	}
+	totals := fetchTenantTotals(ctx, tenantAPI, projectIDs)
+
+	structured := output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML
+	for i, row := range rows {
+		project, t := projects[i], totals[projects[i].ID]
+		if !projectListNoCounts {
+			row["tenant_count"] = t.count
 		}
+		if projectListNoUsage {
+			continue
+		}
+		if structured {
+			row["compute_allocated"] = t.compute
+			row["max_compute"] = project.MaxCompute
+			row["memory_allocated_gb"] = t.memoryGB
+			row["max_memory_gb"] = project.MaxMemoryGB
+			continue
+		}
+		row["compute"] = allocation(units.Cores(t.compute), units.Cores(project.MaxCompute))
+		row["memory"] = allocation(units.GiB(t.memoryGB), units.GiB(project.MaxMemoryGB))
+	}
+}
+
+// allocation formats an allocated amount against a limit, e.g. "12/64"; a limit
+// of zero is unlimited and left out
+func allocation(used, limit fmt.Stringer) string {
+	if limit.String() == "0" {
+		return used.String()
 	}
+	return used.String() + "/" + limit.String()
+}
 
-	return formatter.FormatData(allProjects)
+// tenantTotals sums up the tenants of a project
+type tenantTotals struct {
+	count    int
+	compute  int
+	memoryGB int
+}
+
+// fetchTenantTotals fetches the tenant count and allocated quotas of the given
+// projects, --concurrency at a time. Projects whose tenants cannot be listed
+// are reported with zeros.
+func fetchTenantTotals(ctx context.Context, tenantAPI *api.TenantAPI, projectIDs []string) map[string]tenantTotals {
+	results := parallel.Map(projectIDs, concurrency, func(projectID string) tenantTotals {
+		var t tenantTotals
+		if tenants, err := tenantAPI.ListProjectTenants(ctx, projectID); err == nil {
+			t.count = len(tenants)
+			for _, tenant := range tenants {
+				t.compute += tenant.ComputeQuota
+				t.memoryGB += tenant.MemoryQuotaGB
+			}
+		}
+		return t
+	})
+
+	totals := make(map[string]tenantTotals, len(projectIDs))
+	for i, id := range projectIDs {
+		totals[id] = results[i]
+	}
+	return totals
 }
 
 // projectCreateCmd represents the project create command
 var projectCreateCmd = &cobra.Command{
-	Use:   "create <name>",
+	Use:   "create [name]",
 	Short: "Create a project",
-	Long:  `Create a new project in the specified organization.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runProjectCreate,
+	Long: `Create a new project in the organization given with --org or --org-name,
+or in your default organization.
+
+Use --interactive to be prompted for the organization, description, and quota
+limits instead of passing them as flags.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if projectCreateInteractive {
+			return cobra.MaximumNArgs(1)(cmd, args)
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	},
+	RunE: runProjectCreate,
 }
 
 var (
-	projectCreateOrg        string
-	projectCreateOrgName    string
-	projectCreateDesc       string
-	projectCreateMaxTenants int
-	projectCreateMaxCompute int
-	projectCreateMaxMemory  int
+	projectCreateOrg         string
+	projectCreateDesc        string
+	projectCreateMaxTenants  int
+	projectCreateMaxCompute  int
+	projectCreateMaxMemory   int
+	projectCreateInteractive bool
 )
 
 func init() {
 	projectCmd.AddCommand(projectCreateCmd)
-	projectCreateCmd.Flags().StringVar(&projectCreateOrg, "org", "", "Organization ID")
-	projectCreateCmd.Flags().StringVar(&projectCreateOrgName, "org-name", "", "Organization name")
 	projectCreateCmd.Flags().StringVar(&projectCreateDesc, "description", "", "Project description")
 	projectCreateCmd.Flags().IntVar(&projectCreateMaxTenants, "max-tenants", 0, "Maximum number of tenants")
 	projectCreateCmd.Flags().IntVar(&projectCreateMaxCompute, "max-compute", 0, "Maximum compute quota")
 	projectCreateCmd.Flags().IntVar(&projectCreateMaxMemory, "max-memory", 0, "Maximum memory quota (GB)")
+	projectCreateCmd.Flags().BoolVarP(&projectCreateInteractive, "interactive", "i", false, "Prompt for project settings")
 }
 
 func runProjectCreate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
-	name := args[0]
+	var name string
+	if len(args) > 0 {
+		name = args[0]
+	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	projectAPI := api.NewProjectAPI(client)
 	orgAPI := api.NewOrganizationAPI(client)
 
-	// Resolve org if name used
-	if projectCreateOrgName != "" && projectCreateOrg != "" {
-		return fmt.Errorf("only one of --org or --org-name is allowed")
-	}
-	if projectCreateOrg == "" && projectCreateOrgName != "" {
-		org, err := orgAPI.GetOrganizationByName(projectCreateOrgName)
+	// Prompt for anything not given as flags
+	projectCreateOrg = contextOrgID()
+	if projectCreateInteractive {
+		var err error
+		name, err = promptProjectCreate(ctx, orgAPI, name)
 		if err != nil {
-			return fmt.Errorf("failed to resolve organization by name: %w", err)
+			return err
 		}
-		projectCreateOrg = org.ID
 	}
+	if err := names.ValidateProject(name); err != nil {
+		return err
+	}
+
 	// If still empty, use default organization
 	if projectCreateOrg == "" {
-		def, err := orgAPI.GetDefaultOrganization()
-		if err != nil {
-			return fmt.Errorf("failed to get default organization: %w", err)
+		var err error
+		if projectCreateOrg, err = requireOrgID(ctx, client); err != nil {
+			return err
 		}
-		projectCreateOrg = def.ID
 	}
 
 	// Prepare request
@@ -233,7 +355,7 @@ func runProjectCreate(cmd *cobra.Command, args []string) error {
 	}
 
 	// Create project
-	project, err := projectAPI.CreateProject(projectCreateOrg, req)
+	project, err := projectAPI.CreateProject(ctx, projectCreateOrg, req)
 	if err != nil {
 		return fmt.Errorf("failed to create project: %w", err)
 	}
@@ -242,51 +364,94 @@ func runProjectCreate(cmd *cobra.Command, args []string) error {
 	return formatter.FormatData(project)
 }
 
+// promptProjectCreate interactively fills in the project create settings and returns the project name
+func promptProjectCreate(ctx context.Context, orgAPI *api.OrganizationAPI, name string) (string, error) {
+	for name == "" {
+		var err error
+		if name, err = promptString("Project name", ""); err != nil {
+			return "", err
+		}
+		if name == "" {
+			fmt.Println("Project name is required.")
+		} else if err := names.ValidateProject(name); err != nil {
+			fmt.Println(err)
+			name = ""
+		}
+	}
+
+	// Pick an organization from the user's memberships
+	if projectCreateOrg == "" {
+		orgs, err := orgAPI.ListUserOrganizations(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to list organizations: %w", err)
+		}
+		var options []string
+		def := 0
+		for i, m := range orgs {
+			label := m.Organization.Name
+			if m.IsDefault {
+				label += " (default)"
+				def = i
+			}
+			options = append(options, label)
+		}
+		idx, err := promptSelect("Organization", options, def)
+		if err != nil {
+			return "", err
+		}
+		projectCreateOrg = orgs[idx].Organization.ID
+	}
+
+	var err error
+	if projectCreateDesc, err = promptString("Description", projectCreateDesc); err != nil {
+		return "", err
+	}
+	if projectCreateMaxTenants, err = promptInt("Maximum tenants", projectCreateMaxTenants); err != nil {
+		return "", err
+	}
+	if projectCreateMaxCompute, err = promptInt("Maximum compute (cores)", projectCreateMaxCompute); err != nil {
+		return "", err
+	}
+	if projectCreateMaxMemory, err = promptInt("Maximum memory (GB)", projectCreateMaxMemory); err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
 // projectGetCmd represents the project get command
 var projectGetCmd = &cobra.Command{
 	Use:   "get",
 	Short: "Get project details",
-	Long:  `Get detailed information about a specific project.`,
+	Long:  `Get detailed information about the project given with --project or --project-name, or the default project.`,
 	Args:  cobra.NoArgs,
 	RunE:  runProjectGet,
 }
 
 func init() {
 	projectCmd.AddCommand(projectGetCmd)
-	projectGetCmd.Flags().StringVar(&projectGetID, "project-id", "", "Project ID")
-	projectGetCmd.Flags().StringVar(&projectGetName, "project-name", "", "Project name")
 }
 
-var (
-	projectGetID   string
-	projectGetName string
-)
-
 func runProjectGet(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	projectAPI := api.NewProjectAPI(client)
 
 	// Resolve project
-	if projectGetID != "" && projectGetName != "" {
-		return fmt.Errorf("only one of --project-id or --project-name is allowed")
-	}
-	id := projectGetID
-	if id == "" {
-		var err error
-		id, err = resolveProjectID(client, projectGetName, "", "")
-		if err != nil {
-			return err
-		}
+	id, err := requireProjectID()
+	if err != nil {
+		return err
 	}
 
 	// Get project
-	project, err := projectAPI.GetProject(id)
+	project, err := projectAPI.GetProject(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to get project: %w", err)
 	}
@@ -299,9 +464,10 @@ func runProjectGet(cmd *cobra.Command, args []string) error {
 var projectUpdateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "Update a project",
-	Long:  `Update a project's metadata.`,
-	Args:  cobra.NoArgs,
-	RunE:  runProjectUpdate,
+	Long: `Update a project's metadata. Only the fields passed as flags are sent, so
+concurrent edits to other fields are not overwritten.`,
+	Args: cobra.NoArgs,
+	RunE: runProjectUpdate,
 }
 
 var (
@@ -310,78 +476,58 @@ var (
 	projectUpdateMaxTenants int
 	projectUpdateMaxCompute int
 	projectUpdateMaxMemory  int
-	projectUpdateTargetID   string
-	projectUpdateTargetName string
 )
 
 func init() {
 	projectCmd.AddCommand(projectUpdateCmd)
 	projectUpdateCmd.Flags().StringVar(&projectUpdateName, "name", "", "New project name")
 	projectUpdateCmd.Flags().StringVar(&projectUpdateDesc, "description", "", "New project description")
-	projectUpdateCmd.Flags().IntVar(&projectUpdateMaxTenants, "max-tenants", -1, "New maximum number of tenants")
-	projectUpdateCmd.Flags().IntVar(&projectUpdateMaxCompute, "max-compute", -1, "New maximum compute quota")
-	projectUpdateCmd.Flags().IntVar(&projectUpdateMaxMemory, "max-memory", -1, "New maximum memory quota (GB)")
-	projectUpdateCmd.Flags().StringVar(&projectUpdateTargetID, "project-id", "", "Project ID to update")
-	projectUpdateCmd.Flags().StringVar(&projectUpdateTargetName, "project-name", "", "Project name to update")
+	projectUpdateCmd.Flags().IntVar(&projectUpdateMaxTenants, "max-tenants", 0, "New maximum number of tenants")
+	projectUpdateCmd.Flags().IntVar(&projectUpdateMaxCompute, "max-compute", 0, "New maximum compute quota")
+	projectUpdateCmd.Flags().IntVar(&projectUpdateMaxMemory, "max-memory", 0, "New maximum memory quota (GB)")
 }
 
 func runProjectUpdate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
-	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
-	projectAPI := api.NewProjectAPI(client)
-
-	// Resolve target project by name or id
-	if projectUpdateTargetID != "" && projectUpdateTargetName != "" {
-		return fmt.Errorf("only one of --project-id or --project-name is allowed")
+	// Build a partial update from the flags that were explicitly set
+	var req models.PatchProjectRequest
+	if cmd.Flags().Changed("name") {
+		req.Name = &projectUpdateName
 	}
-	id := projectUpdateTargetID
-	if id == "" {
-		var err error
-		id, err = resolveProjectID(client, projectUpdateTargetName, "", "")
-		if err != nil {
-			return err
-		}
+	if cmd.Flags().Changed("description") {
+		req.Description = &projectUpdateDesc
 	}
-
-	// Get current project to fill in missing fields
-	currentProject, err := projectAPI.GetProject(id)
-	if err != nil {
-		return fmt.Errorf("failed to get current project: %w", err)
+	if cmd.Flags().Changed("max-tenants") {
+		req.MaxTenants = &projectUpdateMaxTenants
 	}
-
-	// Prepare request
-	req := models.UpdateProjectRequest{
-		Name:        projectUpdateName,
-		Description: &projectUpdateDesc,
-		MaxTenants:  projectUpdateMaxTenants,
-		MaxCompute:  projectUpdateMaxCompute,
-		MaxMemoryGB: projectUpdateMaxMemory,
+	if cmd.Flags().Changed("max-compute") {
+		req.MaxCompute = &projectUpdateMaxCompute
 	}
-
-	// Use current values for fields not provided
-	if req.Name == "" {
-		req.Name = currentProject.Name
-	}
-	if req.Description == nil || *req.Description == "" {
-		req.Description = currentProject.Description
+	if cmd.Flags().Changed("max-memory") {
+		req.MaxMemoryGB = &projectUpdateMaxMemory
 	}
-	if req.MaxTenants == -1 {
-		req.MaxTenants = currentProject.MaxTenants
+	if req == (models.PatchProjectRequest{}) {
+		return fmt.Errorf("nothing to update: provide at least one of --name, --description, --max-tenants, --max-compute, or --max-memory")
 	}
-	if req.MaxCompute == -1 {
-		req.MaxCompute = currentProject.MaxCompute
-	}
-	if req.MaxMemoryGB == -1 {
-		req.MaxMemoryGB = currentProject.MaxMemoryGB
+
+	// Create API client
+	client := apiClient()
+	projectAPI := api.NewProjectAPI(client)
+
+	// Resolve target project
+	id, err := requireProjectID()
+	if err != nil {
+		return err
 	}
 
 	// Update project
-	project, err := projectAPI.UpdateProject(id, req)
+	project, err := projectAPI.PatchProject(ctx, id, req)
 	if err != nil {
 		return fmt.Errorf("failed to update project: %w", err)
 	}
@@ -394,9 +540,11 @@ func runProjectUpdate(cmd *cobra.Command, args []string) error {
 var projectDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete a project",
-	Long:  `Delete a project. This action cannot be undone.`,
-	Args:  cobra.NoArgs,
-	RunE:  runProjectDelete,
+	Long: `Delete a project. This action cannot be undone.
+
+You must type the project name to confirm unless --force or --yes is given.`,
+	Args: cobra.NoArgs,
+	RunE: runProjectDelete,
 }
 
 var (
@@ -413,54 +561,40 @@ func init() {
 }
 
 func runProjectDelete(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	projectAPI := api.NewProjectAPI(client)
 
 	// Resolve project
 	if projectDeleteID != "" && projectDeleteName != "" {
 		return fmt.Errorf("only one of --id or --name is allowed")
 	}
-	id := projectDeleteID
-	if id == "" {
-		var err error
-		id, err = resolveProjectID(client, projectDeleteName, "", "")
-		if err != nil {
-			return err
-		}
+	id, err := resolveOrPickProjectID(ctx, client, projectDeleteName, projectDeleteID, "")
+	if err != nil {
+		return err
 	}
 
 	// Get project details for confirmation
-	project, err := projectAPI.GetProject(id)
+	project, err := projectAPI.GetProject(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to get project details: %w", err)
 	}
 
-	// Ask for confirmation unless --force is used
-	if !projectDeleteForce {
-		fmt.Printf("Are you sure you want to delete project '%s' (ID: %s)? This action cannot be undone.\n", project.Name, id)
-		fmt.Print("Type 'yes' to confirm: ")
-
-		reader := bufio.NewReader(os.Stdin)
-		response, err := reader.ReadString('\n')
-		if err != nil {
-			return fmt.Errorf("failed to read input: %w", err)
-		}
-
-		response = strings.TrimSpace(strings.ToLower(response))
-		if response != "yes" {
-			fmt.Println("Deletion cancelled.")
-			return nil
-		}
+	// Ask for confirmation unless --force or --yes is used
+	question := fmt.Sprintf("Are you sure you want to delete project '%s' (ID: %s)? This action cannot be undone.", project.Name, id)
+	if ok, err := confirmTyped(question, "the project name", project.Name, projectDeleteForce); err != nil || !ok {
+		return err
 	}
 
 	// Delete project
-	err = projectAPI.DeleteProject(id)
+	err = projectAPI.DeleteProject(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete project: %w", err)
 	}
@@ -488,91 +622,201 @@ func init() {
 var projectMembersListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List project members",
-	Long:  `List all members of a project.`,
-	Args:  cobra.NoArgs,
-	RunE:  runProjectMembersList,
+	Long: `List all members of a project with their email addresses and roles.
+
+Members are listed by user ID when their email address cannot be looked up, and
+always with --show-ids, which prints the memberships as the API returns them.
+
+Examples:
+  spacectl project members list --project-name my-project
+  spacectl project members list --project abc123 --show-ids
+  spacectl project members list --project-name my-project -o json`,
+	Args: cobra.NoArgs,
+	RunE: runProjectMembersList,
 }
 
+var projectMembersListShowIDs bool
+
 func init() {
 	projectMembersCmd.AddCommand(projectMembersListCmd)
-	projectMembersListCmd.Flags().StringVar(&projectMembersListProjID, "project-id", "", "Project ID")
-	projectMembersListCmd.Flags().StringVar(&projectMembersListProjName, "project-name", "", "Project name")
+	addListFlags(projectMembersListCmd)
+	projectMembersListCmd.Flags().BoolVar(&projectMembersListShowIDs, "show-ids", false, "Show user IDs instead of email addresses")
 }
 
-var (
-	projectMembersListProjID   string
-	projectMembersListProjName string
-)
+// projectMemberView is a project member with their email address, used for output
+type projectMemberView struct {
+	UserID    string    `json:"user_id" yaml:"user_id"`
+	ProjectID string    `json:"project_id" yaml:"project_id"`
+	Email     string    `json:"email,omitempty" yaml:"email,omitempty"`
+	Role      string    `json:"role" yaml:"role"`
+	CreatedAt time.Time `json:"created_at" yaml:"created_at"`
+}
 
 func runProjectMembersList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	// Resolve project
-	projectID, err := resolveProjectID(client, projectMembersListProjName, projectMembersListProjID, "")
+	projectID, err := requireProjectID()
 	if err != nil {
 		return err
 	}
 	projectAPI := api.NewProjectAPI(client)
 
 	// Get project members
-	members, err := projectAPI.ListProjectMembers(projectID)
+	members, err := projectAPI.ListProjectMembers(listContext(ctx), projectID)
 	if err != nil {
 		return fmt.Errorf("failed to list project members: %w", err)
 	}
+	if projectMembersListShowIDs {
+		return formatter.FormatData(members)
+	}
 
-	// Output members
-	return formatter.FormatData(members)
+	// Output members with their email addresses
+	views := projectMemberViews(ctx, client, members)
+	if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML {
+		return formatter.FormatData(views)
+	}
+	return formatter.FormatRows(projectMemberRecords(views), projectMemberColumns)
+}
+
+// projectMemberViews adds the email address of each member. Members whose email
+// cannot be looked up, for example on servers without user lookup, keep only
+// their user ID, with a warning.
+func projectMemberViews(ctx context.Context, client *api.Client, members []models.ProjectMember) []projectMemberView {
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.UserID)
+	}
+	emails := map[string]string{}
+	if len(ids) > 0 {
+		users, err := api.NewUserAPI(client).ListUsersByID(ctx, ids)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: showing user IDs, could not look up member emails: %v\n", err)
+		}
+		for _, u := range users {
+			emails[u.ID] = u.Email
+		}
+	}
+
+	views := make([]projectMemberView, 0, len(members))
+	for _, m := range members {
+		views = append(views, projectMemberView{
+			UserID:    m.UserID,
+			ProjectID: m.ProjectID,
+			Email:     emails[m.UserID],
+			Role:      m.Role,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+	return views
+}
+
+// projectMemberColumns are the columns of projectMemberRecords rows in display order
+var projectMemberColumns = []string{"member", "role", "joined"}
+
+// projectMemberRecords builds the table rows for project members, naming each
+// by email address, or by user ID when it is unknown
+func projectMemberRecords(views []projectMemberView) []map[string]interface{} {
+	rows := make([]map[string]interface{}, 0, len(views))
+	for _, v := range views {
+		member := v.Email
+		if member == "" {
+			member = v.UserID
+		}
+		rows = append(rows, map[string]interface{}{
+			"member": member,
+			"role":   v.Role,
+			"joined": v.CreatedAt.Local().Format("2006-01-02 15:04:05"),
+		})
+	}
+	return rows
 }
 
 // projectMembersAddCmd represents the project members add command
 var projectMembersAddCmd = &cobra.Command{
 	Use:   "add",
 	Short: "Add a member to a project",
-	Long:  `Add a user to a project with the specified role.`,
-	Args:  cobra.NoArgs,
-	RunE:  runProjectMembersAdd,
+	Long: `Add a user to a project with the specified role.
+
+Identify the user with --user (user ID) or --email. When no account exists for
+the email address, a project invitation is sent instead.
+
+Examples:
+  spacectl project members add --project-name my-project --email alice@example.com --role member
+  spacectl project members add --project abc123 --user 42 --role admin`,
+	Args: cobra.NoArgs,
+	RunE: runProjectMembersAdd,
 }
 
 var (
-	projectMembersAddUserID   string
-	projectMembersAddRole     string
-	projectMembersAddProjID   string
-	projectMembersAddProjName string
+	projectMembersAddUserID string
+	projectMembersAddEmail  string
+	projectMembersAddRole   string
 )
 
 func init() {
 	projectMembersCmd.AddCommand(projectMembersAddCmd)
 	projectMembersAddCmd.Flags().StringVar(&projectMembersAddUserID, "user", "", "User ID to add")
+	projectMembersAddCmd.Flags().StringVar(&projectMembersAddEmail, "email", "", "Email of the user to add (sends an invitation if no account exists)")
 	projectMembersAddCmd.Flags().StringVar(&projectMembersAddRole, "role", "", "Role (admin, member)")
-	projectMembersAddCmd.Flags().StringVar(&projectMembersAddProjID, "project-id", "", "Project ID")
-	projectMembersAddCmd.Flags().StringVar(&projectMembersAddProjName, "project-name", "", "Project name")
-	projectMembersAddCmd.MarkFlagRequired("user")
+	projectMembersAddCmd.RegisterFlagCompletionFunc("role", completeRoles("project"))
 	projectMembersAddCmd.MarkFlagRequired("role")
 }
 
 func runProjectMembersAdd(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
+	}
+
+	if projectMembersAddUserID == "" && projectMembersAddEmail == "" {
+		return fmt.Errorf("either --user or --email must be provided")
+	}
+	if projectMembersAddUserID != "" && projectMembersAddEmail != "" {
+		return fmt.Errorf("only one of --user or --email is allowed")
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	// Resolve project
-	projectID, err := resolveProjectID(client, projectMembersAddProjName, projectMembersAddProjID, "")
+	projectID, err := requireProjectID()
 	if err != nil {
 		return err
 	}
 
 	projectAPI := api.NewProjectAPI(client)
 
+	// Resolve user by email, inviting them if they have no account yet
+	if projectMembersAddEmail != "" {
+		userAPI := api.NewUserAPI(client)
+		user, err := userAPI.LookupUserByEmail(ctx, projectMembersAddEmail)
+		if err != nil {
+			return fmt.Errorf("failed to look up user: %w", err)
+		}
+		if user == nil {
+			if err := projectAPI.SendProjectInvitation(ctx, projectID, projectMembersAddEmail, projectMembersAddRole); err != nil {
+				return fmt.Errorf("failed to send project invitation: %w", err)
+			}
+			if !quiet {
+				fmt.Printf("No account found for %s; sent an invitation to join project %s with role %s\n",
+					projectMembersAddEmail, projectID, projectMembersAddRole)
+			}
+			return nil
+		}
+		projectMembersAddUserID = user.ID
+	}
+
 	// Add user to project
-	err = projectAPI.AddUserToProject(projectID, projectMembersAddUserID, projectMembersAddRole)
+	err = projectAPI.AddUserToProject(ctx, projectID, projectMembersAddUserID, projectMembersAddRole)
 	if err != nil {
 		return fmt.Errorf("failed to add user to project: %w", err)
 	}
@@ -600,20 +844,22 @@ func init() {
 }
 
 func runProjectMembersRemove(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	projectID := args[0]
 	userID := args[1]
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	projectAPI := api.NewProjectAPI(client)
 
 	// Remove user from project
-	err := projectAPI.RemoveUserFromProject(projectID, userID)
+	err := projectAPI.RemoveUserFromProject(ctx, projectID, userID)
 	if err != nil {
 		return fmt.Errorf("failed to remove user from project: %w", err)
 	}
@@ -625,3 +871,69 @@ func runProjectMembersRemove(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// projectSetDefaultCmd represents the project set-default command
+var projectSetDefaultCmd = &cobra.Command{
+	Use:   "set-default",
+	Short: "Set default project",
+	Long: `Set the project used by tenant commands when no --project or --project-name
+is given. The default is stored in ~/.spacectl.`,
+	Args: cobra.NoArgs,
+	RunE: runProjectSetDefault,
+}
+
+var projectSetDefaultClear bool
+
+func init() {
+	projectCmd.AddCommand(projectSetDefaultCmd)
+	projectSetDefaultCmd.Flags().BoolVar(&projectSetDefaultClear, "clear", false, "Clear the default project")
+}
+
+func runProjectSetDefault(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if projectSetDefaultClear {
+		cfg.DefaultProject = ""
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+		if !quiet {
+			fmt.Println("Cleared default project")
+		}
+		return nil
+	}
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+	projectAPI := api.NewProjectAPI(client)
+
+	// Resolve project
+	projectID, err := requireExplicitProjectID()
+	if err != nil {
+		return err
+	}
+
+	// Verify the project exists and is accessible
+	project, err := projectAPI.GetProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	// Persist default project
+	cfg.DefaultProject = project.ID
+	if err := cfg.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	// Output success message
+	if !quiet {
+		fmt.Printf("Successfully set project %s (%s) as default\n", project.Name, project.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"spacectl/internal/api"
+
+	"github.com/spf13/cobra"
+)
+
+// projectArchiveCmd represents the project archive command
+var projectArchiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "Archive a project",
+	Long: `Archive a project. Archived projects keep their tenants and data but no new
+tenants can be created in them. Use 'spacectl project unarchive' to restore.`,
+	Args: cobra.NoArgs,
+	RunE: runProjectArchive,
+}
+
+// projectUnarchiveCmd represents the project unarchive command
+var projectUnarchiveCmd = &cobra.Command{
+	Use:   "unarchive",
+	Short: "Unarchive a project",
+	Long:  `Restore an archived project so new tenants can be created in it again.`,
+	Args:  cobra.NoArgs,
+	RunE:  runProjectUnarchive,
+}
+
+func init() {
+	projectCmd.AddCommand(projectArchiveCmd)
+	projectCmd.AddCommand(projectUnarchiveCmd)
+}
+
+func runProjectArchive(cmd *cobra.Command, args []string) error {
+	return setProjectArchived(cmd.Context(), true)
+}
+
+func runProjectUnarchive(cmd *cobra.Command, args []string) error {
+	return setProjectArchived(cmd.Context(), false)
+}
+
+// setProjectArchived archives or unarchives the project selected by the flags
+func setProjectArchived(ctx context.Context, archived bool) error {
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+	projectAPI := api.NewProjectAPI(client)
+
+	// Resolve project
+	projectID, err := requireExplicitProjectID()
+	if err != nil {
+		return err
+	}
+
+	if archived {
+		_, err = projectAPI.ArchiveProject(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to archive project: %w", err)
+		}
+	} else {
+		_, err = projectAPI.UnarchiveProject(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to unarchive project: %w", err)
+		}
+	}
+
+	// Output success message
+	if !quiet {
+		if archived {
+			fmt.Printf("Successfully archived project %s\n", projectID)
+		} else {
+			fmt.Printf("Successfully unarchived project %s\n", projectID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// projectDescribeCmd represents the project describe command
+var projectDescribeCmd = &cobra.Command{
+	Use:   "describe",
+	Short: "Show a detailed view of a project",
+	Long: `Show project metadata, quota utilization, members, and tenants in a single view.
+
+Examples:
+  spacectl project describe --project-name my-project
+  spacectl project describe --project abc123 -o yaml`,
+	Args: cobra.NoArgs,
+	RunE: runProjectDescribe,
+}
+
+func init() {
+	projectCmd.AddCommand(projectDescribeCmd)
+}
+
+// projectDescription is the structured form of project describe used for JSON/YAML output
+type projectDescription struct {
+	Project models.Project           `json:"project" yaml:"project"`
+	Usage   []map[string]interface{} `json:"usage" yaml:"usage"`
+	Members []projectMemberView      `json:"members" yaml:"members"`
+	Tenants []models.Tenant          `json:"tenants" yaml:"tenants"`
+}
+
+func runProjectDescribe(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+	projectAPI := api.NewProjectAPI(client)
+	tenantAPI := api.NewTenantAPI(client)
+
+	// Resolve project
+	projectID, err := requireProjectID()
+	if err != nil {
+		return err
+	}
+
+	// Gather project details
+	project, err := projectAPI.GetProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	members, err := projectAPI.ListProjectMembers(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to list project members: %w", err)
+	}
+	tenants, err := tenantAPI.ListProjectTenants(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	desc := projectDescription{
+		Project: *project,
+		Usage:   projectUsageRecords(project, tenants),
+		Members: projectMemberViews(ctx, client, members),
+		Tenants: tenants,
+	}
+
+	// Structured formats get the whole description as a single document
+	if output.Format(outputFmt) != output.FormatTable {
+		return formatter.FormatData(desc)
+	}
+
+	description := ""
+	if project.Description != nil {
+		description = *project.Description
+	}
+	fmt.Printf("Name:          %s\n", project.Name)
+	fmt.Printf("ID:            %s\n", project.ID)
+	fmt.Printf("Organization:  %s\n", project.OrganizationID)
+	fmt.Printf("Description:   %s\n", description)
+	fmt.Printf("Created:       %s\n", project.CreatedAt.Format("2006-01-02 15:04:05"))
+	fmt.Printf("Updated:       %s\n", project.UpdatedAt.Format("2006-01-02 15:04:05"))
+
+	fmt.Println("\nQuota Usage:")
+	if err := formatter.FormatRows(desc.Usage, usageColumns); err != nil {
+		return err
+	}
+
+	fmt.Println("\nMembers:")
+	if err := formatter.FormatRows(projectMemberRecords(desc.Members), projectMemberColumns); err != nil {
+		return err
+	}
+
+	fmt.Println("\nTenants:")
+	return formatter.FormatData(tenants)
+}
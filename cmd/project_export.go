@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"spacectl/internal/api"
+	"spacectl/internal/manifest"
+	"spacectl/internal/models"
+	"spacectl/internal/names"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// projectExportCmd represents the project export command
+var projectExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a project as a manifest",
+	Long: `Export a project's settings, quotas, and members as a manifest that can be
+re-created elsewhere with 'spacectl project import'. Members are exported with
+their email address and user ID, so they can be found in another environment.
+Output is YAML unless -o json is given.
+
+Examples:
+  spacectl project export --project-name my-project > my-project.yaml
+  spacectl project export --project abc123 -o json`,
+	Args: cobra.NoArgs,
+	RunE: runProjectExport,
+}
+
+func init() {
+	projectCmd.AddCommand(projectExportCmd)
+}
+
+func runProjectExport(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+	projectAPI := api.NewProjectAPI(client)
+	orgAPI := api.NewOrganizationAPI(client)
+
+	// Resolve project
+	projectID, err := requireProjectID()
+	if err != nil {
+		return err
+	}
+
+	project, err := projectAPI.GetProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	members, err := projectAPI.ListProjectMembers(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to list project members: %w", err)
+	}
+
+	m := projectManifest(project, projectMemberViews(ctx, client, members))
+
+	// Record the organization by name so the manifest is portable across environments
+	if org, err := orgAPI.GetOrganization(ctx, project.OrganizationID); err == nil {
+		m.Metadata.Organization = org.Name
+	}
+
+	// Manifests are documents, so default to YAML instead of a table
+	if output.Format(outputFmt) == output.FormatJSON {
+		return formatter.FormatData(m)
+	}
+	return output.NewFormatter(output.FormatYAML, noHeaders, os.Stdout).FormatData(m)
+}
+
+// projectManifest converts a project and its members to a manifest
+func projectManifest(project *models.Project, members []projectMemberView) *manifest.Project {
+	m := manifest.NewProject(project.Name)
+	m.Spec.MaxTenants = project.MaxTenants
+	m.Spec.MaxCompute = project.MaxCompute
+	m.Spec.MaxMemoryGB = project.MaxMemoryGB
+	if project.Description != nil {
+		m.Spec.Description = *project.Description
+	}
+	for _, member := range members {
+		m.Spec.Members = append(m.Spec.Members, manifest.Member{Email: member.Email, UserID: member.UserID, Role: member.Role})
+	}
+	return m
+}
+
+// projectImportCmd represents the project import command
+var projectImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Create a project from a manifest",
+	Long: `Create a project from a manifest produced by 'spacectl project export'.
+
+The project is created in the organization named in the manifest unless --org
+or --org-name is given, falling back to your default organization. Members are
+added after the project is created, found by email when the manifest has one:
+emails without an account are sent a project invitation, and members without
+an email are added by user ID. Members that cannot be added are reported but
+do not fail the import.
+
+Examples:
+  spacectl project import -f my-project.yaml
+  spacectl project import -f my-project.yaml --org-name staging --name my-project-copy`,
+	Args: cobra.NoArgs,
+	RunE: runProjectImport,
+}
+
+var (
+	projectImportFile string
+	projectImportName string
+)
+
+func init() {
+	projectCmd.AddCommand(projectImportCmd)
+	projectImportCmd.Flags().StringVarP(&projectImportFile, "file", "f", "", "Manifest file to import (use - for stdin)")
+	projectImportCmd.Flags().StringVar(&projectImportName, "name", "", "Override the project name from the manifest")
+	projectImportCmd.MarkFlagRequired("file")
+	addManifestValueFlags(projectImportCmd)
+}
+
+func runProjectImport(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Read manifest
+	values, err := manifestValues()
+	if err != nil {
+		return err
+	}
+	resources, err := manifest.ReadFile(projectImportFile, values)
+	if err != nil {
+		return err
+	}
+	if len(resources) != 1 {
+		return fmt.Errorf("expected exactly one project manifest, found %d documents", len(resources))
+	}
+	m, ok := resources[0].(*manifest.Project)
+	if !ok {
+		return fmt.Errorf("manifest is not a project")
+	}
+	if projectImportName != "" {
+		m.Metadata.Name = projectImportName
+	}
+	if m.Metadata.Name == "" {
+		return fmt.Errorf("manifest does not specify a project name")
+	}
+	if err := names.ValidateProject(m.Metadata.Name); err != nil {
+		return err
+	}
+
+	// Create API client
+	client := apiClient()
+	projectAPI := api.NewProjectAPI(client)
+
+	// Determine target organization
+	orgID := contextOrgID()
+	if orgID == "" && m.Metadata.Organization != "" {
+		if orgID, err = resolveOrganizationID(ctx, client, m.Metadata.Organization, ""); err != nil {
+			return err
+		}
+	}
+	if orgID == "" {
+		if orgID, err = requireOrgID(ctx, client); err != nil {
+			return err
+		}
+	}
+
+	// Create project
+	req := models.CreateProjectRequest{
+		Name:        m.Metadata.Name,
+		MaxTenants:  m.Spec.MaxTenants,
+		MaxCompute:  m.Spec.MaxCompute,
+		MaxMemoryGB: m.Spec.MaxMemoryGB,
+	}
+	if m.Spec.Description != "" {
+		req.Description = &m.Spec.Description
+	}
+	project, err := projectAPI.CreateProject(ctx, orgID, req)
+	if err != nil {
+		return fmt.Errorf("failed to create project: %w", err)
+	}
+
+	// Add members, reporting failures without aborting
+	failed, invited := 0, 0
+	for _, member := range m.Spec.Members {
+		invitation, err := importMember(ctx, client, project.ID, member)
+		if err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "Warning: failed to add member %s (%s): %v\n", memberName(member), member.Role, err)
+			continue
+		}
+		if invitation {
+			invited++
+		}
+	}
+
+	if !quiet {
+		fmt.Printf("Successfully imported project %s (%s) with %d of %d members (%d invited)\n",
+			project.Name, project.ID, len(m.Spec.Members)-failed, len(m.Spec.Members), invited)
+	}
+
+	return nil
+}
+
+// importMember adds a manifest member to a project, like 'project members
+// sync': a member with an email is looked up by it and sent an invitation when
+// they have no account, and one without is added by user ID. It reports
+// whether an invitation was sent.
+func importMember(ctx context.Context, client *api.Client, projectID string, member manifest.Member) (bool, error) {
+	projectAPI := api.NewProjectAPI(client)
+	if member.Email == "" {
+		if member.UserID == "" {
+			return false, fmt.Errorf("either email or user_id is required")
+		}
+		return false, projectAPI.AddUserToProject(ctx, projectID, member.UserID, member.Role)
+	}
+
+	user, err := api.NewUserAPI(client).LookupUserByEmail(ctx, member.Email)
+	if err != nil {
+		return false, fmt.Errorf("failed to look up user: %w", err)
+	}
+	if user == nil {
+		return true, projectAPI.SendProjectInvitation(ctx, projectID, member.Email, member.Role)
+	}
+	return false, projectAPI.AddUserToProject(ctx, projectID, user.ID, member.Role)
+}
+
+// memberName names a manifest member by email, or by user ID when it has none
+func memberName(member manifest.Member) string {
+	if member.Email != "" {
+		return member.Email
+	}
+	return member.UserID
+}
@@ -0,0 +1,389 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"spacectl/internal/api"
+	"spacectl/internal/manifest"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// projectMembersSyncCmd represents the project members sync command
+var projectMembersSyncCmd = &cobra.Command{
+	Use:   "sync -f <file>",
+	Short: "Make a project's members match a file",
+	Long: `Make a project's members match the members listed in a YAML file: listed users
+who are not members are added, members whose role differs are given the listed
+role, and members who are not listed are removed. Users are listed by email or
+user ID; listed emails without an account are sent a project invitation, unless
+one is already pending.
+
+The changes are shown before they are made. Use --dry-run to only show them.
+Removing members asks for confirmation unless --force or --yes is given. Sync
+never removes you, or leaves a project that has an admin without one, unless
+--allow-self-removal is given.
+
+The file lists the members under "members":
+
+  members:
+    - email: alice@example.com
+      role: admin
+    - user_id: u-42
+      role: member
+
+Examples:
+  spacectl project members sync -f members.yaml --project-name my-project --dry-run
+  spacectl project members sync -f members.yaml --project-name my-project --yes`,
+	Args: cobra.NoArgs,
+	RunE: runProjectMembersSync,
+}
+
+var (
+	projectMembersSyncFile             string
+	projectMembersSyncDryRun           bool
+	projectMembersSyncForce            bool
+	projectMembersSyncAllowSelfRemoval bool
+)
+
+func init() {
+	projectMembersCmd.AddCommand(projectMembersSyncCmd)
+	projectMembersSyncCmd.Flags().StringVarP(&projectMembersSyncFile, "file", "f", "", "YAML file listing the members (use - for stdin)")
+	projectMembersSyncCmd.Flags().BoolVar(&projectMembersSyncDryRun, "dry-run", false, "Only show the changes that would be made")
+	projectMembersSyncCmd.Flags().BoolVar(&projectMembersSyncForce, "force", false, "Skip confirmation prompt for removals")
+	projectMembersSyncCmd.Flags().BoolVar(&projectMembersSyncAllowSelfRemoval, "allow-self-removal", false, "Allow removing yourself or the project's last admin")
+	projectMembersSyncCmd.MarkFlagRequired("file")
+}
+
+// declaredMember is a member listed in a members file
+type declaredMember struct {
+	Email  string `json:"email,omitempty" yaml:"email,omitempty"`
+	UserID string `json:"user_id,omitempty" yaml:"user_id,omitempty"`
+	Role   string `json:"role" yaml:"role"`
+}
+
+// membersFile is the file read by sync, and written for each project by export
+type membersFile struct {
+	Members []declaredMember `json:"members" yaml:"members"`
+}
+
+// memberSyncAction is what sync does for one member
+type memberSyncAction string
+
+const (
+	memberAdd    memberSyncAction = "add"
+	memberInvite memberSyncAction = "invite"
+	memberChange memberSyncAction = "change"
+	memberRemove memberSyncAction = "remove"
+)
+
+// memberSyncChange is a change to one member of a project
+type memberSyncChange struct {
+	Action  memberSyncAction `json:"action" yaml:"action"`
+	Member  string           `json:"member" yaml:"member"`
+	UserID  string           `json:"user_id,omitempty" yaml:"user_id,omitempty"`
+	OldRole string           `json:"old_role,omitempty" yaml:"old_role,omitempty"`
+	Role    string           `json:"role,omitempty" yaml:"role,omitempty"`
+}
+
+func runProjectMembersSync(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	declared, err := readMembersFile(projectMembersSyncFile)
+	if err != nil {
+		return err
+	}
+
+	// Create API client
+	client := apiClient()
+	// Resolve project
+	projectID, err := requireProjectID()
+	if err != nil {
+		return err
+	}
+	projectAPI := api.NewProjectAPI(client)
+
+	changes, err := planMemberSync(ctx, client, projectID, declared, projectMembersSyncAllowSelfRemoval)
+	if err != nil {
+		return err
+	}
+
+	// Show the changes
+	structured := output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML
+	switch {
+	case structured:
+		if err := formatter.FormatData(changes); err != nil {
+			return err
+		}
+	case !quiet || projectMembersSyncDryRun:
+		printMemberSync(changes)
+	}
+	if projectMembersSyncDryRun || len(changes) == 0 {
+		return nil
+	}
+
+	// Ask for confirmation before removing anyone, unless --force or --yes is used
+	removals := 0
+	for _, c := range changes {
+		if c.Action == memberRemove {
+			removals++
+		}
+	}
+	if removals > 0 {
+		question := fmt.Sprintf("This removes %d members from the project.", removals)
+		if ok, err := confirmTyped(question, "the number of members to remove", strconv.Itoa(removals), projectMembersSyncForce); err != nil || !ok {
+			return err
+		}
+	}
+
+	failed := 0
+	for _, c := range changes {
+		var err error
+		switch c.Action {
+		case memberAdd:
+			err = projectAPI.AddUserToProject(ctx, projectID, c.UserID, c.Role)
+		case memberInvite:
+			err = projectAPI.SendProjectInvitation(ctx, projectID, c.Member, c.Role)
+		case memberChange:
+			err = projectAPI.ChangeProjectUserRole(ctx, projectID, c.UserID, c.Role)
+		case memberRemove:
+			err = projectAPI.RemoveUserFromProject(ctx, projectID, c.UserID)
+		}
+		if err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "Error: failed to %s member %s: %v\n", c.Action, c.Member, err)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to make %d of %d membership changes", failed, len(changes))
+	}
+	if !quiet && !structured {
+		fmt.Printf("Successfully made %d membership changes\n", len(changes))
+	}
+	return nil
+}
+
+// readMembersFile reads and checks the members listed in a members file
+func readMembersFile(path string) ([]declaredMember, error) {
+	data, err := manifest.ReadData(path)
+	if err != nil {
+		return nil, err
+	}
+	var file membersFile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	seen := make(map[string]bool)
+	for i, m := range file.Members {
+		key := m.UserID
+		switch {
+		case m.Email != "" && m.UserID != "":
+			return nil, fmt.Errorf("members[%d]: only one of email or user_id is allowed", i)
+		case m.Email == "" && m.UserID == "":
+			return nil, fmt.Errorf("members[%d]: either email or user_id is required", i)
+		case m.Role == "":
+			return nil, fmt.Errorf("members[%d]: role is required", i)
+		case m.Email != "":
+			key = strings.ToLower(m.Email)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("members[%d]: %s%s is listed more than once", i, m.Email, m.UserID)
+		}
+		seen[key] = true
+	}
+	return file.Members, nil
+}
+
+// planMemberSync compares the declared members with the project's members and
+// returns the changes that make them match: additions and invitations in file
+// order, then role changes, then removals. Unless allowSelfRemoval is set, it
+// fails when the changes would remove the caller or the last admin (see
+// checkMemberRemovals).
+func planMemberSync(ctx context.Context, client *api.Client, projectID string, declared []declaredMember, allowSelfRemoval bool) ([]memberSyncChange, error) {
+	projectAPI := api.NewProjectAPI(client)
+	members, err := projectAPI.ListProjectMembers(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list project members: %w", err)
+	}
+	views := projectMemberViews(ctx, client, members)
+
+	byID := make(map[string]projectMemberView, len(views))
+	byEmail := make(map[string]projectMemberView, len(views))
+	for _, v := range views {
+		byID[v.UserID] = v
+		if v.Email != "" {
+			byEmail[strings.ToLower(v.Email)] = v
+		}
+	}
+
+	var pending map[string]bool
+	var additions, roleChanges, removals []memberSyncChange
+	keep := make(map[string]bool)
+	for _, d := range declared {
+		member, ok := byID[d.UserID]
+		if d.Email != "" {
+			member, ok = byEmail[strings.ToLower(d.Email)]
+		}
+		name := d.Email + d.UserID
+
+		if ok {
+			keep[member.UserID] = true
+			if member.Role != d.Role {
+				roleChanges = append(roleChanges, memberSyncChange{Action: memberChange, Member: name, UserID: member.UserID, OldRole: member.Role, Role: d.Role})
+			}
+			continue
+		}
+		if d.UserID != "" {
+			additions = append(additions, memberSyncChange{Action: memberAdd, Member: name, UserID: d.UserID, Role: d.Role})
+			continue
+		}
+
+		// Look up the account of a new member, or invite them if they have none
+		user, err := api.NewUserAPI(client).LookupUserByEmail(ctx, d.Email)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up user %s: %w", d.Email, err)
+		}
+		if user != nil {
+			if member, ok := byID[user.ID]; ok {
+				// A member whose email could not be looked up before
+				keep[user.ID] = true
+				if member.Role != d.Role {
+					roleChanges = append(roleChanges, memberSyncChange{Action: memberChange, Member: name, UserID: user.ID, OldRole: member.Role, Role: d.Role})
+				}
+				continue
+			}
+			additions = append(additions, memberSyncChange{Action: memberAdd, Member: name, UserID: user.ID, Role: d.Role})
+			continue
+		}
+		if pending == nil {
+			if pending, err = pendingInvitations(ctx, projectAPI, projectID); err != nil {
+				return nil, err
+			}
+		}
+		if !pending[strings.ToLower(d.Email)] {
+			additions = append(additions, memberSyncChange{Action: memberInvite, Member: name, Role: d.Role})
+		}
+	}
+
+	for _, v := range views {
+		if keep[v.UserID] {
+			continue
+		}
+		name := v.Email
+		if name == "" {
+			name = v.UserID
+		}
+		removals = append(removals, memberSyncChange{Action: memberRemove, Member: name, UserID: v.UserID, OldRole: v.Role})
+	}
+
+	changes := append(append(additions, roleChanges...), removals...)
+	if !allowSelfRemoval {
+		if err := checkMemberRemovals(ctx, client, views, changes); err != nil {
+			return nil, err
+		}
+	}
+	return changes, nil
+}
+
+// projectAdminRole is the project role that can manage the project's members
+const projectAdminRole = "admin"
+
+// checkMemberRemovals fails when the changes would leave a project that has an
+// admin without one, or remove the caller, who could not undo it. Invited admins
+// do not count, as they are not members until they accept.
+func checkMemberRemovals(ctx context.Context, client *api.Client, members []projectMemberView, changes []memberSyncChange) error {
+	admins := 0
+	for _, m := range members {
+		if m.Role == projectAdminRole {
+			admins++
+		}
+	}
+	remaining := admins
+	var removals []memberSyncChange
+	for _, c := range changes {
+		if c.OldRole == projectAdminRole && (c.Action == memberChange || c.Action == memberRemove) {
+			remaining--
+		}
+		if c.Role == projectAdminRole && (c.Action == memberAdd || c.Action == memberChange) {
+			remaining++
+		}
+		if c.Action == memberRemove {
+			removals = append(removals, c)
+		}
+	}
+	if admins > 0 && remaining == 0 {
+		return fmt.Errorf("the changes would leave the project without an admin; list an admin in the file, or pass --allow-self-removal")
+	}
+	if len(removals) == 0 {
+		return nil
+	}
+
+	user, err := api.NewAuthAPI(client).GetUserInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get user info: %w", err)
+	}
+	for _, c := range removals {
+		if c.UserID == user.ID {
+			return fmt.Errorf("the changes would remove you (%s) from the project; list yourself in the file, or pass --allow-self-removal", c.Member)
+		}
+	}
+	return nil
+}
+
+// pendingInvitations returns the lowercased emails with a pending invitation to a project
+func pendingInvitations(ctx context.Context, projectAPI *api.ProjectAPI, projectID string) (map[string]bool, error) {
+	invitations, err := projectAPI.ListProjectInvitations(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list project invitations: %w", err)
+	}
+	pending := make(map[string]bool, len(invitations))
+	for _, inv := range invitations {
+		if strings.EqualFold(inv.Status, "pending") {
+			pending[strings.ToLower(inv.InviteeEmail)] = true
+		}
+	}
+	return pending, nil
+}
+
+// printMemberSync shows the membership changes in the style of 'apply plan'
+func printMemberSync(changes []memberSyncChange) {
+	if len(changes) == 0 {
+		fmt.Println("No changes. The project's members match the file.")
+		return
+	}
+
+	fmt.Println("spacectl will make the following membership changes:")
+	fmt.Println()
+	width := 0
+	for _, c := range changes {
+		width = max(width, len(c.Member))
+	}
+	for _, c := range changes {
+		switch c.Action {
+		case memberAdd:
+			fmt.Printf("  + %-*s  %s\n", width, c.Member, c.Role)
+		case memberInvite:
+			fmt.Printf("  + %-*s  %s (invitation, no account yet)\n", width, c.Member, c.Role)
+		case memberChange:
+			fmt.Printf("  ~ %-*s  %s -> %s\n", width, c.Member, c.OldRole, c.Role)
+		case memberRemove:
+			fmt.Printf("  - %-*s  %s\n", width, c.Member, c.OldRole)
+		}
+	}
+	fmt.Println()
+}
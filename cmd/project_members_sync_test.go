@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"spacectl/internal/config"
+	"spacectl/internal/models"
+)
+
+// memberSyncRoutes is a project with admin ann, who is logged in, viewer bob,
+// member user-3, whose email cannot be looked up, and viewer dave
+func memberSyncRoutes() map[string]fakeResponse {
+	return map[string]fakeResponse{
+		"GET /api/v1/version": notFound,
+		"GET /api/v1/projects/proj-1/users": {Body: []models.ProjectMember{
+			{UserID: "user-1", Role: "admin"},
+			{UserID: "user-2", Role: "viewer"},
+			{UserID: "user-3", Role: "member"},
+			{UserID: "user-4", Role: "viewer"},
+		}},
+		"GET /api/v1/users?ids=user-1%2Cuser-2%2Cuser-3%2Cuser-4": {Body: []models.User{
+			{ID: "user-1", Email: "ann@example.com"},
+			{ID: "user-2", Email: "bob@example.com"},
+			{ID: "user-4", Email: "dave@example.com"},
+		}},
+		"GET /api/v1/projects/proj-1/invitations":              {Body: []models.ProjectInvitation{{InviteeEmail: "pending@example.com", Status: "pending"}}},
+		"GET /api/v1/users/lookup?email=carol%40example.com":   {Body: models.User{ID: "user-5", Email: "carol@example.com"}},
+		"GET /api/v1/users/lookup?email=erin%40example.com":    {Body: models.User{ID: "user-3", Email: "erin@example.com"}},
+		"GET /api/v1/users/lookup?email=new%40example.com":     notFound,
+		"GET /api/v1/users/lookup?email=pending%40example.com": notFound,
+		"GET /api/v1/user/info":                                {Body: models.User{ID: "user-1", Email: "ann@example.com"}},
+	}
+}
+
+func TestPlanMemberSync(t *testing.T) {
+	ann := declaredMember{Email: "ann@example.com", Role: "admin"}
+	bob := declaredMember{Email: "bob@example.com", Role: "viewer"}
+	user3 := declaredMember{UserID: "user-3", Role: "member"}
+	dave := declaredMember{Email: "dave@example.com", Role: "viewer"}
+
+	tests := []struct {
+		name             string
+		declared         []declaredMember
+		allowSelfRemoval bool
+		want             []memberSyncChange
+		wantErr          string
+	}{
+		{
+			name:     "unchanged",
+			declared: []declaredMember{ann, bob, user3, dave},
+		},
+		{
+			name: "add, invite, re-role, and remove",
+			declared: []declaredMember{
+				ann,
+				{Email: "Bob@Example.com", Role: "editor"},
+				{Email: "carol@example.com", Role: "viewer"},
+				{Email: "new@example.com", Role: "viewer"},
+				// Already invited, so not invited again
+				{Email: "pending@example.com", Role: "viewer"},
+				{UserID: "user-6", Role: "member"},
+				user3,
+			},
+			want: []memberSyncChange{
+				{Action: memberAdd, Member: "carol@example.com", UserID: "user-5", Role: "viewer"},
+				{Action: memberInvite, Member: "new@example.com", Role: "viewer"},
+				{Action: memberAdd, Member: "user-6", UserID: "user-6", Role: "member"},
+				{Action: memberChange, Member: "Bob@Example.com", UserID: "user-2", OldRole: "viewer", Role: "editor"},
+				{Action: memberRemove, Member: "dave@example.com", UserID: "user-4", OldRole: "viewer"},
+			},
+		},
+		{
+			// user-3 is found by the email lookup, though the member list
+			// has no email for them
+			name:     "member without a known email",
+			declared: []declaredMember{ann, bob, {Email: "erin@example.com", Role: "viewer"}, dave},
+			want: []memberSyncChange{
+				{Action: memberChange, Member: "erin@example.com", UserID: "user-3", OldRole: "member", Role: "viewer"},
+			},
+		},
+		{
+			name:     "removing yourself",
+			declared: []declaredMember{bob, {UserID: "user-3", Role: "admin"}, dave},
+			wantErr:  "would remove you (ann@example.com)",
+		},
+		{
+			name:             "removing yourself when allowed",
+			declared:         []declaredMember{bob, {UserID: "user-3", Role: "admin"}, dave},
+			allowSelfRemoval: true,
+			want: []memberSyncChange{
+				{Action: memberChange, Member: "user-3", UserID: "user-3", OldRole: "member", Role: "admin"},
+				{Action: memberRemove, Member: "ann@example.com", UserID: "user-1", OldRole: "admin"},
+			},
+		},
+		{
+			name:     "demoting the last admin",
+			declared: []declaredMember{{Email: "ann@example.com", Role: "viewer"}, bob, user3, dave},
+			wantErr:  "without an admin",
+		},
+		{
+			// Invited admins are not members until they accept
+			name:     "replacing the last admin with an invitation",
+			declared: []declaredMember{{Email: "new@example.com", Role: "admin"}, bob, user3, dave},
+			wantErr:  "without an admin",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, _ := newFakeAPI(t, config.Config{}, memberSyncRoutes())
+
+			got, err := planMemberSync(context.Background(), client, "proj-1", tc.declared, tc.allowSelfRemoval)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) == 0 && len(tc.want) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("unexpected changes\nwant: %+v\ngot:  %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestReadMembersFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		want    []declaredMember
+		wantErr string
+	}{
+		{
+			name: "email and user ID",
+			yaml: `members:
+  - email: ann@example.com
+    role: admin
+  - user_id: u-42
+    role: member
+`,
+			want: []declaredMember{
+				{Email: "ann@example.com", Role: "admin"},
+				{UserID: "u-42", Role: "member"},
+			},
+		},
+		{
+			name:    "both email and user ID",
+			yaml:    "members:\n  - {email: ann@example.com, user_id: u-1, role: admin}\n",
+			wantErr: "members[0]: only one of email or user_id is allowed",
+		},
+		{
+			name:    "neither email nor user ID",
+			yaml:    "members:\n  - {role: admin}\n",
+			wantErr: "members[0]: either email or user_id is required",
+		},
+		{
+			name:    "missing role",
+			yaml:    "members:\n  - {email: ann@example.com}\n",
+			wantErr: "members[0]: role is required",
+		},
+		{
+			name:    "duplicate email",
+			yaml:    "members:\n  - {email: ann@example.com, role: admin}\n  - {email: Ann@Example.com, role: viewer}\n",
+			wantErr: "members[1]: Ann@Example.com is listed more than once",
+		},
+		{
+			name:    "unknown field",
+			yaml:    "members:\n  - {email: ann@example.com, role: admin, joined: 2025-01-01}\n",
+			wantErr: "field joined not found",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "members.yaml")
+			if err := os.WriteFile(path, []byte(tc.yaml), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := readMembersFile(path)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected an error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("unexpected members\nwant: %+v\ngot:  %+v", tc.want, got)
+			}
+		})
+	}
+}
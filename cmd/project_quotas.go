@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+
+	"github.com/spf13/cobra"
+)
+
+// projectQuotasCmd represents the project quotas command
+var projectQuotasCmd = &cobra.Command{
+	Use:   "quotas",
+	Short: "Manage project quotas",
+	Long:  `Manage project quota limits such as the maximum number of tenants, compute, and memory.`,
+}
+
+func init() {
+	projectCmd.AddCommand(projectQuotasCmd)
+}
+
+// projectQuotasSetCmd represents the project quotas set command
+var projectQuotasSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Update project quotas",
+	Long: `Update a project's quota limits. Only the limits passed as flags are sent;
+all other limits are left unchanged.
+
+Examples:
+  spacectl project quotas set --project-name my-project --max-tenants 5
+  spacectl project quotas set --project abc123 --max-compute 64 --max-memory 256`,
+	Args: cobra.NoArgs,
+	RunE: runProjectQuotasSet,
+}
+
+var (
+	projectQuotasSetMaxTenants int
+	projectQuotasSetMaxCompute int
+	projectQuotasSetMaxMemory  int
+)
+
+func init() {
+	projectQuotasCmd.AddCommand(projectQuotasSetCmd)
+	projectQuotasSetCmd.Flags().IntVar(&projectQuotasSetMaxTenants, "max-tenants", 0, "New maximum number of tenants")
+	projectQuotasSetCmd.Flags().IntVar(&projectQuotasSetMaxCompute, "max-compute", 0, "New maximum compute quota")
+	projectQuotasSetCmd.Flags().IntVar(&projectQuotasSetMaxMemory, "max-memory", 0, "New maximum memory quota (GB)")
+}
+
+func runProjectQuotasSet(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Build request from the flags that were explicitly set
+	var req models.UpdateProjectQuotasRequest
+	if cmd.Flags().Changed("max-tenants") {
+		req.MaxTenants = &projectQuotasSetMaxTenants
+	}
+	if cmd.Flags().Changed("max-compute") {
+		req.MaxCompute = &projectQuotasSetMaxCompute
+	}
+	if cmd.Flags().Changed("max-memory") {
+		req.MaxMemoryGB = &projectQuotasSetMaxMemory
+	}
+	if req.MaxTenants == nil && req.MaxCompute == nil && req.MaxMemoryGB == nil {
+		return fmt.Errorf("at least one of --max-tenants, --max-compute, or --max-memory must be provided")
+	}
+
+	// Create API client
+	client := apiClient()
+	projectAPI := api.NewProjectAPI(client)
+
+	// Resolve project
+	projectID, err := requireProjectID()
+	if err != nil {
+		return err
+	}
+
+	// Get current project to report the previous limits
+	before, err := projectAPI.GetProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	// Update quotas
+	after, err := projectAPI.UpdateProjectQuotas(ctx, projectID, req)
+	if err != nil {
+		return fmt.Errorf("failed to update project quotas: %w", err)
+	}
+
+	// Output before/after values
+	changes := []map[string]interface{}{
+		{"field": "max_tenants", "before": before.MaxTenants, "after": after.MaxTenants},
+		{"field": "max_compute", "before": before.MaxCompute, "after": after.MaxCompute},
+		{"field": "max_memory_gb", "before": before.MaxMemoryGB, "after": after.MaxMemoryGB},
+	}
+	return formatter.FormatRows(changes, []string{"field", "before", "after"})
+}
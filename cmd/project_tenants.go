@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+
+	"spacectl/internal/api"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// projectTenantsCmd represents the project tenants command
+var projectTenantsCmd = &cobra.Command{
+	Use:   "tenants",
+	Short: "List tenants in a project",
+	Long: `List the tenants in a project with project-centric columns (namespace,
+status, and age). This is equivalent to 'spacectl tenant list' filtered to
+a single project.
+
+Examples:
+  spacectl project tenants --project-name my-project
+  spacectl project tenants --project-name my-project --filter status=Ready`,
+	Args: cobra.NoArgs,
+	RunE: runProjectTenants,
+}
+
+func init() {
+	projectCmd.AddCommand(projectTenantsCmd)
+	addListFlags(projectTenantsCmd)
+}
+
+func runProjectTenants(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+	tenantAPI := api.NewTenantAPI(client)
+
+	// Resolve project
+	projectID, err := requireProjectID()
+	if err != nil {
+		return err
+	}
+
+	tenants, err := tenantAPI.ListProjectTenants(listContext(ctx), projectID)
+	if err != nil {
+		return fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	// Structured formats get the full tenant objects
+	if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML {
+		return formatter.FormatData(tenants)
+	}
+
+	var rows []map[string]interface{}
+	for _, t := range tenants {
+		rows = append(rows, map[string]interface{}{
+			"name":      t.Name,
+			"namespace": t.Namespace,
+			"status":    t.Status,
+			"age":       output.Age(t.CreatedAt),
+		})
+	}
+	return formatter.FormatRows(rows, []string{"name", "namespace", "status", "age"})
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+
+	"github.com/spf13/cobra"
+)
+
+// projectUsageCmd represents the project usage command
+var projectUsageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Show project quota utilization",
+	Long: `Show how much of a project's quota is allocated to tenants, including the
+tenant count, total compute and memory, and the percentage of each limit used.
+
+Examples:
+  spacectl project usage --project-name my-project
+  spacectl project usage --project-name my-project --watch --interval 10s`,
+	Args: cobra.NoArgs,
+	RunE: runProjectUsage,
+}
+
+var (
+	projectUsageWatch    bool
+	projectUsageInterval time.Duration
+)
+
+func init() {
+	projectCmd.AddCommand(projectUsageCmd)
+	projectUsageCmd.Flags().BoolVarP(&projectUsageWatch, "watch", "w", false, "Refresh the summary periodically")
+	projectUsageCmd.Flags().DurationVar(&projectUsageInterval, "interval", 5*time.Second, "Refresh interval when using --watch")
+}
+
+func runProjectUsage(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+
+	// Resolve project
+	projectID, err := requireProjectID()
+	if err != nil {
+		return err
+	}
+
+	if !projectUsageWatch {
+		return printProjectUsage(ctx, client, projectID)
+	}
+
+	return watch(ctx, cmd.CommandPath(), projectUsageInterval, func() error {
+		return printProjectUsage(ctx, client, projectID)
+	})
+}
+
+// printProjectUsage fetches the project and its tenants and prints the utilization summary
+func printProjectUsage(ctx context.Context, client *api.Client, projectID string) error {
+	projectAPI := api.NewProjectAPI(client)
+	tenantAPI := api.NewTenantAPI(client)
+
+	project, err := projectAPI.GetProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	tenants, err := tenantAPI.ListProjectTenants(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to list tenants: %w", err)
+	}
+
+	return formatter.FormatRows(projectUsageRecords(project, tenants), usageColumns)
+}
+
+// projectUsageRecords aggregates tenant allocations against the project's limits
+func projectUsageRecords(project *models.Project, tenants []models.Tenant) []map[string]interface{} {
+	var compute, memory int
+	for _, t := range tenants {
+		compute += t.ComputeQuota
+		memory += t.MemoryQuotaGB
+	}
+
+	return []map[string]interface{}{
+		usageRecord("tenants", len(tenants), project.MaxTenants),
+		usageRecord("compute", compute, project.MaxCompute),
+		usageRecord("memory_gb", memory, project.MaxMemoryGB),
+	}
+}
+
+// usageColumns are the columns of usageRecord rows in display order
+var usageColumns = []string{"resource", "used", "limit", "percent"}
+
+// usageRecord builds a single utilization row. A limit of zero is treated as unlimited.
+func usageRecord(resource string, used, limit int) map[string]interface{} {
+	percent := "n/a"
+	if limit > 0 {
+		percent = fmt.Sprintf("%.0f%%", float64(used)*100/float64(limit))
+	}
+	return map[string]interface{}{
+		"resource": resource,
+		"used":     used,
+		"limit":    limit,
+		"percent":  percent,
+	}
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// stdinReader is shared by all prompts so buffered input is never lost between them
+var stdinReader = bufio.NewReader(os.Stdin)
+
+// promptString asks for a line of input, returning def when the answer is empty.
+// Prompts are written to stderr, like everything else in this file, so they never
+// mix with JSON or YAML output on stdout.
+func promptString(label, def string) (string, error) {
+	if err := promptUnavailable(strings.ToLower(label), "pass the value on the command line instead"); err != nil {
+		return "", err
+	}
+	if def != "" {
+		fmt.Fprintf(os.Stderr, "%s [%s]: ", label, def)
+	} else {
+		fmt.Fprintf(os.Stderr, "%s: ", label)
+	}
+
+	line, err := stdinReader.ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("failed to read input: %w", err)
+	}
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return def, nil
+	}
+	return line, nil
+}
+
+// promptInt asks for a non-negative integer, re-prompting until the answer is valid
+func promptInt(label string, def int) (int, error) {
+	for {
+		answer, err := promptString(label, strconv.Itoa(def))
+		if err != nil {
+			return 0, err
+		}
+		n, err := strconv.Atoi(answer)
+		if err != nil || n < 0 {
+			fmt.Fprintf(os.Stderr, "Please enter a whole number of 0 or more.\n")
+			continue
+		}
+		return n, nil
+	}
+}
+
+// promptSelect shows a numbered list of options and returns the chosen index
+func promptSelect(label string, options []string, def int) (int, error) {
+	if len(options) == 0 {
+		return 0, fmt.Errorf("no options available for %s", strings.ToLower(label))
+	}
+	if err := promptUnavailable(strings.ToLower(label), "pass the value on the command line instead"); err != nil {
+		return 0, err
+	}
+
+	fmt.Fprintf(os.Stderr, "%s:\n", label)
+	for i, opt := range options {
+		fmt.Fprintf(os.Stderr, "  %d) %s\n", i+1, opt)
+	}
+
+	for {
+		answer, err := promptString("Select", strconv.Itoa(def+1))
+		if err != nil {
+			return 0, err
+		}
+		n, err := strconv.Atoi(answer)
+		if err != nil || n < 1 || n > len(options) {
+			fmt.Fprintf(os.Stderr, "Please enter a number between 1 and %d.\n", len(options))
+			continue
+		}
+		return n - 1, nil
+	}
+}
+
+// stdinIsTerminal reports whether prompts can be answered interactively
+var stdinIsTerminal = func() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// checkInteractive returns why the user cannot be prompted, or nil if they can.
+// Prompts are disabled with --non-interactive and when stdin is not a terminal,
+// e.g. in CI jobs, so commands fail fast instead of waiting for input.
+func checkInteractive() error {
+	switch {
+	case nonInteractive:
+		return errors.New("running with --non-interactive")
+	case !stdinIsTerminal():
+		return errors.New("stdin is not a terminal")
+	}
+	return nil
+}
+
+// promptUnavailable returns an error for a prompt for what that cannot be shown,
+// with a hint on how to provide the answer instead, or nil if prompting is possible
+func promptUnavailable(what, hint string) error {
+	if err := checkInteractive(); err != nil {
+		return fmt.Errorf("cannot prompt for %s: %v; %s", what, err, hint)
+	}
+	return nil
+}
+
+// confirmTyped describes a destructive action and asks the user to type want,
+// such as the name of the resource, to go ahead. It returns true without asking
+// when force or the global --yes flag is set, and fails when prompts are not
+// possible (see checkInteractive).
+func confirmTyped(question, what, want string, force bool) (bool, error) {
+	if force || assumeYes {
+		return true, nil
+	}
+	if err := promptUnavailable("confirmation", "pass --yes to confirm"); err != nil {
+		return false, err
+	}
+
+	fmt.Fprintln(os.Stderr, question)
+	answer, err := promptString(fmt.Sprintf("Type %s to confirm", what), "")
+	if err != nil {
+		return false, err
+	}
+	if answer != want {
+		fmt.Fprintln(os.Stderr, "Cancelled.")
+		return false, nil
+	}
+	return true, nil
+}
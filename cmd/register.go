@@ -35,8 +35,13 @@ func init() {
 }
 
 func runRegister(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Get email if not provided
 	if registerEmail == "" {
+		if err := promptUnavailable("an email address", "pass --email"); err != nil {
+			return err
+		}
 		fmt.Print("Email: ")
 		reader := bufio.NewReader(os.Stdin)
 		email, err := reader.ReadString('\n')
@@ -48,6 +53,9 @@ func runRegister(cmd *cobra.Command, args []string) error {
 
 	// Get password if not provided
 	if registerPassword == "" {
+		if err := promptUnavailable("a password", "pass --password"); err != nil {
+			return err
+		}
 		fmt.Print("Password: ")
 		passwordBytes, err := term.ReadPassword(int(syscall.Stdin))
 		if err != nil {
@@ -58,18 +66,18 @@ func runRegister(cmd *cobra.Command, args []string) error {
 	}
 
 	// Create API client
-    client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	authAPI := api.NewAuthAPI(client)
 
 	// Attempt registration
-	err := authAPI.Register(registerEmail, registerPassword)
+	err := authAPI.Register(ctx, registerEmail, registerPassword)
 	if err != nil {
 		return fmt.Errorf("registration failed: %w", err)
 	}
 
 	// Output success message
 	if !quiet {
-		fmt.Printf("Successfully registered %s. Please check your email for verification instructions.\n", registerEmail)
+		fmt.Printf("Successfully registered %s. Please check your email for the verification code and run 'spacectl auth verify'.\n", registerEmail)
 	}
 
 	return nil
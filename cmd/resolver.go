@@ -1,25 +1,53 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"spacectl/internal/api"
+	"spacectl/internal/models"
+	"spacectl/internal/suggest"
 )
 
 // resolveOrganizationID resolves an organization identifier from either name or id.
 // If both are empty, returns an error. If both are provided, returns an error.
-func resolveOrganizationID(client *api.Client, name, id string) (string, error) {
+// An id that is not a complete UUID is matched as a prefix of the user's organizations.
+func resolveOrganizationID(ctx context.Context, client *api.Client, name, id string) (string, error) {
 	if name == "" && id == "" {
 		return "", fmt.Errorf("either --name or --id must be provided")
 	}
 	if name != "" && id != "" {
 		return "", fmt.Errorf("only one of --name or --id is allowed")
 	}
+	orgAPI := api.NewOrganizationAPI(client)
 	if id != "" {
-		return id, nil
+		if isFullID(id) {
+			return id, nil
+		}
+		memberships, err := orgAPI.ListUserOrganizations(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to list organizations: %w", err)
+		}
+		ids := make([]string, 0, len(memberships))
+		for _, m := range memberships {
+			ids = append(ids, m.Organization.ID)
+		}
+		return matchIDPrefix("organization", id, ids)
+	}
+	org, err := orgAPI.GetOrganizationByName(ctx, name)
+	if errors.Is(err, api.ErrNotFound) {
+		// Suggest similar names from the user's organizations, if they can be listed
+		var names []string
+		if memberships, listErr := orgAPI.ListUserOrganizations(ctx); listErr == nil {
+			for _, m := range memberships {
+				names = append(names, m.Organization.Name)
+			}
+		}
+		return "", api.NotFoundError("organization named %q not found%s", name, didYouMean(name, names))
 	}
-	orgAPI := api.NewOrganizationAPI(client)
-	org, err := orgAPI.GetOrganizationByName(name)
 	if err != nil {
 		return "", fmt.Errorf("failed to resolve organization by name: %w", err)
 	}
@@ -28,65 +56,195 @@ func resolveOrganizationID(client *api.Client, name, id string) (string, error)
 
 // resolveProjectID resolves a project ID from name or id, optionally within an organization.
 // If orgID is provided, the search is scoped; otherwise falls back to the user's projects.
-func resolveProjectID(client *api.Client, projectName, projectID, orgID string) (string, error) {
+// A name may be qualified with its organization, as in "acme/web", which scopes the
+// search to that organization. An unqualified name found in several organizations is
+// ambiguous (see pickAmbiguousProject). An id that is not a complete UUID is matched as
+// a prefix of the projects searched.
+func resolveProjectID(ctx context.Context, client *api.Client, projectName, projectID, orgID string) (string, error) {
 	if projectName == "" && projectID == "" {
 		return "", fmt.Errorf("either --name or --id must be provided for project")
 	}
 	if projectName != "" && projectID != "" {
 		return "", fmt.Errorf("only one of --name or --id is allowed for project")
 	}
-	if projectID != "" {
+	if projectID != "" && isFullID(projectID) {
 		return projectID, nil
 	}
+	if orgName, name, ok := strings.Cut(projectName, "/"); ok {
+		if orgName == "" || name == "" {
+			return "", fmt.Errorf("invalid project name %q: use <project> or <organization>/<project>", projectName)
+		}
+		qualifiedOrgID, err := resolveOrganizationID(ctx, client, orgName, "")
+		if err != nil {
+			return "", err
+		}
+		if orgID != "" && orgID != qualifiedOrgID {
+			return "", fmt.Errorf("project %q is qualified with organization %q, but another organization is selected", projectName, orgName)
+		}
+		projectName, orgID = name, qualifiedOrgID
+	}
 	projectAPI := api.NewProjectAPI(client)
 	if orgID != "" {
-		projects, err := projectAPI.ListOrganizationProjects(orgID)
+		projects, err := projectAPI.ListOrganizationProjects(ctx, orgID)
 		if err != nil {
 			return "", fmt.Errorf("failed to list projects in organization: %w", err)
 		}
+		ids := make([]string, 0, len(projects))
+		names := make([]string, 0, len(projects))
 		for _, p := range projects {
-			if p.Name == projectName {
+			if projectName != "" && p.Name == projectName {
 				return p.ID, nil
 			}
+			ids = append(ids, p.ID)
+			names = append(names, p.Name)
+		}
+		if projectID != "" {
+			return matchIDPrefix("project", projectID, ids)
 		}
-		return "", fmt.Errorf("project named %q not found in organization", projectName)
+		return "", api.NotFoundError("project named %q not found in organization%s", projectName, didYouMean(projectName, names))
 	}
 	// Fallback: search user's projects
-	memberships, err := projectAPI.ListUserProjects()
+	memberships, err := projectAPI.ListUserProjects(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to list user projects: %w", err)
 	}
+	ids := make([]string, 0, len(memberships))
+	names := make([]string, 0, len(memberships))
+	var matches []models.ProjectMembership
 	for _, m := range memberships {
-		if m.Project.Name == projectName {
-			return m.Project.ID, nil
+		if projectName != "" && m.Project.Name == projectName {
+			matches = append(matches, m)
+		}
+		ids = append(ids, m.Project.ID)
+		names = append(names, m.Project.Name)
+	}
+	switch {
+	case len(matches) == 1:
+		return matches[0].Project.ID, nil
+	case len(matches) > 1:
+		return pickAmbiguousProject(ctx, client, projectName, matches)
+	case projectID != "":
+		return matchIDPrefix("project", projectID, ids)
+	}
+	return "", api.NotFoundError("project named %q not found%s", projectName, didYouMean(projectName, names))
+}
+
+// pickAmbiguousProject chooses between projects with the same name in different
+// organizations. The user picks one when they can be prompted; otherwise it fails
+// with the org-qualified names of the candidates.
+func pickAmbiguousProject(ctx context.Context, client *api.Client, name string, matches []models.ProjectMembership) (string, error) {
+	// Name the organizations when they can be listed, or else fall back to their IDs
+	orgNames := map[string]string{}
+	if orgs, err := api.NewOrganizationAPI(client).ListUserOrganizations(ctx); err == nil {
+		for _, m := range orgs {
+			orgNames[m.Organization.ID] = m.Organization.Name
 		}
 	}
-	return "", fmt.Errorf("project named %q not found", projectName)
+	candidates := make([]string, len(matches))
+	for i, m := range matches {
+		org, ok := orgNames[m.Project.OrganizationID]
+		if !ok {
+			org = m.Project.OrganizationID
+		}
+		candidates[i] = org + "/" + m.Project.Name
+	}
+
+	if checkInteractive() != nil {
+		return "", fmt.Errorf("project name %q is ambiguous: it exists in %s; pass an org-qualified name such as %q, or select the organization with --org-name",
+			name, strings.Join(candidates, ", "), candidates[0])
+	}
+	idx, err := promptSelect(fmt.Sprintf("Project %q exists in several organizations", name), candidates, 0)
+	if err != nil {
+		return "", err
+	}
+	return matches[idx].Project.ID, nil
 }
 
 // resolveTenantID resolves a tenant ID from name or id within a project.
-func resolveTenantID(client *api.Client, tenantName, tenantID, projectID string) (string, error) {
+// If projectID is empty, the configured default project is used. An id that is
+// not a complete UUID is matched as a prefix of the project's tenants.
+func resolveTenantID(ctx context.Context, client *api.Client, tenantName, tenantID, projectID string) (string, error) {
 	if tenantName == "" && tenantID == "" {
 		return "", fmt.Errorf("either --name or --id must be provided for tenant")
 	}
 	if tenantName != "" && tenantID != "" {
 		return "", fmt.Errorf("only one of --name or --id is allowed for tenant")
 	}
-	if tenantID != "" {
+	if tenantID != "" && isFullID(tenantID) {
 		return tenantID, nil
 	}
 	if projectID == "" {
-		return "", fmt.Errorf("project is required to resolve tenant by name")
+		projectID = cfg.DefaultProject
+	}
+	if projectID == "" {
+		if tenantID != "" {
+			return "", fmt.Errorf("project is required to resolve a tenant ID prefix (pass the full ID, pass --project, or run 'spacectl project set-default')")
+		}
+		return "", fmt.Errorf("project is required to resolve tenant by name (pass --project or run 'spacectl project set-default')")
 	}
 	tenantAPI := api.NewTenantAPI(client)
-	tenants, err := tenantAPI.ListProjectTenants(projectID)
+	tenants, err := tenantAPI.ListProjectTenants(ctx, projectID)
 	if err != nil {
 		return "", fmt.Errorf("failed to list tenants in project: %w", err)
 	}
+	ids := make([]string, 0, len(tenants))
+	names := make([]string, 0, len(tenants))
 	for _, t := range tenants {
-		if t.Name == tenantName {
+		if tenantName != "" && t.Name == tenantName {
 			return t.ID, nil
 		}
+		ids = append(ids, t.ID)
+		names = append(names, t.Name)
+	}
+	if tenantID != "" {
+		return matchIDPrefix("tenant", tenantID, ids)
+	}
+	return "", api.NotFoundError("tenant with name %q not found in project%s", tenantName, didYouMean(tenantName, names))
+}
+
+// uuidPattern matches a complete UUID
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// isFullID reports whether id is a complete UUID that can be used without a lookup
+func isFullID(id string) bool {
+	return uuidPattern.MatchString(id)
+}
+
+// matchIDPrefix resolves a partial ID, like the unique prefixes accepted by
+// Docker, to the one ID in ids that starts with it. An exact match always wins.
+func matchIDPrefix(kind, prefix string, ids []string) (string, error) {
+	var matches []string
+	for _, id := range ids {
+		if id == prefix {
+			return id, nil
+		}
+		if strings.HasPrefix(strings.ToLower(id), strings.ToLower(prefix)) {
+			matches = append(matches, id)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", api.NotFoundError("no %s ID starts with %q", kind, prefix)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("%s ID prefix %q is ambiguous: it matches %s", kind, prefix, strings.Join(matches, ", "))
+	}
+}
+
+// didYouMean suggests the candidates closest to a name that was not found, as a
+// suffix for the error message, or returns "" if none are close
+func didYouMean(name string, candidates []string) string {
+	matches := suggest.Closest(name, candidates)
+	if len(matches) == 0 {
+		return ""
+	}
+	quoted := make([]string, len(matches))
+	for i, m := range matches {
+		quoted[i] = fmt.Sprintf("%q", m)
+	}
+	if len(quoted) == 1 {
+		return fmt.Sprintf("; did you mean %s?", quoted[0])
 	}
-	return "", fmt.Errorf("tenant with name %q not found in project", tenantName)
+	return fmt.Sprintf("; did you mean one of %s?", strings.Join(quoted, ", "))
 }
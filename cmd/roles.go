@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"spacectl/internal/api"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// rolesCmd represents the roles command
+var rolesCmd = &cobra.Command{
+	Use:   "roles",
+	Short: "Show the roles members can be given",
+	Long: `Show the roles that can be given to organization and project members, and what
+each of them allows.`,
+}
+
+// rolesListCmd represents the roles list command
+var rolesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List roles and their permissions",
+	Long: `List the roles that can be given with --role, such as in 'project members add'
+and 'org invitations send', with the permissions each one grants. Use --scope to
+only list organization or project roles.
+
+Examples:
+  spacectl roles list
+  spacectl roles list --scope project
+  spacectl roles list -o json | jq '.[] | select(.name == "member") | .permissions'`,
+	Args: cobra.NoArgs,
+	RunE: runRolesList,
+}
+
+var rolesScope string
+
+// roleScopes are the scopes --scope accepts
+var roleScopes = []string{"org", "project"}
+
+func init() {
+	rootCmd.AddCommand(rolesCmd)
+	rolesCmd.AddCommand(rolesListCmd)
+	rolesListCmd.Flags().StringVar(&rolesScope, "scope", "", "Only list roles of this scope: org or project")
+	rolesListCmd.RegisterFlagCompletionFunc("scope", cobra.FixedCompletions(roleScopes, cobra.ShellCompDirectiveNoFileComp))
+	addListFlags(rolesListCmd)
+}
+
+func runRolesList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	scope := strings.ToLower(rolesScope)
+	if scope != "" && !slices.Contains(roleScopes, scope) {
+		return fmt.Errorf("invalid --scope %q: use one of %s", rolesScope, strings.Join(roleScopes, ", "))
+	}
+
+	// Create API client
+	client := apiClient()
+	roles, err := api.NewRoleAPI(client).ListRoles(listContext(ctx), scope)
+	if err != nil {
+		return fmt.Errorf("failed to list roles: %w", err)
+	}
+	// Organization roles first, each scope in the server's order
+	sort.SliceStable(roles, func(i, j int) bool {
+		return roles[i].Scope == "org" && roles[j].Scope != "org"
+	})
+
+	// Structured formats get the permissions as a list
+	if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML {
+		return formatter.FormatData(roles)
+	}
+
+	if len(roles) == 0 {
+		if !quiet {
+			fmt.Println("No roles found.")
+		}
+		return nil
+	}
+
+	rows := make([]map[string]interface{}, 0, len(roles))
+	for _, r := range roles {
+		rows = append(rows, map[string]interface{}{
+			"scope":       r.Scope,
+			"role":        r.Name,
+			"description": r.Description,
+			"permissions": strings.Join(r.Permissions, ", "),
+		})
+	}
+	return formatter.FormatRows(rows, []string{"scope", "role", "description", "permissions"})
+}
@@ -1,24 +1,58 @@
 package cmd
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"log/slog"
+	"net/url"
 	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
 
+	"spacectl/internal/api"
+	"spacectl/internal/ci"
 	"spacectl/internal/config"
+	"spacectl/internal/logging"
 	"spacectl/internal/output"
+	"spacectl/internal/version"
 
 	"github.com/spf13/cobra"
 )
 
 var (
-	cfgFile   string
-	apiURL    string
-	outputFmt string
-	noHeaders bool
-	quiet     bool
-    debug     bool
+	cfgFile               string
+	apiURL                string
+	outputFmt             string
+	noHeaders             bool
+	quiet                 bool
+	assumeYes             bool
+	nonInteractive        bool
+	debug                 bool
+	logLevel              string
+	logFile               string
+	printCurl             bool
+	retries               int
+	timeout               time.Duration
+	connTimeout           time.Duration
+	caCert                string
+	clientCert            string
+	clientKey             string
+	insecureSkipTLSVerify bool
+	noCache               bool
+	offline               bool
+	ciProvider            string
+
+	// Settings resolved from flags and config in PersistentPreRunE
 	cfg       *config.Config
 	formatter *output.Formatter
+	logger    *slog.Logger
+	proxyURL  *url.URL
+	tlsConfig *tls.Config
+	annotator *ci.Annotator // nil unless --ci is given
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -41,17 +75,149 @@ with the Kubespaces API.`,
 			cfg.APIURL = apiURL
 		}
 
-        // Create formatter
+		// Fall back to the configured timeouts when no flag is given
+		cfgConnectTimeout, cfgTimeout, err := cfg.Timeouts()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		if connTimeout == 0 {
+			connTimeout = cfgConnectTimeout
+		}
+		if timeout == 0 {
+			timeout = cfgTimeout
+		}
+
+		// Validate the proxy from config up front rather than on the first request
+		if proxyURL, err = cfg.Proxy(); err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+
+		// Build the TLS configuration, letting flags override config
+		tlsOpts := api.TLSOptions{
+			CACertFile:         cfg.CACert,
+			ClientCertFile:     cfg.ClientCert,
+			ClientKeyFile:      cfg.ClientKey,
+			InsecureSkipVerify: cfg.InsecureSkipTLSVerify,
+		}
+		if caCert != "" {
+			tlsOpts.CACertFile = caCert
+		}
+		if clientCert != "" {
+			tlsOpts.ClientCertFile = clientCert
+		}
+		if clientKey != "" {
+			tlsOpts.ClientKeyFile = clientKey
+		}
+		if cmd.Flags().Changed("insecure-skip-tls-verify") {
+			tlsOpts.InsecureSkipVerify = insecureSkipTLSVerify
+		}
+		if tlsConfig, err = api.LoadTLSConfig(tlsOpts); err != nil {
+			return err
+		}
+
+		if offline && noCache {
+			return fmt.Errorf("--offline reads from the response cache and cannot be combined with --no-cache")
+		}
+		if concurrency < 1 {
+			return fmt.Errorf("--concurrency must be at least 1")
+		}
+
+		if logger, err = newLogger(); err != nil {
+			return err
+		}
+
+		// Annotations go to stderr so they never mix with structured output
+		if ciProvider != "" {
+			provider, err := ci.ParseProvider(ciProvider)
+			if err != nil {
+				return err
+			}
+			annotator = ci.New(provider, os.Stderr)
+		}
+
+		// Resolve the project and organization to work in
+		if err := resolveContextFlags(cmd.Context()); err != nil {
+			return err
+		}
+
+		// Create formatter
 		format := output.Format(outputFmt)
 		formatter = output.NewFormatter(format, noHeaders, os.Stdout)
+		formatter.SetListOptions(output.ListOptions{
+			SortBy:  listOpts.SortBy,
+			Filters: listOpts.Filters,
+		})
 
 		return nil
 	},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+		// Tell the user how old the data shown in offline mode is
+		if sharedClient == nil || !offline {
+			return
+		}
+		if asOf := sharedClient.OfflineAsOf(); !asOf.IsZero() {
+			fmt.Fprintf(os.Stderr, "Offline: showing cached data as of %s (%s ago)\n",
+				asOf.Local().Format("2006-01-02 15:04:05 MST"), output.FormatAge(asOf))
+		}
+	},
 }
 
-// Execute adds all child commands to the root command and sets flags appropriately.
+// Execute adds all child commands to the root command and sets flags appropriately,
+// or runs a plugin when the command line names one. Ctrl-C cancels the command's
+// context so in-flight requests and watch loops stop promptly.
 func Execute() error {
-	return rootCmd.Execute()
+	if isKubectlPlugin(os.Args[0]) {
+		useKubectlPluginName()
+	}
+
+	// Commands that are not built in may be plugins
+	if path, args, ok := findPlugin(os.Args[1:]); ok {
+		err := runPlugin(path, args)
+		if err != nil && !errors.As(err, new(exitCodeError)) {
+			rootCmd.PrintErrln(rootCmd.ErrPrefix(), err.Error())
+		}
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	// done is closed when the command returns, before stop cancels ctx, so the
+	// watchdog below only acts on a real interrupt
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+			return
+		}
+		select {
+		case <-done:
+			return
+		default:
+		}
+		stop()
+		// Give the command a moment to unwind, then exit in case it is blocked
+		// on something that ignores the context, such as a confirmation prompt
+		time.Sleep(2 * time.Second)
+		fmt.Fprintln(os.Stderr, "Interrupted")
+		os.Exit(ExitInterrupted)
+	}()
+
+	rootCmd.SilenceErrors = true
+	cmd, err := rootCmd.ExecuteContextC(ctx)
+	if err != nil {
+		switch {
+		case ctx.Err() != nil:
+			rootCmd.PrintErrln("Interrupted")
+		case errors.As(err, new(exitCodeError)):
+			// A tool run by the command failed and already explained why
+		default:
+			rootCmd.PrintErrln(rootCmd.ErrPrefix(), err.Error())
+		}
+		annotator.Error(cmd.CommandPath()+" failed", err.Error())
+	}
+	return err
 }
 
 func init() {
@@ -60,10 +226,99 @@ func init() {
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.spacectl)")
 	rootCmd.PersistentFlags().StringVar(&apiURL, "api-url", "", "API URL (overrides config)")
-	rootCmd.PersistentFlags().StringVarP(&outputFmt, "output", "o", "table", "Output format (table, json, yaml, csv)")
+	rootCmd.PersistentFlags().StringVarP(&outputFmt, "output", "o", "table", "Output format (table, wide, json, yaml, csv, name, terraform-external)")
 	rootCmd.PersistentFlags().BoolVar(&noHeaders, "no-headers", false, "Suppress headers in table/CSV output")
 	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "Minimal output")
-    rootCmd.PersistentFlags().BoolVar(&debug, "debug", false, "Enable debug logging of API requests")
+	rootCmd.PersistentFlags().BoolVarP(&assumeYes, "yes", "y", false, "Skip confirmation prompts, answering yes")
+	rootCmd.PersistentFlags().BoolVar(&nonInteractive, "non-interactive", false, "Never prompt for input; fail if a required value is missing (implied when stdin is not a terminal)")
+	rootCmd.PersistentFlags().BoolVar(&debug, "debug", false, "Enable debug logging of API requests (same as --log-level debug)")
+	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "", "Log API requests and their timing at this level: debug, info, warn, error, or off (default off, or debug with --log-file)")
+	rootCmd.PersistentFlags().StringVar(&logFile, "log-file", "", "Append logs to this file instead of stderr")
+	rootCmd.PersistentFlags().BoolVar(&printCurl, "curl", false, "Print the equivalent curl command of each API call to stderr (with the access token redacted)")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0, "Overall timeout for each API request, e.g. 2m (overrides config, default 30s)")
+	rootCmd.PersistentFlags().DurationVar(&connTimeout, "connect-timeout", 0, "Timeout for connecting to the API (overrides config, default 10s)")
+	rootCmd.PersistentFlags().StringVar(&caCert, "ca-cert", "", "PEM file with CA certificates to trust for the API server")
+	rootCmd.PersistentFlags().StringVar(&clientCert, "client-cert", "", "PEM client certificate for mutual TLS")
+	rootCmd.PersistentFlags().StringVar(&clientKey, "client-key", "", "PEM client key for mutual TLS")
+	rootCmd.PersistentFlags().BoolVar(&insecureSkipTLSVerify, "insecure-skip-tls-verify", false, "Do not verify the API server's certificate (insecure)")
+	rootCmd.PersistentFlags().BoolVar(&noCache, "no-cache", false, "Do not use cached responses for locations, versions, organizations, projects, and tenants")
+	rootCmd.PersistentFlags().BoolVar(&offline, "offline", false, "Serve list and get commands from the local response cache without contacting the API")
+	rootCmd.PersistentFlags().StringVar(&ciProvider, "ci", "", "Annotate failures, and sections and summaries of apply and delete -f, for a CI system: github or gitlab")
+	rootCmd.PersistentFlags().IntVar(&retries, "retries", api.DefaultRetries, "Number of times to retry rate-limited requests and idempotent requests that hit server or network errors")
+}
+
+// Shared API client, created on first use
+var (
+	sharedClient     *api.Client
+	sharedClientOnce sync.Once
+)
+
+// apiClient returns the API client shared by the running command, configured from
+// the global flags. Reusing one client keeps connections alive across the many
+// requests some commands make.
+func apiClient() *api.Client {
+	sharedClientOnce.Do(func() {
+		sharedClient = api.NewClient(cfg.APIURL, cfg, false)
+		sharedClient.SetLogger(logger)
+		if printCurl {
+			sharedClient.SetCurlOutput(os.Stderr)
+		}
+		sharedClient.SetRetries(retries)
+		sharedClient.SetTimeouts(connTimeout, timeout)
+		sharedClient.SetProxy(proxyURL)
+		sharedClient.SetTLSConfig(tlsConfig)
+		if !noCache {
+			sharedClient.EnableCache(api.DefaultCacheDir())
+		}
+		sharedClient.SetOffline(offline)
+	})
+	return sharedClient
+}
+
+// newLogger builds the diagnostic logger from --log-level, --log-file, and --debug.
+// Logging is off unless one of them is given; a log file without a level records
+// full debug traces so it can be attached to bug reports.
+func newLogger() (*slog.Logger, error) {
+	level := logging.LevelOff
+	switch {
+	case logLevel != "":
+		var err error
+		if level, err = logging.ParseLevel(logLevel); err != nil {
+			return nil, err
+		}
+	case debug || logFile != "":
+		level = slog.LevelDebug
+	}
+
+	if logFile == "" {
+		return logging.New(os.Stderr, level), nil
+	}
+	f, err := logging.OpenFile(logFile)
+	if err != nil {
+		return nil, err
+	}
+	// The file stays open until the process exits so late requests are logged too
+	logger := logging.New(f, level)
+	logger.Info("spacectl started", "version", version.Version, "args", redactArgs(os.Args[1:]))
+	return logger, nil
+}
+
+// redactArgs masks the values of flags that carry secrets, for logging the command line
+func redactArgs(args []string) []string {
+	redacted := make([]string, len(args))
+	copy(redacted, args)
+	for i, arg := range redacted {
+		name, _, hasValue := strings.Cut(arg, "=")
+		if name != "--password" {
+			continue
+		}
+		if hasValue {
+			redacted[i] = name + "=***REDACTED***"
+		} else if i+1 < len(redacted) {
+			redacted[i+1] = "***REDACTED***"
+		}
+	}
+	return redacted
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+	"spacectl/internal/suggest"
+
+	"github.com/spf13/cobra"
+)
+
+// searchCmd represents the search command
+var searchCmd = &cobra.Command{
+	Use:   "search <term>",
+	Short: "Find organizations, projects, and tenants by name",
+	Long: `Search the names of every organization, project, and tenant you can see, and
+the namespaces of tenants, for a term. Matching ignores case and is forgiving:
+"pdeu" finds prod-eu, and so does a small typo such as "prdo-eu". The best
+matches are listed first.
+
+Examples:
+  spacectl search prod
+  spacectl search payments -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSearch,
+}
+
+func init() {
+	rootCmd.AddCommand(searchCmd)
+	addConcurrencyFlag(searchCmd)
+}
+
+// searchResult is a resource that matched the search term
+type searchResult struct {
+	score int
+	row   map[string]interface{}
+}
+
+func runSearch(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	term := args[0]
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+	orgAPI := api.NewOrganizationAPI(client)
+	projectAPI := api.NewProjectAPI(client)
+	tenantAPI := api.NewTenantAPI(client)
+
+	// Organizations and projects are listed concurrently, then the tenants of
+	// every project
+	var (
+		wg          sync.WaitGroup
+		orgs        []models.OrganizationMembershipResponse
+		memberships []models.ProjectMembership
+		orgErr      error
+		projectErr  error
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		orgs, orgErr = orgAPI.ListUserOrganizations(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		memberships, projectErr = projectAPI.ListUserProjects(ctx)
+	}()
+	wg.Wait()
+	if orgErr != nil {
+		return fmt.Errorf("failed to list organizations: %w", orgErr)
+	}
+	if projectErr != nil {
+		return fmt.Errorf("failed to list user projects: %w", projectErr)
+	}
+
+	orgNames := make(map[string]string, len(orgs))
+	for _, m := range orgs {
+		orgNames[m.Organization.ID] = m.Organization.Name
+	}
+	projectIDs := make([]string, 0, len(memberships))
+	projectNames := make(map[string]string, len(memberships))
+	for _, m := range memberships {
+		projectIDs = append(projectIDs, m.Project.ID)
+		projectNames[m.Project.ID] = m.Project.Name
+	}
+	tenants, failed := fetchProjectTenants(ctx, tenantAPI, projectIDs)
+
+	var results []searchResult
+	add := func(score int, kind, name, parent, namespace, id string) {
+		results = append(results, searchResult{score: score, row: map[string]interface{}{
+			"type":      kind,
+			"name":      name,
+			"parent":    parent,
+			"namespace": namespace,
+			"id":        id,
+		}})
+	}
+	for _, m := range orgs {
+		if score, ok := suggest.Score(term, m.Organization.Name); ok {
+			add(score, "organization", m.Organization.Name, "", "", m.Organization.ID)
+		}
+	}
+	for _, m := range memberships {
+		if score, ok := suggest.Score(term, m.Project.Name); ok {
+			add(score, "project", m.Project.Name, orgNames[m.Project.OrganizationID], "", m.Project.ID)
+		}
+	}
+	for _, id := range projectIDs {
+		if failed[id] {
+			fmt.Fprintf(os.Stderr, "Warning: could not search the tenants of project %s\n", projectNames[id])
+			continue
+		}
+		for _, t := range tenants[id] {
+			score, ok := suggest.Score(term, t.Name)
+			if nsScore, nsOK := suggest.Score(term, t.Namespace); nsOK && (!ok || nsScore < score) {
+				score, ok = nsScore, true
+			}
+			if ok {
+				add(score, "tenant", t.Name, projectNames[id], t.Namespace, t.ID)
+			}
+		}
+	}
+
+	if len(results) == 0 {
+		if !quiet {
+			fmt.Fprintf(os.Stderr, "No organizations, projects, or tenants match %q\n", term)
+		}
+		return nil
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].score < results[j].score
+	})
+	rows := make([]map[string]interface{}, len(results))
+	for i, r := range results {
+		rows[i] = r.row
+	}
+	return formatter.FormatRows(rows, []string{"type", "name", "parent", "namespace", "id"})
+}
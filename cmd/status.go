@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+	"spacectl/internal/output"
+	"spacectl/internal/parallel"
+
+	"github.com/spf13/cobra"
+)
+
+// statusCmd represents the status command
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show an overview of your account and resources",
+	Long: `Show who you are logged in as, your default organization and project, how
+many projects and tenants you have by status, and any tenants that have failed.
+
+Examples:
+  spacectl status
+  spacectl status -o json`,
+	Args: cobra.NoArgs,
+	RunE: runStatus,
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+	addConcurrencyFlag(statusCmd)
+}
+
+// tenantStatusFailed is the status of a tenant whose provisioning failed
+const tenantStatusFailed = "Failed"
+
+// statusOverview is the structured form of status used for JSON/YAML output
+type statusOverview struct {
+	User                string                   `json:"user" yaml:"user"`
+	DefaultOrganization string                   `json:"default_organization,omitempty" yaml:"default_organization,omitempty"`
+	DefaultProject      string                   `json:"default_project,omitempty" yaml:"default_project,omitempty"`
+	Projects            map[string]int           `json:"projects" yaml:"projects"`
+	Tenants             map[string]int           `json:"tenants" yaml:"tenants"`
+	FailedTenants       []map[string]interface{} `json:"failed_tenants" yaml:"failed_tenants"`
+	// UnreachableProjects are projects whose tenants could not be listed
+	UnreachableProjects []string `json:"unreachable_projects,omitempty" yaml:"unreachable_projects,omitempty"`
+}
+
+func runStatus(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Create API client
+	client := apiClient()
+	authAPI := api.NewAuthAPI(client)
+	orgAPI := api.NewOrganizationAPI(client)
+	projectAPI := api.NewProjectAPI(client)
+	tenantAPI := api.NewTenantAPI(client)
+
+	user, err := authAPI.GetUserInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get user info: %w", err)
+	}
+	overview := statusOverview{
+		User:     user.Email,
+		Projects: map[string]int{},
+		Tenants:  map[string]int{},
+	}
+
+	// A missing default organization is not an error for an overview
+	if org, err := orgAPI.GetDefaultOrganization(ctx); err == nil {
+		overview.DefaultOrganization = org.Name
+	}
+
+	memberships, err := projectAPI.ListUserProjects(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list user projects: %w", err)
+	}
+	projectIDs := make([]string, 0, len(memberships))
+	projectNames := make(map[string]string, len(memberships))
+	for _, m := range memberships {
+		overview.Projects[m.Project.DisplayStatus()]++
+		projectIDs = append(projectIDs, m.Project.ID)
+		projectNames[m.Project.ID] = m.Project.Name
+	}
+	if cfg.DefaultProject != "" {
+		overview.DefaultProject = cfg.DefaultProject
+		if name, ok := projectNames[cfg.DefaultProject]; ok {
+			overview.DefaultProject = name
+		}
+	}
+
+	tenants, failed := fetchProjectTenants(ctx, tenantAPI, projectIDs)
+	for _, id := range projectIDs {
+		if failed[id] {
+			overview.UnreachableProjects = append(overview.UnreachableProjects, projectNames[id])
+			continue
+		}
+		for _, t := range tenants[id] {
+			overview.Tenants[t.Status]++
+			if t.Status == tenantStatusFailed {
+				overview.FailedTenants = append(overview.FailedTenants, map[string]interface{}{
+					"project":  projectNames[id],
+					"name":     t.Name,
+					"id":       t.ID,
+					"location": t.CloudProvider + "/" + t.Region,
+				})
+			}
+		}
+	}
+
+	// Structured formats get the whole overview as a single document
+	if output.Format(outputFmt) != output.FormatTable {
+		return formatter.FormatData(overview)
+	}
+
+	fmt.Printf("User:                  %s\n", overview.User)
+	fmt.Printf("Default organization:  %s\n", valueOrNone(overview.DefaultOrganization))
+	fmt.Printf("Default project:       %s\n", valueOrNone(overview.DefaultProject))
+	fmt.Printf("Projects:              %s\n", formatStatusCounts(overview.Projects))
+	fmt.Printf("Tenants:               %s\n", formatStatusCounts(overview.Tenants))
+	if len(overview.UnreachableProjects) > 0 {
+		fmt.Printf("Unreachable projects:  %s\n", strings.Join(overview.UnreachableProjects, ", "))
+	}
+
+	if len(overview.FailedTenants) == 0 {
+		if !quiet {
+			fmt.Println("\nNo failed tenants.")
+		}
+		return nil
+	}
+	fmt.Println("\nFailed tenants:")
+	return formatter.FormatRows(overview.FailedTenants, []string{"project", "name", "location", "id"})
+}
+
+// fetchProjectTenants lists the tenants of the given projects, --concurrency at
+// a time. The second result holds the projects whose tenants could not be listed.
+func fetchProjectTenants(ctx context.Context, tenantAPI *api.TenantAPI, projectIDs []string) (map[string][]models.Tenant, map[string]bool) {
+	tenants := make(map[string][]models.Tenant, len(projectIDs))
+	failed := make(map[string]bool)
+	var mu sync.Mutex
+
+	parallel.ForEach(len(projectIDs), concurrency, func(i int) {
+		list, err := tenantAPI.ListProjectTenants(ctx, projectIDs[i])
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			failed[projectIDs[i]] = true
+		} else {
+			tenants[projectIDs[i]] = list
+		}
+	})
+	return tenants, failed
+}
+
+// formatStatusCounts renders counts by status as "3 total (2 Ready, 1 Failed)"
+func formatStatusCounts(counts map[string]int) string {
+	total := 0
+	statuses := make([]string, 0, len(counts))
+	for status, n := range counts {
+		total += n
+		statuses = append(statuses, status)
+	}
+	if total == 0 {
+		return "0"
+	}
+	sort.Strings(statuses)
+
+	parts := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		label := status
+		if label == "" {
+			label = "Unknown"
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", counts[status], label))
+	}
+	return fmt.Sprintf("%d total (%s)", total, strings.Join(parts, ", "))
+}
+
+// valueOrNone returns s, or "(none)" if it is empty
+func valueOrNone(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return s
+}
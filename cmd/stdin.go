@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+)
+
+// stdinArg is the argument that stands for a list read from stdin
+const stdinArg = "-"
+
+// expandStdinArgs replaces "-" among values with the lines read from stdin, so
+// lists of names or IDs can be piped in, e.g. from "tenant list -o name". Blank
+// lines and lines starting with # are skipped. Stdin can only be read once.
+func expandStdinArgs(values []string) ([]string, error) {
+	var expanded []string
+	readStdin := false
+	for _, value := range values {
+		if value != stdinArg {
+			expanded = append(expanded, value)
+			continue
+		}
+		if readStdin {
+			return nil, fmt.Errorf("%q can only be given once", stdinArg)
+		}
+		readStdin = true
+
+		scanner := bufio.NewScanner(stdinReader)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			expanded = append(expanded, line)
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read stdin: %w", err)
+		}
+	}
+	return expanded, nil
+}
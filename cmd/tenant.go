@@ -1,20 +1,33 @@
 package cmd
 
 import (
-	"bufio"
+	"context"
 	"crypto/md5"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"spacectl/internal/api"
+	"spacectl/internal/config"
+	"spacectl/internal/kubeconfig"
+	"spacectl/internal/manifest"
 	"spacectl/internal/models"
+	"spacectl/internal/names"
+	"spacectl/internal/output"
+	"spacectl/internal/parallel"
+	"spacectl/internal/pty"
+	"spacectl/internal/units"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
 )
 
 // tenantCmd represents the tenant command
@@ -32,43 +45,55 @@ func init() {
 var tenantListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List tenants",
-	Long:  `List tenants. Use --project to filter by project.`,
-	RunE:  runTenantList,
+	Long: `List the tenants of the project given with --project or --project-name, or of
+the default project. Use --all to list tenants from all projects, with a
+PROJECT column.
+
+The table shows each tenant's namespace, status, location, Kubernetes version,
+quotas, and age; -o wide adds the host cluster and the tenant ID.
+
+Examples:
+  spacectl tenant list
+  spacectl tenant list --all -o wide`,
+	RunE: runTenantList,
 }
 
-var tenantListProject string
-var tenantListProjectName string
 var tenantListAll bool
 
 func init() {
 	tenantCmd.AddCommand(tenantListCmd)
-	tenantListCmd.Flags().StringVar(&tenantListProject, "project", "", "Project ID to filter tenants")
-	tenantListCmd.Flags().StringVar(&tenantListProjectName, "project-name", "", "Project name to filter tenants")
 	tenantListCmd.Flags().BoolVar(&tenantListAll, "all", false, "List tenants from all projects")
+	addConcurrencyFlag(tenantListCmd)
+	addListFlags(tenantListCmd)
+}
+
+// projectTenant is a tenant listed with the name of its project
+type projectTenant struct {
+	Project       string `json:"project" yaml:"project"`
+	models.Tenant `yaml:",inline"`
 }
 
 func runTenantList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Validate flags
-	if tenantListAll && (tenantListProject != "" || tenantListProjectName != "") {
+	if tenantListAll && projectFlagSet() {
 		return fmt.Errorf("--all cannot be used with --project or --project-name")
 	}
-	if tenantListProjectName != "" && tenantListProject != "" {
-		return fmt.Errorf("only one of --project or --project-name is allowed")
-	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	tenantAPI := api.NewTenantAPI(client)
 
 	if tenantListAll {
 		// List tenants from all projects
 		projectAPI := api.NewProjectAPI(client)
-		userProjects, err := projectAPI.ListUserProjects()
+		userProjects, err := projectAPI.ListUserProjects(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to list user projects: %w", err)
 		}
@@ -76,208 +101,413 @@ func runTenantList(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("no projects found. Create a project first")
 		}
 
-		// Create custom output for all tenants with proper alignment
-		var output strings.Builder
-		output.WriteString("PROJECT        NAME                CLOUD  REGION  VERSION   COMPUTE  MEMORY(GB)  STATUS\n")
-		output.WriteString("-------        ----                -----  ------  -------   -------  ----------  ------\n")
-
-		for _, membership := range userProjects {
-			projectTenants, err := tenantAPI.ListProjectTenants(membership.Project.ID)
-			if err != nil {
+		// Collect tenants from every project, --concurrency projects at a time,
+		// tagged with the project name
+		type projectResult struct {
+			tenants []models.Tenant
+			err     error
+		}
+		results := parallel.Map(userProjects, concurrency, func(m models.ProjectMembership) projectResult {
+			tenants, err := tenantAPI.ListProjectTenants(listContext(ctx), m.Project.ID)
+			return projectResult{tenants: tenants, err: err}
+		})
+		var allTenants []projectTenant
+		for i, membership := range userProjects {
+			if err := results[i].err; err != nil {
 				return fmt.Errorf("failed to list tenants for project %s: %w", membership.Project.Name, err)
 			}
-			for _, tenant := range projectTenants {
-				output.WriteString(fmt.Sprintf("%-15s %-20s %-6s %-7s %-9s %-8d %-11d %s\n",
-					membership.Project.Name,
-					tenant.Namespace,
-					tenant.CloudProvider,
-					tenant.Region,
-					tenant.KubernetesVersion,
-					tenant.ComputeQuota,
-					tenant.MemoryQuotaGB,
-					tenant.Status,
-				))
+			for _, tenant := range results[i].tenants {
+				allTenants = append(allTenants, projectTenant{Project: membership.Project.Name, Tenant: tenant})
 			}
 		}
+		allTenants = limitTotal(allTenants)
 
-		fmt.Print(output.String())
-		return nil
-	}
-
-	// Single project logic
-	if tenantListProject == "" && tenantListProjectName != "" {
-		pid, err := resolveProjectID(client, tenantListProjectName, "", "")
-		if err != nil {
-			return err
+		// Structured formats get the tenants with all their fields
+		if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML {
+			return formatter.FormatData(allTenants)
 		}
-		tenantListProject = pid
+		rows := make([]map[string]interface{}, 0, len(allTenants))
+		for _, t := range allTenants {
+			row := formatter.TenantRecord(t.Tenant)
+			row["project"] = t.Project
+			rows = append(rows, row)
+		}
+		addVersionSupport(ctx, tenantAPI, rows)
+		return formatter.FormatRows(rows, output.TenantColumns)
 	}
 
-	// If still empty, use default project
-	if tenantListProject == "" {
-		// Get user's projects and use the first one as default
-		projectAPI := api.NewProjectAPI(client)
-		userProjects, err := projectAPI.ListUserProjects()
-		if err != nil {
-			return fmt.Errorf("failed to list user projects: %w", err)
-		}
-		if len(userProjects) == 0 {
-			return fmt.Errorf("no projects found. Create a project first")
-		}
-		tenantListProject = userProjects[0].Project.ID
+	// Single project logic
+	projectID, err := requireProjectID()
+	if err != nil {
+		return err
 	}
 
 	// Get tenants
-	tenants, err := tenantAPI.ListProjectTenants(tenantListProject)
+	tenants, err := tenantAPI.ListProjectTenants(listContext(ctx), projectID)
 	if err != nil {
 		return fmt.Errorf("failed to list tenants: %w", err)
 	}
 
-	// Output tenants
-	return formatter.FormatData(tenants)
+	// Output tenants, noting Kubernetes versions near or past end of life
+	rows := make([]map[string]interface{}, 0, len(tenants))
+	for _, t := range tenants {
+		rows = append(rows, formatter.TenantRecord(t))
+	}
+	if !addVersionSupport(ctx, tenantAPI, rows) {
+		return formatter.FormatData(tenants)
+	}
+	return formatter.FormatRows(rows, output.TenantColumns)
 }
 
 // tenantCreateCmd represents the tenant create command
 var tenantCreateCmd = &cobra.Command{
 	Use:   "create <name>",
 	Short: "Create a tenant",
-	Long:  `Create a new Kubernetes tenant in the specified project.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runTenantCreate,
+	Long: `Create a new Kubernetes tenant in the specified project.
+
+With -f, the tenant is read from a Tenant manifest (see 'spacectl explain tenant').
+Flags and the name argument override the values in the manifest.
+
+Settings not given as flags or in the manifest come from the project's entry in
+project_defaults in ~/.spacectl, then from default_cloud, default_region,
+default_compute, and default_memory, and the Kubernetes version defaults to the
+latest one. The defaults used are printed before the tenant is created.
+
+Creating a tenant with the name of an existing tenant in the project fails before
+anything is created. With --if-not-exists, the existing tenant is printed instead,
+so scripts can run the command again safely.
+
+Examples:
+  spacectl tenant create dev --cloud eks --region eu
+  spacectl tenant create dev
+  spacectl tenant create dev --compute 4 --memory 16Gi
+  spacectl tenant create -f dev.yaml
+  spacectl tenant create dev --if-not-exists -o json`,
+	Args: cobra.RangeArgs(0, 1),
+	RunE: runTenantCreate,
 }
 
 var (
-	tenantCreateProject         string
-	tenantCreateProjectName     string
 	tenantCreateCloud           string
 	tenantCreateRegion          string
 	tenantCreateK8sVersion      string
-	tenantCreateCompute         int
-	tenantCreateMemory          int
+	tenantCreateCompute         units.Cores
+	tenantCreateMemory          units.GiB
 	tenantCreateNamespaceSuffix string
+	tenantCreateFile            string
+	tenantCreateIfNotExists     bool
 )
 
 func init() {
 	tenantCmd.AddCommand(tenantCreateCmd)
-	tenantCreateCmd.Flags().StringVar(&tenantCreateProject, "project", "", "Project ID")
-	tenantCreateCmd.Flags().StringVar(&tenantCreateProjectName, "project-name", "", "Project name")
 	tenantCreateCmd.Flags().StringVar(&tenantCreateCloud, "cloud", "", "Cloud provider (uses config default if not set)")
 	tenantCreateCmd.Flags().StringVar(&tenantCreateRegion, "region", "", "Region (uses config default if not set)")
 	tenantCreateCmd.Flags().StringVar(&tenantCreateK8sVersion, "k8s-version", "", "Kubernetes version (uses latest if not set)")
-	tenantCreateCmd.Flags().IntVar(&tenantCreateCompute, "compute", 0, "Compute quota in cores (uses config default if not set)")
-	tenantCreateCmd.Flags().IntVar(&tenantCreateMemory, "memory", 0, "Memory quota in GB (uses config default if not set)")
+	tenantCreateCmd.RegisterFlagCompletionFunc("cloud", completeClouds)
+	tenantCreateCmd.RegisterFlagCompletionFunc("region", completeRegions)
+	tenantCreateCmd.RegisterFlagCompletionFunc("k8s-version", completeKubernetesVersions)
+	tenantCreateCmd.Flags().Var(&tenantCreateCompute, "compute", "Compute quota in cores, such as 2 or 2000m (uses config default if not set)")
+	tenantCreateCmd.Flags().Var(&tenantCreateMemory, "memory", "Memory quota, such as 4Gi or 0.5Ti; plain numbers are Gi (uses config default if not set)")
 	tenantCreateCmd.Flags().StringVar(&tenantCreateNamespaceSuffix, "namespace-suffix", "", "Namespace suffix")
+	tenantCreateCmd.Flags().StringVarP(&tenantCreateFile, "file", "f", "", "Tenant manifest to create the tenant from (use - for stdin)")
+	tenantCreateCmd.Flags().BoolVar(&tenantCreateIfNotExists, "if-not-exists", false, "Print the existing tenant instead of failing when the name is taken")
+	addManifestValueFlags(tenantCreateCmd)
 }
 
 func runTenantCreate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
-	name := args[0]
+	name := ""
+	if len(args) == 1 {
+		name = args[0]
+	}
+	if tenantCreateFile != "" {
+		m, err := readTenantManifest(tenantCreateFile)
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			name = m.Metadata.Name
+		}
+		if !projectFlagSet() && m.Metadata.Project != "" {
+			if err := selectProject(ctx, m.Metadata.Project); err != nil {
+				return err
+			}
+		}
+		applyTenantSpec(m.Spec)
+	}
+	if name == "" {
+		return fmt.Errorf("a tenant name is required")
+	}
+	if err := names.ValidateTenant(name); err != nil {
+		return err
+	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	tenantAPI := api.NewTenantAPI(client)
 
-	// Resolve project if name provided
-	if tenantCreateProjectName != "" && tenantCreateProject != "" {
-		return fmt.Errorf("only one of --project or --project-name is allowed")
+	// Use the project from --project or --project-name, or the default project
+	projectID, err := requireProjectID()
+	if err != nil {
+		return err
+	}
+
+	// Fail fast when the name is taken, before any defaults are fetched
+	existing, err := findTenantByName(ctx, tenantAPI, projectID, name)
+	if err != nil {
+		return err
 	}
-	if tenantCreateProject == "" && tenantCreateProjectName != "" {
-		pid, err := resolveProjectID(client, tenantCreateProjectName, "", "")
+	if existing != nil {
+		if !tenantCreateIfNotExists {
+			return api.ConflictError("tenant %q already exists in this project (ID %s); pass --if-not-exists to reuse it", name, existing.ID)
+		}
+		if !quiet {
+			fmt.Fprintf(os.Stderr, "Tenant %s already exists, not creating it\n", name)
+		}
+		return formatter.FormatData(existing)
+	}
+
+	// Prepare request
+	req := models.CreateTenantRequest{
+		Name:              name,
+		CloudProvider:     tenantCreateCloud,
+		Region:            tenantCreateRegion,
+		KubernetesVersion: tenantCreateK8sVersion,
+		ComputeQuota:      int(tenantCreateCompute),
+		MemoryQuotaGB:     int(tenantCreateMemory),
+		NamespaceSuffix:   tenantCreateNamespaceSuffix,
+	}
+
+	// Apply defaults from config, and fetch the latest k8s version if not provided
+	projectDefaults, err := projectTenantDefaults(ctx, client, projectID, "")
+	if err != nil {
+		return err
+	}
+	latestVersion := req.KubernetesVersion == "" && projectDefaults.KubernetesVersion == ""
+	if latestVersion && !quiet {
+		fmt.Println("Fetching latest Kubernetes version...")
+	}
+	applied, err := tenantCreateDefaults(ctx, tenantAPI, &req, projectDefaults)
+	if err != nil {
+		return err
+	}
+	if len(applied) > 0 && !quiet {
+		fmt.Printf("Using defaults: %s\n", strings.Join(applied, ", "))
+	}
+	if latestVersion && !quiet {
+		fmt.Printf("Using Kubernetes version: %s\n", req.KubernetesVersion)
+	}
+	if !quiet {
+		warnKubernetesVersionSupport(ctx, tenantAPI, req.KubernetesVersion)
+	}
+
+	// Create tenant
+	tenant, err := tenantAPI.CreateTenant(ctx, projectID, req)
+	if err != nil {
+		return fmt.Errorf("failed to create tenant: %w", err)
+	}
+
+	// Output tenant
+	return formatter.FormatData(tenant)
+}
+
+// warnKubernetesVersionSupport warns on stderr when a Kubernetes version is
+// deprecated or at or near end of life, suggesting the default version
+func warnKubernetesVersionSupport(ctx context.Context, tenantAPI *api.TenantAPI, version string) {
+	versions, err := tenantAPI.GetAvailableKubernetesVersions(ctx)
+	if err != nil {
+		return
+	}
+	var support, suggested string
+	for _, v := range versions {
+		if v.Version == version {
+			support = v.Support(time.Now())
+		}
+		if v.IsDefault {
+			suggested = v.Version
+		}
+	}
+	if support == "" {
+		return
+	}
+	if suggested != "" && suggested != version {
+		fmt.Fprintf(os.Stderr, "Warning: Kubernetes %s: %s; consider --k8s-version %s\n", version, support, suggested)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Warning: Kubernetes %s: %s\n", version, support)
+}
+
+// findTenantByName returns the tenant of a project with the given name, or nil
+// if there is none
+func findTenantByName(ctx context.Context, tenantAPI *api.TenantAPI, projectID, name string) (*models.Tenant, error) {
+	tenants, err := tenantAPI.ListProjectTenants(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenants: %w", err)
+	}
+	for i := range tenants {
+		if tenants[i].Name == name {
+			return &tenants[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// projectTenantDefaults returns the tenant creation defaults configured for a
+// project, given by ID or, before it exists, by name
+func projectTenantDefaults(ctx context.Context, client *api.Client, projectID, projectName string) (config.TenantDefaults, error) {
+	if len(cfg.ProjectDefaults) == 0 {
+		return config.TenantDefaults{}, nil
+	}
+	if defaults, ok := cfg.ProjectDefaults[projectID]; ok && projectID != "" {
+		return defaults, nil
+	}
+	if projectName == "" {
+		project, err := api.NewProjectAPI(client).GetProject(ctx, projectID)
 		if err != nil {
-			return err
+			return config.TenantDefaults{}, fmt.Errorf("failed to get project: %w", err)
 		}
-		tenantCreateProject = pid
+		projectName = project.Name
 	}
+	return cfg.ProjectDefaults[projectName], nil
+}
 
-	// Require project
-	if tenantCreateProject == "" && tenantCreateProjectName == "" {
-		return fmt.Errorf("either --project or --project-name is required")
+// tenantCreateDefaults fills the settings of a create request that were not
+// given from the project's defaults, then the config defaults, and the
+// Kubernetes version with the latest one. It returns the defaults it applied,
+// e.g. "region eu", for the user to see.
+func tenantCreateDefaults(ctx context.Context, tenantAPI *api.TenantAPI, req *models.CreateTenantRequest, project config.TenantDefaults) ([]string, error) {
+	var applied []string
+	if req.ComputeQuota == 0 && project.Compute > 0 {
+		req.ComputeQuota = project.Compute
+		applied = append(applied, fmt.Sprintf("compute %d cores", req.ComputeQuota))
+	}
+	if req.MemoryQuotaGB == 0 && project.Memory > 0 {
+		req.MemoryQuotaGB = project.Memory
+		applied = append(applied, "memory "+units.GiB(req.MemoryQuotaGB).String())
+	}
+	if req.KubernetesVersion == "" && project.KubernetesVersion != "" {
+		req.KubernetesVersion = project.KubernetesVersion
+		applied = append(applied, "Kubernetes version "+req.KubernetesVersion)
 	}
 
-	// Apply defaults from config
-	if tenantCreateCloud == "" {
-		if cfg.DefaultCloud != "" {
-			tenantCreateCloud = cfg.DefaultCloud
-		} else {
-			return fmt.Errorf("--cloud is required (or set default_cloud in ~/.spacectl)")
+	if req.CloudProvider == "" {
+		if cfg.DefaultCloud == "" {
+			return nil, fmt.Errorf("--cloud is required (or set default_cloud in ~/.spacectl)")
 		}
+		req.CloudProvider = cfg.DefaultCloud
+		applied = append(applied, "cloud "+req.CloudProvider)
 	}
 
-	if tenantCreateRegion == "" {
-		if cfg.DefaultRegion != "" {
-			tenantCreateRegion = cfg.DefaultRegion
-		} else {
-			return fmt.Errorf("--region is required (or set default_region in ~/.spacectl)")
+	if req.Region == "" {
+		if cfg.DefaultRegion == "" {
+			return nil, fmt.Errorf("--region is required (or set default_region in ~/.spacectl)")
 		}
+		req.Region = cfg.DefaultRegion
+		applied = append(applied, "region "+req.Region)
 	}
 
-	if tenantCreateCompute == 0 {
+	if req.ComputeQuota == 0 {
 		if cfg.DefaultCompute > 0 {
-			tenantCreateCompute = cfg.DefaultCompute
+			req.ComputeQuota = cfg.DefaultCompute
 		} else {
-			tenantCreateCompute = 2 // Fallback default
+			req.ComputeQuota = 2 // Fallback default
 		}
+		applied = append(applied, fmt.Sprintf("compute %d cores", req.ComputeQuota))
 	}
 
-	if tenantCreateMemory == 0 {
+	if req.MemoryQuotaGB == 0 {
 		if cfg.DefaultMemory > 0 {
-			tenantCreateMemory = cfg.DefaultMemory
+			req.MemoryQuotaGB = cfg.DefaultMemory
 		} else {
-			tenantCreateMemory = 4 // Fallback default
+			req.MemoryQuotaGB = 4 // Fallback default
 		}
+		applied = append(applied, "memory "+units.GiB(req.MemoryQuotaGB).String())
 	}
 
-	// Fetch latest k8s version if not provided
-	if tenantCreateK8sVersion == "" {
-		if !quiet {
-			fmt.Println("Fetching latest Kubernetes version...")
-		}
-		versions, err := tenantAPI.GetAvailableKubernetesVersions()
+	if req.KubernetesVersion == "" {
+		versions, err := tenantAPI.GetAvailableKubernetesVersions(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to fetch Kubernetes versions: %w", err)
+			return nil, fmt.Errorf("failed to fetch Kubernetes versions: %w", err)
 		}
 		if len(versions) == 0 {
-			return fmt.Errorf("no Kubernetes versions available")
+			return nil, fmt.Errorf("no Kubernetes versions available")
 		}
 		// Use the first version (should be the latest)
-		tenantCreateK8sVersion = versions[0].Version
-		if !quiet {
-			fmt.Printf("Using Kubernetes version: %s\n", tenantCreateK8sVersion)
-		}
+		req.KubernetesVersion = versions[0].Version
 	}
+	return applied, nil
+}
 
-	// Prepare request
-	req := models.CreateTenantRequest{
-		Name:              name,
-		CloudProvider:     tenantCreateCloud,
-		Region:            tenantCreateRegion,
-		KubernetesVersion: tenantCreateK8sVersion,
-		ComputeQuota:      tenantCreateCompute,
-		MemoryQuotaGB:     tenantCreateMemory,
-		NamespaceSuffix:   tenantCreateNamespaceSuffix,
+// readTenantManifest reads a file holding a single Tenant manifest, expanding
+// the variables given with --set and --values
+func readTenantManifest(path string) (*manifest.Tenant, error) {
+	values, err := manifestValues()
+	if err != nil {
+		return nil, err
 	}
-
-	// Create tenant
-	tenant, err := tenantAPI.CreateTenant(tenantCreateProject, req)
+	resources, err := manifest.ReadFile(path, values)
 	if err != nil {
-		return fmt.Errorf("failed to create tenant: %w", err)
+		return nil, err
+	}
+	if len(resources) != 1 {
+		return nil, fmt.Errorf("expected exactly one tenant manifest, found %d documents", len(resources))
+	}
+	m, ok := resources[0].(*manifest.Tenant)
+	if !ok {
+		return nil, fmt.Errorf("manifest is not a tenant")
 	}
+	return m, nil
+}
 
-	// Output tenant
-	return formatter.FormatData(tenant)
+// applyTenantSpec fills the tenant create settings not given as flags from a manifest
+func applyTenantSpec(spec manifest.TenantSpec) {
+	if tenantCreateCloud == "" {
+		tenantCreateCloud = spec.CloudProvider
+	}
+	if tenantCreateRegion == "" {
+		tenantCreateRegion = spec.Region
+	}
+	if tenantCreateK8sVersion == "" {
+		tenantCreateK8sVersion = spec.KubernetesVersion
+	}
+	if tenantCreateCompute == 0 {
+		tenantCreateCompute = units.Cores(spec.ComputeQuota)
+	}
+	if tenantCreateMemory == 0 {
+		tenantCreateMemory = units.GiB(spec.MemoryQuotaGB)
+	}
+	if tenantCreateNamespaceSuffix == "" {
+		tenantCreateNamespaceSuffix = spec.NamespaceSuffix
+	}
 }
 
 // tenantGetCmd represents the tenant get command
 var tenantGetCmd = &cobra.Command{
 	Use:   "get",
 	Short: "Get tenant details",
-	Long:  `Get detailed information about a specific tenant.`,
-	Args:  cobra.NoArgs,
-	RunE:  runTenantGet,
+	Long: `Get detailed information about a specific tenant.
+
+With -o terraform-external, the tenant is printed as the flat JSON object of
+strings that Terraform's external data source expects, with two more keys:
+kubeconfig_path, a cached copy of the tenant's kubeconfig that is refreshed when
+older than an hour, and api_server, the Kubernetes API endpoint in it.
+
+Examples:
+  spacectl tenant get --name dev
+  spacectl tenant get --name dev -o terraform-external
+
+  # In Terraform
+  data "external" "tenant" {
+    program = ["spacectl", "tenant", "get", "--name", "dev", "-o", "terraform-external"]
+  }`,
+	Args: cobra.NoArgs,
+	RunE: runTenantGet,
 }
 
 func init() {
@@ -285,78 +515,155 @@ func init() {
 }
 
 var (
-	tenantGetID          string
-	tenantGetName        string
-	tenantGetProjectID   string
-	tenantGetProjectName string
+	tenantGetID   string
+	tenantGetName string
 )
 
 func init() {
 	tenantGetCmd.Flags().StringVar(&tenantGetID, "id", "", "Tenant ID")
 	tenantGetCmd.Flags().StringVar(&tenantGetName, "name", "", "Tenant name")
-	tenantGetCmd.Flags().StringVar(&tenantGetProjectID, "project", "", "Project ID (required if using --name)")
-	tenantGetCmd.Flags().StringVar(&tenantGetProjectName, "project-name", "", "Project name (alternative to --project when using --name)")
 }
 
 func runTenantGet(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	tenantAPI := api.NewTenantAPI(client)
 	// Resolve tenant
 	if tenantGetName != "" && tenantGetID != "" {
 		return fmt.Errorf("only one of --name or --id is allowed")
 	}
-	if tenantGetName != "" {
-		// need project context
-		if tenantGetProjectID != "" && tenantGetProjectName != "" {
-			return fmt.Errorf("only one of --project or --project-name is allowed")
-		}
-		if tenantGetProjectID == "" && tenantGetProjectName != "" {
-			pid, err := resolveProjectID(client, tenantGetProjectName, "", "")
-			if err != nil {
-				return err
-			}
-			tenantGetProjectID = pid
-		}
-		var err error
-		tenantGetID, err = resolveTenantID(client, tenantGetName, "", tenantGetProjectID)
-		if err != nil {
-			return err
-		}
-	} else if tenantGetID == "" {
+	if tenantGetName == "" && tenantGetID == "" && checkInteractive() != nil {
 		return fmt.Errorf("either --name or --id must be provided")
 	}
+	var err error
+	tenantGetID, err = resolveOrPickTenantID(ctx, client, tenantGetName, tenantGetID, contextProjectID())
+	if err != nil {
+		return err
+	}
 
 	// Get tenant
-	tenant, err := tenantAPI.GetTenant(tenantGetID)
+	tenant, err := tenantAPI.GetTenant(ctx, tenantGetID)
 	if err != nil {
 		return fmt.Errorf("failed to get tenant: %w", err)
 	}
 
+	// Terraform gets where to reach the tenant along with its fields
+	if output.Format(outputFmt) == output.FormatTerraformExternal {
+		path, err := getOrFetchKubeconfig(ctx, tenantAPI, tenant.ID, noCache)
+		if err != nil {
+			return fmt.Errorf("failed to get kubeconfig: %w", err)
+		}
+		server, err := kubeconfigServer(path)
+		if err != nil {
+			return err
+		}
+		return formatter.FormatData(tenantExternalData{Tenant: tenant, KubeconfigPath: path, APIServer: server})
+	}
+
+	// Tables note when the tenant's Kubernetes version is near end of life
+	rows := []map[string]interface{}{formatter.TenantRecord(*tenant)}
+	if addVersionSupport(ctx, tenantAPI, rows) {
+		return formatter.FormatRows(rows, output.TenantColumns)
+	}
+
 	// Output tenant
 	return formatter.FormatData(tenant)
 }
 
+// tenantExternalData is a tenant with the location of its Kubernetes API, for
+// -o terraform-external
+type tenantExternalData struct {
+	*models.Tenant
+	KubeconfigPath string `json:"kubeconfig_path"`
+	APIServer      string `json:"api_server"`
+}
+
+// kubeconfigFile is the part of a kubeconfig file spacectl reads
+type kubeconfigFile struct {
+	CurrentContext string `yaml:"current-context"`
+	Contexts       []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Clusters []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server string `yaml:"server"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+}
+
+// readKubeconfig parses a kubeconfig file
+func readKubeconfig(path string) (*kubeconfigFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kubeconfig: %w", err)
+	}
+	var kubeconfig kubeconfigFile
+	if err := yaml.Unmarshal(data, &kubeconfig); err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+	return &kubeconfig, nil
+}
+
+// kubeconfigServer returns the API server of the current context's cluster in
+// a kubeconfig file, or of its first cluster when no context is current
+func kubeconfigServer(path string) (string, error) {
+	kubeconfig, err := readKubeconfig(path)
+	if err != nil {
+		return "", err
+	}
+	if len(kubeconfig.Clusters) == 0 {
+		return "", fmt.Errorf("kubeconfig has no clusters")
+	}
+
+	cluster := kubeconfig.Clusters[0].Name
+	for _, c := range kubeconfig.Contexts {
+		if c.Name == kubeconfig.CurrentContext {
+			cluster = c.Context.Cluster
+		}
+	}
+	for _, c := range kubeconfig.Clusters {
+		if c.Name == cluster {
+			return c.Cluster.Server, nil
+		}
+	}
+	return kubeconfig.Clusters[0].Cluster.Server, nil
+}
+
 // tenantDeleteCmd represents the tenant delete command
 var tenantDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete a tenant",
-	Long:  `Delete a tenant. This action cannot be undone.`,
-	Args:  cobra.NoArgs,
-	RunE:  runTenantDelete,
+	Long: `Delete a tenant. This action cannot be undone.
+
+You must type the tenant name to confirm unless --force or --yes is given.
+
+--ids deletes several tenants of the project at once, given by name or ID. Use
+"--ids -" to read them from stdin, one per line.
+
+Examples:
+  spacectl tenant delete --name dev
+  spacectl tenant delete --ids dev,staging
+  spacectl tenant list -o name | grep dev | spacectl tenant delete --ids - --force`,
+	Args: cobra.NoArgs,
+	RunE: runTenantDelete,
 }
 
 var (
-	tenantDeleteForce       bool
-	tenantDeleteID          string
-	tenantDeleteName        string
-	tenantDeleteProjectID   string
-	tenantDeleteProjectName string
+	tenantDeleteForce bool
+	tenantDeleteID    string
+	tenantDeleteName  string
+	tenantDeleteIDs   []string
 )
 
 func init() {
@@ -364,71 +671,56 @@ func init() {
 	tenantDeleteCmd.Flags().BoolVar(&tenantDeleteForce, "force", false, "Skip confirmation prompt")
 	tenantDeleteCmd.Flags().StringVar(&tenantDeleteID, "id", "", "Tenant ID")
 	tenantDeleteCmd.Flags().StringVar(&tenantDeleteName, "name", "", "Tenant name")
-	tenantDeleteCmd.Flags().StringVar(&tenantDeleteProjectID, "project", "", "Project ID (required if using --name)")
-	tenantDeleteCmd.Flags().StringVar(&tenantDeleteProjectName, "project-name", "", "Project name (alternative to --project when using --name)")
+	tenantDeleteCmd.Flags().StringSliceVar(&tenantDeleteIDs, "ids", nil, "Names or IDs of tenants to delete (comma-separated, or - to read them from stdin)")
+	addConcurrencyFlag(tenantDeleteCmd)
 }
 
 func runTenantDelete(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	tenantAPI := api.NewTenantAPI(client)
 
+	if len(tenantDeleteIDs) > 0 {
+		if tenantDeleteName != "" || tenantDeleteID != "" {
+			return fmt.Errorf("--ids cannot be used with --name or --id")
+		}
+		return deleteTenants(ctx, client, tenantDeleteIDs)
+	}
+
 	// Resolve tenant
 	if tenantDeleteName != "" && tenantDeleteID != "" {
 		return fmt.Errorf("only one of --name or --id is allowed")
 	}
-	if tenantDeleteName != "" {
-		// need project context
-		if tenantDeleteProjectID != "" && tenantDeleteProjectName != "" {
-			return fmt.Errorf("only one of --project or --project-name is allowed")
-		}
-		if tenantDeleteProjectID == "" && tenantDeleteProjectName != "" {
-			pid, err := resolveProjectID(client, tenantDeleteProjectName, "", "")
-			if err != nil {
-				return err
-			}
-			tenantDeleteProjectID = pid
-		}
-		var err error
-		tenantDeleteID, err = resolveTenantID(client, tenantDeleteName, "", tenantDeleteProjectID)
-		if err != nil {
-			return err
-		}
-	} else if tenantDeleteID == "" {
+	if tenantDeleteName == "" && tenantDeleteID == "" && checkInteractive() != nil {
 		return fmt.Errorf("either --name or --id must be provided")
 	}
+	var err error
+	tenantDeleteID, err = resolveOrPickTenantID(ctx, client, tenantDeleteName, tenantDeleteID, contextProjectID())
+	if err != nil {
+		return err
+	}
 
 	// Get tenant details for confirmation
-	tenant, err := tenantAPI.GetTenant(tenantDeleteID)
+	tenant, err := tenantAPI.GetTenant(ctx, tenantDeleteID)
 	if err != nil {
 		return fmt.Errorf("failed to get tenant details: %w", err)
 	}
 
-	// Ask for confirmation unless --force is used
-	if !tenantDeleteForce {
-		fmt.Printf("Are you sure you want to delete tenant '%s' (ID: %s)? This action cannot be undone.\n", tenant.Name, tenantDeleteID)
-		fmt.Print("Type 'yes' to confirm: ")
-
-		reader := bufio.NewReader(os.Stdin)
-		response, err := reader.ReadString('\n')
-		if err != nil {
-			return fmt.Errorf("failed to read input: %w", err)
-		}
-
-		response = strings.TrimSpace(strings.ToLower(response))
-		if response != "yes" {
-			fmt.Println("Deletion cancelled.")
-			return nil
-		}
+	// Ask for confirmation unless --force or --yes is used
+	question := fmt.Sprintf("Are you sure you want to delete tenant '%s' (ID: %s)? This action cannot be undone.", tenant.Name, tenantDeleteID)
+	if ok, err := confirmTyped(question, "the tenant name", tenant.Name, tenantDeleteForce); err != nil || !ok {
+		return err
 	}
 
 	// Delete tenant
-	err = tenantAPI.DeleteTenant(tenantDeleteID)
+	err = tenantAPI.DeleteTenant(ctx, tenantDeleteID)
 	if err != nil {
 		return fmt.Errorf("failed to delete tenant: %w", err)
 	}
@@ -441,6 +733,100 @@ func runTenantDelete(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// deleteTenants deletes the tenants of the selected project given by name or ID,
+// after a single confirmation. It carries on past failures and reports them at the end.
+func deleteTenants(ctx context.Context, client *api.Client, refs []string) error {
+	tenantAPI := api.NewTenantAPI(client)
+
+	refs, err := expandStdinArgs(refs)
+	if err != nil {
+		return err
+	}
+	if len(refs) == 0 {
+		return fmt.Errorf("no tenants given to delete")
+	}
+	projectID, err := requireProjectID()
+	if err != nil {
+		return err
+	}
+	tenants, err := tenantAPI.ListProjectTenants(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to list tenants in project: %w", err)
+	}
+	targets, err := matchTenants(tenants, refs)
+	if err != nil {
+		return err
+	}
+
+	// Ask for confirmation unless --force or --yes is used
+	var question strings.Builder
+	fmt.Fprintf(&question, "Are you sure you want to delete these %d tenants? This action cannot be undone.\n", len(targets))
+	for _, t := range targets {
+		fmt.Fprintf(&question, "  %s (ID: %s)\n", t.Name, t.ID)
+	}
+	if ok, err := confirmTyped(question.String(), "the number of tenants", strconv.Itoa(len(targets)), tenantDeleteForce); err != nil || !ok {
+		return err
+	}
+
+	// Delete --concurrency tenants at a time, reporting in the order given
+	errs := parallel.Map(targets, concurrency, func(t models.Tenant) error {
+		return tenantAPI.DeleteTenant(ctx, t.ID)
+	})
+	failed := 0
+	for i, t := range targets {
+		if err := errs[i]; err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "Error: failed to delete tenant %s: %v\n", t.Name, err)
+			continue
+		}
+		if !quiet {
+			fmt.Printf("Successfully deleted tenant %s\n", t.Name)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to delete %d of %d tenants", failed, len(targets))
+	}
+	return nil
+}
+
+// matchTenants finds the tenant for each name or ID in refs, which may also be
+// a unique ID prefix. Each tenant is returned once, in the order given.
+func matchTenants(tenants []models.Tenant, refs []string) ([]models.Tenant, error) {
+	byID := make(map[string]models.Tenant, len(tenants))
+	ids := make([]string, 0, len(tenants))
+	names := make([]string, 0, len(tenants))
+	for _, t := range tenants {
+		byID[t.ID] = t
+		ids = append(ids, t.ID)
+		names = append(names, t.Name)
+	}
+
+	var matched []models.Tenant
+	seen := make(map[string]bool)
+	for _, ref := range refs {
+		id := ""
+		for _, t := range tenants {
+			if t.Name == ref {
+				id = t.ID
+				break
+			}
+		}
+		if id == "" {
+			var err error
+			if id, err = matchIDPrefix("tenant", ref, ids); errors.Is(err, api.ErrNotFound) {
+				return nil, api.NotFoundError("tenant %q not found in project%s", ref, didYouMean(ref, names))
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		if !seen[id] {
+			seen[id] = true
+			matched = append(matched, byID[id])
+		}
+	}
+	return matched, nil
+}
+
 // tenantStatusCmd represents the tenant status command
 var tenantStatusCmd = &cobra.Command{
 	Use:   "status",
@@ -451,57 +837,43 @@ var tenantStatusCmd = &cobra.Command{
 }
 
 var (
-	tenantStatusID          string
-	tenantStatusName        string
-	tenantStatusProjectID   string
-	tenantStatusProjectName string
+	tenantStatusID   string
+	tenantStatusName string
 )
 
 func init() {
 	tenantCmd.AddCommand(tenantStatusCmd)
 	tenantStatusCmd.Flags().StringVar(&tenantStatusID, "id", "", "Tenant ID")
 	tenantStatusCmd.Flags().StringVar(&tenantStatusName, "name", "", "Tenant name")
-	tenantStatusCmd.Flags().StringVar(&tenantStatusProjectID, "project", "", "Project ID")
-	tenantStatusCmd.Flags().StringVar(&tenantStatusProjectName, "project-name", "", "Project name")
 }
 
 func runTenantStatus(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	tenantAPI := api.NewTenantAPI(client)
 
 	// Resolve tenant
 	if tenantStatusName != "" && tenantStatusID != "" {
 		return fmt.Errorf("only one of --name or --id is allowed")
 	}
-	if tenantStatusName != "" {
-		// need project context
-		if tenantStatusProjectID != "" && tenantStatusProjectName != "" {
-			return fmt.Errorf("only one of --project or --project-name is allowed")
-		}
-		if tenantStatusProjectID == "" && tenantStatusProjectName != "" {
-			pid, err := resolveProjectID(client, tenantStatusProjectName, "", "")
-			if err != nil {
-				return err
-			}
-			tenantStatusProjectID = pid
-		}
-		var err error
-		tenantStatusID, err = resolveTenantID(client, tenantStatusName, "", tenantStatusProjectID)
-		if err != nil {
-			return err
-		}
-	} else if tenantStatusID == "" {
+	if tenantStatusName == "" && tenantStatusID == "" && checkInteractive() != nil {
 		return fmt.Errorf("either --name or --id must be provided")
 	}
+	var err error
+	tenantStatusID, err = resolveOrPickTenantID(ctx, client, tenantStatusName, tenantStatusID, contextProjectID())
+	if err != nil {
+		return err
+	}
 
 	// Get tenant status
-	status, err := tenantAPI.GetTenantStatus(tenantStatusID)
+	status, err := tenantAPI.GetTenantStatus(ctx, tenantStatusID)
 	if err != nil {
 		return fmt.Errorf("failed to get tenant status: %w", err)
 	}
@@ -512,37 +884,129 @@ func runTenantStatus(cmd *cobra.Command, args []string) error {
 
 // tenantKubeconfigCmd represents the tenant kubeconfig command
 var tenantKubeconfigCmd = &cobra.Command{
-	Use:   "kubeconfig <id>",
+	Use:   "kubeconfig (<id> | --name <name>)",
 	Short: "Download tenant kubeconfig",
-	Long:  `Download the kubeconfig file for a tenant.`,
-	Args:  cobra.ExactArgs(1),
-	RunE:  runTenantKubeconfig,
+	Long: `Download the kubeconfig file for a tenant, given by ID or by --name in the
+project given with --project or --project-name, or the default project.
+
+--format json converts the kubeconfig to JSON, --minify keeps only its current
+context with that context's cluster and user, and --context-name renames the
+current context, so the output can be passed straight to other tools. Without
+these flags the kubeconfig is written exactly as the API returns it.
+
+The kubeconfig grants admin access to the whole cluster. --role view asks for a
+read-only credential bound to one namespace instead, the one given with
+--namespace or "default", to share with auditors and dashboards.
+
+Project admins can hand access to another member of the project with
+--for-user: the kubeconfig is issued for that member, by email or user ID, and
+carries the member's own identity and access rather than yours.
+
+Examples:
+  spacectl tenant kubeconfig abc123 --output-file ~/.kube/dev.yaml
+  spacectl tenant kubeconfig abc123 --role view --namespace web --output-file web-view.yaml
+  spacectl tenant kubeconfig --name dev --for-user alice@example.com --output-file alice-dev.yaml
+  spacectl tenant kubeconfig abc123 --minify --context-name acme-dev
+  spacectl tenant kubeconfig abc123 --format json | jq -r '.clusters[0].cluster.server'`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runTenantKubeconfig,
 }
 
-var tenantKubeconfigOutputFile string
+var (
+	tenantKubeconfigOutputFile  string
+	tenantKubeconfigFormat      string
+	tenantKubeconfigMinify      bool
+	tenantKubeconfigContextName string
+	tenantKubeconfigRole        string
+	tenantKubeconfigNamespace   string
+	tenantKubeconfigName        string
+	tenantKubeconfigForUser     string
+)
+
+// kubeconfigRoles are the roles --role accepts
+var kubeconfigRoles = []string{"admin", "view"}
 
 func init() {
 	tenantCmd.AddCommand(tenantKubeconfigCmd)
 	tenantKubeconfigCmd.Flags().StringVar(&tenantKubeconfigOutputFile, "output-file", "", "Output file path (default: stdout)")
+	tenantKubeconfigCmd.Flags().StringVar(&tenantKubeconfigFormat, "format", "yaml", "Kubeconfig format: yaml or json")
+	tenantKubeconfigCmd.Flags().BoolVar(&tenantKubeconfigMinify, "minify", false, "Keep only the current context and its cluster and user")
+	tenantKubeconfigCmd.Flags().StringVar(&tenantKubeconfigContextName, "context-name", "", "Rename the current context")
+	tenantKubeconfigCmd.Flags().StringVar(&tenantKubeconfigRole, "role", "admin", "Access the kubeconfig grants: admin, or view for read-only access to one namespace")
+	tenantKubeconfigCmd.Flags().StringVar(&tenantKubeconfigNamespace, "namespace", "", "Namespace a --role view kubeconfig is bound to (default \"default\")")
+	tenantKubeconfigCmd.Flags().StringVar(&tenantKubeconfigName, "name", "", "Tenant name (alternative to the ID)")
+	tenantKubeconfigCmd.Flags().StringVar(&tenantKubeconfigForUser, "for-user", "", "Issue the kubeconfig for another project member, by email or user ID (project admins only)")
+	tenantKubeconfigCmd.RegisterFlagCompletionFunc("role", cobra.FixedCompletions(kubeconfigRoles, cobra.ShellCompDirectiveNoFileComp))
+	tenantKubeconfigCmd.MarkFlagsMutuallyExclusive("for-user", "role")
+	tenantKubeconfigCmd.MarkFlagsMutuallyExclusive("for-user", "namespace")
 }
 
 func runTenantKubeconfig(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
-	id := args[0]
+	if len(args) == 1 && tenantKubeconfigName != "" {
+		return fmt.Errorf("only one of <id> or --name is allowed")
+	}
+	if len(args) == 0 && tenantKubeconfigName == "" && checkInteractive() != nil {
+		return fmt.Errorf("either <id> or --name must be provided")
+	}
+	if tenantKubeconfigFormat != "yaml" && tenantKubeconfigFormat != "json" {
+		return fmt.Errorf("invalid --format %q: use yaml or json", tenantKubeconfigFormat)
+	}
+	// The default admin kubeconfig is for the whole cluster, so only view
+	// kubeconfigs are bound to a namespace
+	var opts api.KubeconfigOptions
+	switch {
+	case !slices.Contains(kubeconfigRoles, tenantKubeconfigRole):
+		return fmt.Errorf("invalid --role %q: use one of %s", tenantKubeconfigRole, strings.Join(kubeconfigRoles, ", "))
+	case tenantKubeconfigRole == "view":
+		opts = api.KubeconfigOptions{Role: tenantKubeconfigRole, Namespace: tenantKubeconfigNamespace}
+	case tenantKubeconfigNamespace != "":
+		return fmt.Errorf("--namespace is only allowed with --role view")
+	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	tenantAPI := api.NewTenantAPI(client)
 
+	// Resolve tenant; an ID is used as given
+	var id string
+	if len(args) == 1 {
+		id = args[0]
+	} else {
+		var err error
+		if id, err = resolveOrPickTenantID(ctx, client, tenantKubeconfigName, "", contextProjectID()); err != nil {
+			return err
+		}
+	}
+
+	// Resolve the member to issue the kubeconfig for
+	if tenantKubeconfigForUser != "" {
+		userID, err := kubeconfigUserID(ctx, client, tenantKubeconfigForUser)
+		if err != nil {
+			return err
+		}
+		opts.UserID = userID
+	}
+
 	// Get kubeconfig
-	kubeconfig, err := tenantAPI.GetTenantKubeconfig(id)
+	kubeconfig, err := tenantAPI.GetScopedTenantKubeconfig(ctx, id, opts)
+	if errors.Is(err, api.ErrForbidden) && opts.UserID != "" {
+		return fmt.Errorf("failed to get kubeconfig for %s: only admins of the tenant's project can issue kubeconfigs for other members: %w", tenantKubeconfigForUser, err)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get kubeconfig: %w", err)
 	}
+	if tenantKubeconfigFormat != "yaml" || tenantKubeconfigMinify || tenantKubeconfigContextName != "" {
+		if kubeconfig, err = rewriteKubeconfig(kubeconfig); err != nil {
+			return err
+		}
+	}
 
 	// Output kubeconfig
 	if tenantKubeconfigOutputFile != "" {
@@ -551,7 +1015,11 @@ func runTenantKubeconfig(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("failed to write kubeconfig file: %w", err)
 		}
 		if !quiet {
-			fmt.Printf("Kubeconfig saved to %s\n", tenantKubeconfigOutputFile)
+			if tenantKubeconfigForUser != "" {
+				fmt.Printf("Kubeconfig for %s saved to %s\n", tenantKubeconfigForUser, tenantKubeconfigOutputFile)
+			} else {
+				fmt.Printf("Kubeconfig saved to %s\n", tenantKubeconfigOutputFile)
+			}
 		}
 	} else {
 		fmt.Print(kubeconfig)
@@ -560,44 +1028,252 @@ func runTenantKubeconfig(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// kubeconfigUserID returns the ID of the user given to --for-user by email or ID
+func kubeconfigUserID(ctx context.Context, client *api.Client, user string) (string, error) {
+	if !strings.Contains(user, "@") {
+		return user, nil
+	}
+	found, err := api.NewUserAPI(client).LookupUserByEmail(ctx, user)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up user: %w", err)
+	}
+	if found == nil {
+		return "", api.NotFoundError("no account found for %s", user)
+	}
+	return found.ID, nil
+}
+
+// rewriteKubeconfig applies --minify and --context-name to a kubeconfig and
+// converts it to the --format
+func rewriteKubeconfig(data string) (string, error) {
+	config, err := kubeconfig.Parse([]byte(data))
+	if err != nil {
+		return "", err
+	}
+	if tenantKubeconfigMinify {
+		if err := config.Minify(); err != nil {
+			return "", fmt.Errorf("cannot minify kubeconfig: %w", err)
+		}
+	}
+	if tenantKubeconfigContextName != "" {
+		if err := config.RenameContext(tenantKubeconfigContextName); err != nil {
+			return "", fmt.Errorf("cannot rename kubeconfig context: %w", err)
+		}
+	}
+
+	var out []byte
+	if tenantKubeconfigFormat == "json" {
+		out, err = config.JSON()
+	} else {
+		out, err = config.YAML()
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to encode kubeconfig: %w", err)
+	}
+	return string(out), nil
+}
+
 // tenantLocationsCmd represents the tenant locations command
 var tenantLocationsCmd = &cobra.Command{
 	Use:   "locations",
 	Short: "List available locations",
-	Long:  `List available cloud provider and region combinations.`,
-	RunE:  runTenantLocations,
+	Long: `List the cloud providers and regions tenants can be created in, one region per
+row with its zones. Use --cloud and --region to only list the regions of a
+cloud provider, or the zones of a region, and --zones for one row per zone.
+Structured formats get one entry per zone.
+
+Examples:
+  spacectl tenant locations
+  spacectl tenant locations --cloud eks
+  spacectl tenant locations --cloud eks --region eu-west-1 --zones
+  spacectl tenant locations --zones -o json`,
+	Args: cobra.NoArgs,
+	RunE: runTenantLocations,
 }
 
+var (
+	tenantLocationsCloud  string
+	tenantLocationsRegion string
+	tenantLocationsZones  bool
+)
+
 func init() {
 	tenantCmd.AddCommand(tenantLocationsCmd)
+	tenantLocationsCmd.Flags().StringVar(&tenantLocationsCloud, "cloud", "", "Only list locations of this cloud provider")
+	tenantLocationsCmd.Flags().StringVar(&tenantLocationsRegion, "region", "", "Only list locations in this region")
+	tenantLocationsCmd.Flags().BoolVar(&tenantLocationsZones, "zones", false, "List one row per zone")
+	tenantLocationsCmd.RegisterFlagCompletionFunc("cloud", completeClouds)
+	tenantLocationsCmd.RegisterFlagCompletionFunc("region", completeRegions)
 }
 
 func runTenantLocations(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	tenantAPI := api.NewTenantAPI(client)
 
 	// Get locations
-	locations, err := tenantAPI.GetAvailableLocations()
+	locations, err := fetchLocations(ctx, tenantAPI, tenantLocationsCloud, tenantLocationsRegion)
+	if errors.Is(err, api.ErrNotFound) {
+		return err
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get locations: %w", err)
 	}
 
-	// Output locations
-	return formatter.FormatData(locations)
+	// Structured formats and --zones get one entry per zone
+	if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML || tenantLocationsZones {
+		return formatter.FormatData(locations)
+	}
+
+	// Tables get one row per region, in the order the API lists them
+	var rows []map[string]interface{}
+	zones := make(map[string][]string)
+	for _, l := range locations {
+		key := l.CloudProvider + "/" + l.Region
+		if _, ok := zones[key]; !ok {
+			zones[key] = []string{}
+			rows = append(rows, map[string]interface{}{
+				"cloud_provider": l.CloudProvider,
+				"region":         l.Region,
+			})
+		}
+		if l.Zone != "" {
+			zones[key] = append(zones[key], l.Zone)
+		}
+	}
+	for _, row := range rows {
+		row["zones"] = strings.Join(zones[row["cloud_provider"].(string)+"/"+row["region"].(string)], ", ")
+	}
+	return formatter.FormatRows(rows, []string{"cloud_provider", "region", "zones"})
+}
+
+// fetchLocations lists the cloud providers, regions, and zones tenants can be
+// created in, keeping those of cloud and region when given. Servers without the
+// locations endpoint are asked for the clouds, the regions of each cloud, and
+// the zones of each region instead.
+func fetchLocations(ctx context.Context, tenantAPI *api.TenantAPI, cloud, region string) ([]models.Location, error) {
+	locations, err := tenantAPI.GetAvailableLocations(ctx)
+	if err == nil {
+		return filterLocations(locations, cloud, region)
+	}
+	if !errors.Is(err, api.ErrNotFound) {
+		return nil, err
+	}
+
+	clouds, err := tenantAPI.GetAvailableClouds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if clouds, err = matchLocation("cloud provider", cloud, clouds); err != nil {
+		return nil, err
+	}
+	var regions []models.Location
+	var regionNames []string
+	for _, c := range clouds {
+		names, err := tenantAPI.GetAvailableRegions(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			regions = append(regions, models.Location{CloudProvider: c, Region: name})
+			if !slices.Contains(regionNames, name) {
+				regionNames = append(regionNames, name)
+			}
+		}
+	}
+	if _, err := matchLocation("region", region, regionNames); err != nil {
+		return nil, err
+	}
+
+	locations = []models.Location{}
+	for _, r := range regions {
+		if region != "" && !strings.EqualFold(r.Region, region) {
+			continue
+		}
+		zones, err := tenantAPI.GetAvailableZones(ctx, r.CloudProvider, r.Region)
+		if err != nil {
+			return nil, err
+		}
+		if len(zones) == 0 {
+			locations = append(locations, r)
+		}
+		for _, zone := range zones {
+			locations = append(locations, models.Location{CloudProvider: r.CloudProvider, Region: r.Region, Zone: zone})
+		}
+	}
+	return locations, nil
+}
+
+// filterLocations keeps the locations of a cloud provider and region, either of
+// which may be empty to keep all
+func filterLocations(locations []models.Location, cloud, region string) ([]models.Location, error) {
+	var clouds []string
+	for _, l := range locations {
+		if !slices.Contains(clouds, l.CloudProvider) {
+			clouds = append(clouds, l.CloudProvider)
+		}
+	}
+	if _, err := matchLocation("cloud provider", cloud, clouds); err != nil {
+		return nil, err
+	}
+
+	var regions []string
+	var inCloud []models.Location
+	for _, l := range locations {
+		if cloud == "" || strings.EqualFold(l.CloudProvider, cloud) {
+			inCloud = append(inCloud, l)
+			if !slices.Contains(regions, l.Region) {
+				regions = append(regions, l.Region)
+			}
+		}
+	}
+	if _, err := matchLocation("region", region, regions); err != nil {
+		return nil, err
+	}
+
+	matched := []models.Location{}
+	for _, l := range inCloud {
+		if region == "" || strings.EqualFold(l.Region, region) {
+			matched = append(matched, l)
+		}
+	}
+	return matched, nil
+}
+
+// matchLocation returns the known cloud providers or regions that name selects,
+// or all of them when name is empty. An unknown name is an error listing the
+// known ones.
+func matchLocation(kind, name string, known []string) ([]string, error) {
+	if name == "" {
+		return known, nil
+	}
+	var matched []string
+	for _, k := range known {
+		if strings.EqualFold(k, name) {
+			matched = append(matched, k)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, api.NotFoundError("unknown %s %q%s (available: %s)", kind, name, didYouMean(name, known), strings.Join(known, ", "))
+	}
+	return matched, nil
 }
 
 // tenantK8sVersionsCmd represents the tenant k8s-versions command
 var tenantK8sVersionsCmd = &cobra.Command{
 	Use:   "k8s-versions",
 	Short: "List available Kubernetes versions",
-	Long:  `List available Kubernetes versions for tenant creation.`,
-	RunE:  runTenantK8sVersions,
+	Long: `List available Kubernetes versions for tenant creation, with the date each
+one reaches end of life when known. Versions that are deprecated, or within 90
+days of their end of life, are marked in the SUPPORT column.`,
+	RunE: runTenantK8sVersions,
 }
 
 func init() {
@@ -605,23 +1281,85 @@ func init() {
 }
 
 func runTenantK8sVersions(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	tenantAPI := api.NewTenantAPI(client)
 
 	// Get Kubernetes versions
-	versions, err := tenantAPI.GetAvailableKubernetesVersions()
+	versions, err := tenantAPI.GetAvailableKubernetesVersions(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get Kubernetes versions: %w", err)
 	}
 
-	// Output versions
-	return formatter.FormatData(versions)
+	// Structured formats get the versions with all their fields
+	if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML {
+		return formatter.FormatData(versions)
+	}
+	now := time.Now()
+	rows := make([]map[string]interface{}, 0, len(versions))
+	for _, v := range versions {
+		rows = append(rows, map[string]interface{}{
+			"version":     v.Version,
+			"is_default":  v.IsDefault,
+			"end_of_life": v.EndOfLife,
+			"support":     v.Support(now),
+		})
+	}
+	return formatter.FormatRows(rows, []string{"version", "is_default", "end_of_life", "support"})
+}
+
+// kubernetesVersionSupport returns the support of the available Kubernetes
+// versions that are deprecated or at or near end of life, by version. Versions
+// that cannot be fetched are left out, so listings never fail over a warning.
+func kubernetesVersionSupport(ctx context.Context, tenantAPI *api.TenantAPI) map[string]string {
+	versions, err := tenantAPI.GetAvailableKubernetesVersions(ctx)
+	if err != nil {
+		return nil
+	}
+	now := time.Now()
+	support := make(map[string]string)
+	for _, v := range versions {
+		if s := v.Support(now); s != "" {
+			support[v.Version] = s
+		}
+	}
+	return support
+}
+
+// addVersionSupport adds a version_support column to table rows of tenants
+// when any of them runs a Kubernetes version that is deprecated or at or near
+// end of life, and reports whether it did
+func addVersionSupport(ctx context.Context, tenantAPI *api.TenantAPI, rows []map[string]interface{}) bool {
+	switch output.Format(outputFmt) {
+	case output.FormatTable, output.FormatWide, output.FormatCSV:
+	default:
+		return false
+	}
+	if len(rows) == 0 {
+		return false
+	}
+	support := kubernetesVersionSupport(ctx, tenantAPI)
+	shown := false
+	for _, row := range rows {
+		if version, ok := row["kubernetes_version"].(string); ok && support[version] != "" {
+			shown = true
+		}
+	}
+	if !shown {
+		return false
+	}
+	for _, row := range rows {
+		version, _ := row["kubernetes_version"].(string)
+		row["version_support"] = support[version]
+	}
+	return true
 }
 
 // tenantKubectlCmd represents the tenant kubectl command
@@ -631,63 +1369,52 @@ var tenantKubectlCmd = &cobra.Command{
 	Long: `Execute kubectl commands on a tenant using its kubeconfig.
 The kubeconfig is automatically retrieved and cached for performance.
 
+Interactive commands, such as 'exec -it', 'attach -it', 'run -it', and 'edit',
+run in a pseudo-terminal that follows the size of your terminal, so shells and
+editors behave as they do with kubectl itself.
+
 Examples:
   spacectl tenant kubectl --name my-tenant --project my-project -- get pods
+  spacectl tenant kubectl --name my-tenant -- exec -it deploy/web -- sh
   spacectl tenant kubectl --id abc123 -- get nodes
   spacectl tenant kubectl --name my-tenant --project my-project -- apply -f deployment.yaml`,
-	RunE:                   runTenantKubectl,
-	DisableFlagsInUseLine:  true,
-	DisableFlagParsing:     false,
-	FParseErrWhitelist:     cobra.FParseErrWhitelist{UnknownFlags: true},
+	RunE:                  runTenantKubectl,
+	DisableFlagsInUseLine: true,
+	DisableFlagParsing:    false,
+	FParseErrWhitelist:    cobra.FParseErrWhitelist{UnknownFlags: true},
 }
 
 var (
-	tenantKubectlName      string
-	tenantKubectlID        string
-	tenantKubectlProjectID string
-	tenantKubectlProjectName string
-	tenantKubectlNoCache   bool
+	tenantKubectlName    string
+	tenantKubectlID      string
+	tenantKubectlNoCache bool
 )
 
 func init() {
 	tenantCmd.AddCommand(tenantKubectlCmd)
 	tenantKubectlCmd.Flags().StringVar(&tenantKubectlName, "name", "", "Tenant name")
 	tenantKubectlCmd.Flags().StringVar(&tenantKubectlID, "id", "", "Tenant ID")
-	tenantKubectlCmd.Flags().StringVar(&tenantKubectlProjectID, "project", "", "Project ID (required if using --name)")
-	tenantKubectlCmd.Flags().StringVar(&tenantKubectlProjectName, "project-name", "", "Project name (alternative to --project)")
 	tenantKubectlCmd.Flags().BoolVar(&tenantKubectlNoCache, "no-cache", false, "Skip cache and fetch fresh kubeconfig")
 }
 
 func runTenantKubectl(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
-	// Parse arguments to find the separator "--"
-	var kubectlArgs []string
-	foundSeparator := false
-
-	for i, arg := range args {
-		if arg == "--" {
-			foundSeparator = true
-			if i+1 < len(args) {
-				kubectlArgs = args[i+1:]
-			}
-			break
-		}
-	}
-
-	if !foundSeparator {
-		kubectlArgs = args
-	}
+	// Cobra has already removed the separator "--", so a later one, as in
+	// 'exec -it pod -- sh', belongs to kubectl
+	kubectlArgs := args
 
 	if len(kubectlArgs) == 0 {
 		return fmt.Errorf("no kubectl command provided. Usage: spacectl tenant kubectl [flags] -- <kubectl-command>")
 	}
 
 	// Create API client
-	client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	tenantAPI := api.NewTenantAPI(client)
 
 	// Resolve tenant ID
@@ -698,34 +1425,16 @@ func runTenantKubectl(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("only one of --name or --id is allowed")
 	}
 
-	if tenantKubectlName != "" {
-		// Need project context for name resolution
-		if tenantKubectlProjectID != "" && tenantKubectlProjectName != "" {
-			return fmt.Errorf("only one of --project or --project-name is allowed")
-		}
-		if tenantKubectlProjectID == "" && tenantKubectlProjectName != "" {
-			pid, err := resolveProjectID(client, tenantKubectlProjectName, "", "")
-			if err != nil {
-				return err
-			}
-			tenantKubectlProjectID = pid
-		}
-		if tenantKubectlProjectID == "" {
-			return fmt.Errorf("--project or --project-name is required when using --name")
-		}
-
-		tenantID, err = resolveTenantID(client, tenantKubectlName, "", tenantKubectlProjectID)
-		if err != nil {
-			return err
-		}
-	} else if tenantKubectlID != "" {
-		tenantID = tenantKubectlID
-	} else {
+	if tenantKubectlName == "" && tenantKubectlID == "" && checkInteractive() != nil {
 		return fmt.Errorf("either --name or --id must be provided")
 	}
+	tenantID, err = resolveOrPickTenantID(ctx, client, tenantKubectlName, tenantKubectlID, contextProjectID())
+	if err != nil {
+		return err
+	}
 
 	// Get or retrieve kubeconfig
-	kubeconfigPath, err := getOrFetchKubeconfig(tenantAPI, tenantID, tenantKubectlNoCache)
+	kubeconfigPath, err := getOrFetchKubeconfig(ctx, tenantAPI, tenantID, tenantKubectlNoCache)
 	if err != nil {
 		return fmt.Errorf("failed to get kubeconfig: %w", err)
 	}
@@ -733,11 +1442,20 @@ func runTenantKubectl(cmd *cobra.Command, args []string) error {
 	// Execute kubectl with the kubeconfig
 	kubectlCmd := exec.Command("kubectl", kubectlArgs...)
 	kubectlCmd.Env = append(os.Environ(), fmt.Sprintf("KUBECONFIG=%s", kubeconfigPath))
-	kubectlCmd.Stdout = os.Stdout
-	kubectlCmd.Stderr = os.Stderr
-	kubectlCmd.Stdin = os.Stdin
 
-	if err := kubectlCmd.Run(); err != nil {
+	// Interactive sessions get a pseudo-terminal of their own so that raw mode,
+	// line editing, and window resizes reach the remote shell or the editor
+	err = pty.ErrUnsupported
+	if kubectlNeedsTTY(kubectlArgs) && stdinIsTerminal() && term.IsTerminal(int(os.Stdout.Fd())) {
+		err = pty.Run(kubectlCmd)
+	}
+	if errors.Is(err, pty.ErrUnsupported) {
+		kubectlCmd.Stdout = os.Stdout
+		kubectlCmd.Stderr = os.Stderr
+		kubectlCmd.Stdin = os.Stdin
+		err = kubectlCmd.Run()
+	}
+	if err != nil {
 		if exitErr, ok := err.(*exec.ExitError); ok {
 			os.Exit(exitErr.ExitCode())
 		}
@@ -747,8 +1465,28 @@ func runTenantKubectl(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// kubectlNeedsTTY reports whether kubectl arguments start an interactive
+// session, i.e. exec, attach, run, or debug with -i or -t, or edit
+func kubectlNeedsTTY(args []string) bool {
+	interactive, tty := false, false
+	for _, arg := range args {
+		switch arg {
+		case "--":
+			// The rest is the command run in the container
+			return interactive && tty
+		case "edit":
+			return true
+		case "exec", "attach", "run", "debug":
+			interactive = true
+		case "-i", "-t", "-it", "-ti", "--stdin", "--tty", "--stdin=true", "--tty=true":
+			tty = true
+		}
+	}
+	return interactive && tty
+}
+
 // getOrFetchKubeconfig retrieves the kubeconfig from cache or fetches it from the API
-func getOrFetchKubeconfig(tenantAPI *api.TenantAPI, tenantID string, noCache bool) (string, error) {
+func getOrFetchKubeconfig(ctx context.Context, tenantAPI *api.TenantAPI, tenantID string, noCache bool) (string, error) {
 	// Create cache directory
 	cacheDir := filepath.Join(os.TempDir(), "spacectl-kubeconfigs")
 	if err := os.MkdirAll(cacheDir, 0700); err != nil {
@@ -782,7 +1520,7 @@ func getOrFetchKubeconfig(tenantAPI *api.TenantAPI, tenantID string, noCache boo
 		fmt.Fprintf(os.Stderr, "Fetching kubeconfig for tenant %s...\n", tenantID)
 	}
 
-	kubeconfig, err := tenantAPI.GetTenantKubeconfig(tenantID)
+	kubeconfig, err := tenantAPI.GetTenantKubeconfig(ctx, tenantID)
 	if err != nil {
 		return "", err
 	}
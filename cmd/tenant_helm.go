@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"spacectl/internal/api"
+
+	"github.com/spf13/cobra"
+)
+
+// tenantHelmCmd represents the tenant helm command
+var tenantHelmCmd = &cobra.Command{
+	Use:   "helm (--name <name> | --id <id>) -- <helm args>",
+	Short: "Run helm against a tenant",
+	Long: `Run helm with a tenant's kubeconfig, like 'spacectl tenant kubectl'. The
+kubeconfig is retrieved and cached for an hour.
+
+helm is pointed at the tenant's context with --kube-context, so a
+HELM_KUBECONTEXT in your environment does not redirect it to another cluster.
+With --merge-kubeconfig, your own kubeconfig files (from $KUBECONFIG, or
+~/.kube/config) are kept after the tenant's, for charts and plugins that use
+other contexts; pass --kube-context in the helm arguments to pick one of them.
+
+Examples:
+  spacectl tenant helm --name dev -- install myapp ./chart
+  spacectl tenant helm --name dev -- upgrade --install myapp ./chart -f values.yaml
+  spacectl tenant helm --name dev -- list --all-namespaces
+  spacectl tenant helm --name dev --merge-kubeconfig -- install myapp ./chart`,
+	Args: cobra.ArbitraryArgs,
+	RunE: runTenantHelm,
+}
+
+var (
+	tenantHelmName            string
+	tenantHelmID              string
+	tenantHelmMergeKubeconfig bool
+)
+
+func init() {
+	tenantCmd.AddCommand(tenantHelmCmd)
+	tenantHelmCmd.Flags().StringVar(&tenantHelmName, "name", "", "Tenant name")
+	tenantHelmCmd.Flags().StringVar(&tenantHelmID, "id", "", "Tenant ID")
+	tenantHelmCmd.Flags().BoolVar(&tenantHelmMergeKubeconfig, "merge-kubeconfig", false, "Keep your own kubeconfig files after the tenant's")
+}
+
+func runTenantHelm(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("no helm command provided. Usage: spacectl tenant helm --name <name> -- <helm-command>")
+	}
+
+	kubeconfigPath, err := tenantKubeconfigPath(ctx, tenantHelmName, tenantHelmID)
+	if err != nil {
+		return err
+	}
+	kubeconfig, err := readKubeconfig(kubeconfigPath)
+	if err != nil {
+		return err
+	}
+
+	kubeconfigEnv := kubeconfigPath
+	if tenantHelmMergeKubeconfig {
+		kubeconfigEnv = strings.Join(append([]string{kubeconfigPath}, userKubeconfigs()...), string(os.PathListSeparator))
+	}
+
+	// Point helm at the tenant unless the arguments choose a context
+	helmArgs := args
+	if kubeContext, ok := helmKubeContext(args); ok {
+		if !tenantHelmMergeKubeconfig && !kubeconfig.hasContext(kubeContext) {
+			return fmt.Errorf("context %q is not in the tenant's kubeconfig; pass --merge-kubeconfig to use your own contexts", kubeContext)
+		}
+	} else if kubeconfig.CurrentContext != "" {
+		helmArgs = append([]string{"--kube-context", kubeconfig.CurrentContext}, args...)
+	}
+
+	// helm's own failures are not usage errors
+	cmd.SilenceUsage = true
+	return runTenantTool("helm", helmArgs, "KUBECONFIG="+kubeconfigEnv)
+}
+
+// tenantKubeconfigPath resolves a tenant given by --name or --id and returns the
+// path of its cached kubeconfig, fetching it when the cache is stale
+func tenantKubeconfigPath(ctx context.Context, name, id string) (string, error) {
+	if name != "" && id != "" {
+		return "", fmt.Errorf("only one of --name or --id is allowed")
+	}
+	if name == "" && id == "" && checkInteractive() != nil {
+		return "", fmt.Errorf("either --name or --id must be provided")
+	}
+
+	// Create API client
+	client := apiClient()
+	tenantID, err := resolveOrPickTenantID(ctx, client, name, id, contextProjectID())
+	if err != nil {
+		return "", err
+	}
+	path, err := getOrFetchKubeconfig(ctx, api.NewTenantAPI(client), tenantID, noCache)
+	if err != nil {
+		return "", fmt.Errorf("failed to get kubeconfig: %w", err)
+	}
+	return path, nil
+}
+
+// runTenantTool runs a Kubernetes tool attached to the terminal with extra
+// environment variables, exiting with the tool's exit code when it fails
+func runTenantTool(tool string, args []string, env ...string) error {
+	toolCmd := exec.Command(tool, args...)
+	toolCmd.Env = append(os.Environ(), env...)
+	toolCmd.Stdout = os.Stdout
+	toolCmd.Stderr = os.Stderr
+	toolCmd.Stdin = os.Stdin
+
+	if err := toolCmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return exitCodeError{code: exitErr.ExitCode()}
+		}
+		return fmt.Errorf("failed to execute %s: %w", tool, err)
+	}
+	return nil
+}
+
+// userKubeconfigs returns the kubeconfig files kubectl would use without spacectl
+func userKubeconfigs() []string {
+	if env := os.Getenv("KUBECONFIG"); env != "" {
+		return filepath.SplitList(env)
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+	path := filepath.Join(home, ".kube", "config")
+	if _, err := os.Stat(path); err != nil {
+		return nil
+	}
+	return []string{path}
+}
+
+// helmKubeContext returns the value of --kube-context in helm arguments
+func helmKubeContext(args []string) (string, bool) {
+	for i, arg := range args {
+		if arg == "--kube-context" && i+1 < len(args) {
+			return args[i+1], true
+		}
+		if value, ok := strings.CutPrefix(arg, "--kube-context="); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// hasContext reports whether the kubeconfig defines a context
+func (k *kubeconfigFile) hasContext(name string) bool {
+	for _, c := range k.Contexts {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
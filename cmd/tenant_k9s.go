@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+
+	"github.com/spf13/cobra"
+)
+
+// tenantK9sCmd represents the tenant k9s command
+var tenantK9sCmd = &cobra.Command{
+	Use:   "k9s (--name <name> | --id <id>) [-- <k9s args>]",
+	Short: "Open k9s on a tenant",
+	Long: `Launch the k9s terminal UI with a tenant's kubeconfig. The kubeconfig is
+retrieved and cached for an hour, like for 'spacectl tenant kubectl'. Arguments
+after -- are passed to k9s.
+
+Examples:
+  spacectl tenant k9s --name dev
+  spacectl tenant k9s --name dev -- --namespace web --command pods
+  spacectl tenant k9s --id abc123 -- --readonly`,
+	Args: cobra.ArbitraryArgs,
+	RunE: runTenantK9s,
+}
+
+var (
+	tenantK9sName string
+	tenantK9sID   string
+)
+
+func init() {
+	tenantCmd.AddCommand(tenantK9sCmd)
+	tenantK9sCmd.Flags().StringVar(&tenantK9sName, "name", "", "Tenant name")
+	tenantK9sCmd.Flags().StringVar(&tenantK9sID, "id", "", "Tenant ID")
+}
+
+func runTenantK9s(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Fail before fetching anything when k9s is missing
+	if _, err := exec.LookPath("k9s"); err != nil {
+		return fmt.Errorf("k9s was not found in your PATH. Install it with %s, or see https://k9scli.io/topics/install/", k9sInstallHint())
+	}
+
+	kubeconfigPath, err := tenantKubeconfigPath(ctx, tenantK9sName, tenantK9sID)
+	if err != nil {
+		return err
+	}
+
+	// k9s's own failures are not usage errors
+	cmd.SilenceUsage = true
+	return runTenantTool("k9s", args, "KUBECONFIG="+kubeconfigPath)
+}
+
+// k9sInstallHint suggests how to install k9s on this platform
+func k9sInstallHint() string {
+	switch runtime.GOOS {
+	case "darwin":
+		return "'brew install derailed/k9s/k9s'"
+	case "windows":
+		return "'winget install k9s' or 'scoop install k9s'"
+	default:
+		return "'brew install derailed/k9s/k9s' or 'snap install k9s --devmode'"
+	}
+}
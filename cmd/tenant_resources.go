@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"spacectl/internal/kube"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// tenantNodesCmd represents the tenant nodes command
+var tenantNodesCmd = &cobra.Command{
+	Use:   "nodes (--name <name> | --id <id>)",
+	Short: "List a tenant's nodes",
+	Long: `List the nodes of a tenant's cluster with their status, roles, age, and
+kubelet version. Like 'tenant pods' and 'tenant ns', it talks to the tenant's
+Kubernetes API directly with its kubeconfig, so it works without kubectl.
+Kubeconfig users authenticate as they do with kubectl, including with exec and
+auth-provider plugins.
+
+Examples:
+  spacectl tenant nodes --name dev
+  spacectl tenant nodes --id abc123 -o json`,
+	Args: cobra.NoArgs,
+	RunE: runTenantNodes,
+}
+
+// tenantPodsCmd represents the tenant pods command
+var tenantPodsCmd = &cobra.Command{
+	Use:   "pods (--name <name> | --id <id>)",
+	Short: "List a tenant's pods",
+	Long: `List the pods of a namespace in a tenant's cluster with their readiness,
+status, restarts, and age, without kubectl. The namespace is the one given with
+--namespace, or else the kubeconfig's namespace, or "default".
+
+Examples:
+  spacectl tenant pods --name dev
+  spacectl tenant pods --name dev --namespace kube-system
+  spacectl tenant pods --name dev -A`,
+	Args: cobra.NoArgs,
+	RunE: runTenantPods,
+}
+
+// tenantNamespacesCmd represents the tenant ns command
+var tenantNamespacesCmd = &cobra.Command{
+	Use:     "ns (--name <name> | --id <id>)",
+	Aliases: []string{"namespaces"},
+	Short:   "List a tenant's namespaces",
+	Long: `List the namespaces of a tenant's cluster with their status and age, without
+kubectl.
+
+Examples:
+  spacectl tenant ns --name dev
+  spacectl tenant namespaces --id abc123 -o yaml`,
+	Args: cobra.NoArgs,
+	RunE: runTenantNamespaces,
+}
+
+var (
+	tenantResourcesName     string
+	tenantResourcesID       string
+	tenantPodsNamespace     string
+	tenantPodsAllNamespaces bool
+)
+
+func init() {
+	for _, c := range []*cobra.Command{tenantNodesCmd, tenantPodsCmd, tenantNamespacesCmd} {
+		tenantCmd.AddCommand(c)
+		c.Flags().StringVar(&tenantResourcesName, "name", "", "Tenant name")
+		c.Flags().StringVar(&tenantResourcesID, "id", "", "Tenant ID")
+	}
+	tenantPodsCmd.Flags().StringVar(&tenantPodsNamespace, "namespace", "", "Namespace to list pods in (defaults to the kubeconfig's namespace)")
+	tenantPodsCmd.Flags().BoolVarP(&tenantPodsAllNamespaces, "all-namespaces", "A", false, "List pods in all namespaces")
+}
+
+// tenantKubeClient returns a Kubernetes API client for the tenant given with
+// --name or --id, using its cached kubeconfig
+func tenantKubeClient(ctx context.Context) (*kube.Client, error) {
+	path, err := tenantKubeconfigPath(ctx, tenantResourcesName, tenantResourcesID)
+	if err != nil {
+		return nil, err
+	}
+	return kube.NewClient(path)
+}
+
+func runTenantNodes(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	client, err := tenantKubeClient(ctx)
+	if err != nil {
+		return err
+	}
+	nodes, err := client.ListNodes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	// Structured formats get the nodes with their Kubernetes fields
+	if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML {
+		return formatter.FormatData(nodes)
+	}
+	rows := make([]map[string]interface{}, 0, len(nodes))
+	for _, n := range nodes {
+		rows = append(rows, map[string]interface{}{
+			"name":    n.Metadata.Name,
+			"status":  n.DisplayStatus(),
+			"roles":   n.Roles(),
+			"age":     output.Age(n.Metadata.CreationTimestamp),
+			"version": n.Status.NodeInfo.KubeletVersion,
+		})
+	}
+	return formatter.FormatRows(rows, []string{"name", "status", "roles", "age", "version"})
+}
+
+func runTenantPods(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	if tenantPodsAllNamespaces && tenantPodsNamespace != "" {
+		return fmt.Errorf("only one of --namespace or --all-namespaces is allowed")
+	}
+
+	client, err := tenantKubeClient(ctx)
+	if err != nil {
+		return err
+	}
+	namespace := tenantPodsNamespace
+	if namespace == "" && !tenantPodsAllNamespaces {
+		namespace = client.Namespace
+	}
+	pods, err := client.ListPods(ctx, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	// Structured formats get the pods with their Kubernetes fields
+	if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML {
+		return formatter.FormatData(pods)
+	}
+	rows := make([]map[string]interface{}, 0, len(pods))
+	for _, p := range pods {
+		row := map[string]interface{}{
+			"name":     p.Metadata.Name,
+			"ready":    p.Ready(),
+			"status":   p.DisplayStatus(),
+			"restarts": p.Restarts(),
+			"age":      output.Age(p.Metadata.CreationTimestamp),
+		}
+		if tenantPodsAllNamespaces {
+			row["namespace"] = p.Metadata.Namespace
+		}
+		rows = append(rows, row)
+	}
+	return formatter.FormatRows(rows, []string{"namespace", "name", "ready", "status", "restarts", "age"})
+}
+
+func runTenantNamespaces(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	client, err := tenantKubeClient(ctx)
+	if err != nil {
+		return err
+	}
+	namespaces, err := client.ListNamespaces(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	// Structured formats get the namespaces with their Kubernetes fields
+	if output.Format(outputFmt) == output.FormatJSON || output.Format(outputFmt) == output.FormatYAML {
+		return formatter.FormatData(namespaces)
+	}
+	rows := make([]map[string]interface{}, 0, len(namespaces))
+	for _, ns := range namespaces {
+		rows = append(rows, map[string]interface{}{
+			"name":   ns.Metadata.Name,
+			"status": ns.Status.Phase,
+			"age":    output.Age(ns.Metadata.CreationTimestamp),
+		})
+	}
+	return formatter.FormatRows(rows, []string{"name", "status", "age"})
+}
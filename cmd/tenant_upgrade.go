@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+	"spacectl/internal/version"
+
+	"github.com/spf13/cobra"
+)
+
+// tenantUpgradeCmd represents the tenant upgrade command
+var tenantUpgradeCmd = &cobra.Command{
+	Use:   "upgrade (--name <name> | --id <id>) (--k8s-version <version> | --to-latest [--minor-only])",
+	Short: "Upgrade a tenant's Kubernetes version",
+	Long: `Upgrade a tenant to a newer Kubernetes version, shown as the jump from the
+current version before it is applied.
+
+With --to-latest the newest available version that has not reached its end of
+life is chosen. Add --minor-only to go at most one minor version up, such as
+from 1.29 to the newest 1.30 release, as Kubernetes upgrades one minor version
+at a time. A tenant already on the chosen version is left alone, so scripts can
+run the upgrade over a whole fleet repeatedly.
+
+Examples:
+  spacectl tenant upgrade --name dev --k8s-version 1.31
+  spacectl tenant upgrade --name dev --to-latest --dry-run
+  spacectl tenant list -o name | xargs -I{} spacectl tenant upgrade --name {} --to-latest --minor-only`,
+	Args: cobra.NoArgs,
+	RunE: runTenantUpgrade,
+}
+
+var (
+	tenantUpgradeName      string
+	tenantUpgradeID        string
+	tenantUpgradeVersion   string
+	tenantUpgradeToLatest  bool
+	tenantUpgradeMinorOnly bool
+	tenantUpgradeDryRun    bool
+)
+
+func init() {
+	tenantCmd.AddCommand(tenantUpgradeCmd)
+	tenantUpgradeCmd.Flags().StringVar(&tenantUpgradeName, "name", "", "Tenant name")
+	tenantUpgradeCmd.Flags().StringVar(&tenantUpgradeID, "id", "", "Tenant ID")
+	tenantUpgradeCmd.Flags().StringVar(&tenantUpgradeVersion, "k8s-version", "", "Kubernetes version to upgrade to")
+	tenantUpgradeCmd.Flags().BoolVar(&tenantUpgradeToLatest, "to-latest", false, "Upgrade to the newest available Kubernetes version")
+	tenantUpgradeCmd.Flags().BoolVar(&tenantUpgradeMinorOnly, "minor-only", false, "With --to-latest, go at most one minor version up")
+	tenantUpgradeCmd.Flags().BoolVar(&tenantUpgradeDryRun, "dry-run", false, "Only show the upgrade that would be made")
+	tenantUpgradeCmd.MarkFlagsMutuallyExclusive("name", "id")
+	tenantUpgradeCmd.MarkFlagsMutuallyExclusive("k8s-version", "to-latest")
+	tenantUpgradeCmd.RegisterFlagCompletionFunc("k8s-version", completeKubernetesVersions)
+}
+
+func runTenantUpgrade(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	if tenantUpgradeName == "" && tenantUpgradeID == "" && checkInteractive() != nil {
+		return fmt.Errorf("either --name or --id must be provided")
+	}
+	if tenantUpgradeMinorOnly && !tenantUpgradeToLatest {
+		return fmt.Errorf("--minor-only can only be used with --to-latest")
+	}
+	if tenantUpgradeVersion == "" && !tenantUpgradeToLatest {
+		return fmt.Errorf("either --k8s-version or --to-latest must be provided")
+	}
+
+	// Create API client
+	client := apiClient()
+	tenantAPI := api.NewTenantAPI(client)
+
+	id, err := resolveOrPickTenantID(ctx, client, tenantUpgradeName, tenantUpgradeID, contextProjectID())
+	if err != nil {
+		return err
+	}
+	tenant, err := tenantAPI.GetTenant(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get tenant: %w", err)
+	}
+
+	target := tenantUpgradeVersion
+	if tenantUpgradeToLatest {
+		versions, err := tenantAPI.GetAvailableKubernetesVersions(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get Kubernetes versions: %w", err)
+		}
+		target, err = latestUpgrade(tenant.KubernetesVersion, versions, tenantUpgradeMinorOnly, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	if target == tenant.KubernetesVersion {
+		if !quiet {
+			fmt.Printf("Tenant %s is already on Kubernetes %s\n", tenant.Name, target)
+		}
+		return nil
+	}
+	if cmp, err := version.Compare(target, tenant.KubernetesVersion); err == nil && cmp < 0 {
+		return fmt.Errorf("cannot upgrade tenant %s from Kubernetes %s to the older %s: downgrades are not supported", tenant.Name, tenant.KubernetesVersion, target)
+	}
+
+	if tenantUpgradeDryRun {
+		fmt.Printf("Would upgrade tenant %s: Kubernetes %s → %s\n", tenant.Name, tenant.KubernetesVersion, target)
+		return nil
+	}
+	if !quiet {
+		fmt.Printf("Upgrading tenant %s: Kubernetes %s → %s\n", tenant.Name, tenant.KubernetesVersion, target)
+		warnKubernetesVersionSupport(ctx, tenantAPI, target)
+	}
+
+	if _, err := tenantAPI.UpdateTenant(ctx, tenant.ID, models.UpdateTenantRequest{KubernetesVersion: &target}); err != nil {
+		return fmt.Errorf("failed to upgrade tenant: %w", err)
+	}
+	if !quiet {
+		fmt.Printf("Successfully upgraded tenant %s to Kubernetes %s\n", tenant.Name, target)
+	}
+	return nil
+}
+
+// latestUpgrade returns the newest of the available Kubernetes versions that a
+// tenant on current can be upgraded to, skipping versions past their end of
+// life. With minorOnly, versions more than one minor version above current are
+// skipped too. A tenant already on the newest version gets current back.
+func latestUpgrade(current string, versions []models.KubernetesVersion, minorOnly bool, now time.Time) (string, error) {
+	// Newer versions can only be found for a version that parses
+	if _, err := version.Compare(current, current); err != nil {
+		return "", fmt.Errorf("cannot find an upgrade for Kubernetes %s: %w", current, err)
+	}
+	latest := current
+	for _, v := range versions {
+		if v.EndOfLifeAt(now) {
+			continue
+		}
+		cmp, err := version.Compare(v.Version, latest)
+		if err != nil || cmp <= 0 {
+			continue
+		}
+		if minorOnly {
+			step, err := version.MinorStep(current, v.Version)
+			if err != nil || step > 1 {
+				continue
+			}
+		}
+		latest = v.Version
+	}
+	return latest, nil
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"spacectl/internal/models"
+)
+
+func TestLatestUpgrade(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	versions := []models.KubernetesVersion{
+		{Version: "1.28.9", EndOfLife: "2025-05-01"},
+		{Version: "1.29.4", EndOfLife: "2025-06-01"},
+		{Version: "1.29.2"},
+		{Version: "1.30.1"},
+		{Version: "1.30.3", Deprecated: true},
+		{Version: "1.31.0", IsDefault: true},
+		{Version: "1.32.0", EndOfLife: "not a date"},
+	}
+
+	tests := []struct {
+		name      string
+		current   string
+		minorOnly bool
+		want      string
+		wantErr   bool
+	}{
+		{name: "newest", current: "1.28.2", want: "1.32.0"},
+		{name: "one minor version up", current: "1.29.0", minorOnly: true, want: "1.30.3"},
+		// 1.29.4 reached its end of life on the day, so 1.29.2 is the newest 1.29
+		{name: "end of life versions are skipped", current: "1.28.2", minorOnly: true, want: "1.29.2"},
+		{name: "already latest", current: "1.32.0", want: "1.32.0"},
+		{name: "newer than every version", current: "1.33.1", minorOnly: true, want: "1.33.1"},
+		{name: "unparsable current version", current: "latest", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := latestUpgrade(tc.current, versions, tc.minorOnly, now)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+
+	"spacectl/internal/api"
+	"spacectl/internal/output"
+	"spacectl/internal/parallel"
+
+	"github.com/spf13/cobra"
+)
+
+// topCmd represents the top command
+var topCmd = &cobra.Command{
+	Use:   "top",
+	Short: "Show the biggest resource consumers",
+	Long:  `Show which resources are allocated or use the most compute and memory.`,
+}
+
+// topTenantsCmd represents the top tenants command
+var topTenantsCmd = &cobra.Command{
+	Use:   "tenants",
+	Short: "Rank tenants by compute and memory",
+	Long: `Rank the tenants of a project, or of all your projects with --all, by their
+compute and memory quotas, biggest first. The current CPU and memory usage of
+each tenant is shown when the platform has collected it.
+
+--sort-by ranks by compute (the default) or memory quota, or by cpu-usage or
+memory-usage; tenants without usage rank last.
+
+Examples:
+  spacectl top tenants --project-name web
+  spacectl top tenants --all
+  spacectl top tenants --all --sort-by memory-usage
+  spacectl top tenants --all --no-usage -o json`,
+	Args: cobra.NoArgs,
+	RunE: runTopTenants,
+}
+
+var (
+	topTenantsAll     bool
+	topTenantsSortBy  string
+	topTenantsNoUsage bool
+)
+
+func init() {
+	rootCmd.AddCommand(topCmd)
+	topCmd.AddCommand(topTenantsCmd)
+	topTenantsCmd.Flags().BoolVar(&topTenantsAll, "all", false, "Rank tenants from all projects")
+	topTenantsCmd.Flags().StringVar(&topTenantsSortBy, "sort-by", "compute", "Rank by compute, memory, cpu-usage, or memory-usage")
+	topTenantsCmd.Flags().BoolVar(&topTenantsNoUsage, "no-usage", false, "Rank by quotas only, without fetching usage")
+	addConcurrencyFlag(topTenantsCmd)
+}
+
+// tenantTop is a tenant's allocation and, when known, usage
+type tenantTop struct {
+	Project       string   `json:"project" yaml:"project"`
+	Name          string   `json:"name" yaml:"name"`
+	ID            string   `json:"id" yaml:"id"`
+	Status        string   `json:"status" yaml:"status"`
+	ComputeQuota  int      `json:"compute_quota" yaml:"compute_quota"`
+	MemoryQuotaGB int      `json:"memory_quota_gb" yaml:"memory_quota_gb"`
+	CPUUsage      *float64 `json:"cpu_usage,omitempty" yaml:"cpu_usage,omitempty"`
+	MemoryUsageGB *float64 `json:"memory_usage_gb,omitempty" yaml:"memory_usage_gb,omitempty"`
+}
+
+func runTopTenants(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Validate flags
+	if topTenantsAll && projectFlagSet() {
+		return fmt.Errorf("--all cannot be used with --project or --project-name")
+	}
+	less, err := topTenantsLess(topTenantsSortBy)
+	if err != nil {
+		return err
+	}
+
+	// Create API client
+	client := apiClient()
+	projectAPI := api.NewProjectAPI(client)
+	tenantAPI := api.NewTenantAPI(client)
+
+	var projectIDs []string
+	projectNames := map[string]string{}
+	if topTenantsAll {
+		memberships, err := projectAPI.ListUserProjects(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list user projects: %w", err)
+		}
+		if len(memberships) == 0 {
+			return fmt.Errorf("no projects found. Create a project first")
+		}
+		for _, m := range memberships {
+			projectIDs = append(projectIDs, m.Project.ID)
+			projectNames[m.Project.ID] = m.Project.Name
+		}
+	} else {
+		projectID, err := requireProjectID()
+		if err != nil {
+			return err
+		}
+		project, err := projectAPI.GetProject(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to get project: %w", err)
+		}
+		projectIDs = []string{projectID}
+		projectNames[projectID] = project.Name
+	}
+
+	tenants, failed := fetchProjectTenants(ctx, tenantAPI, projectIDs)
+	var tops []tenantTop
+	for _, id := range projectIDs {
+		if failed[id] {
+			if !topTenantsAll {
+				return fmt.Errorf("failed to list tenants of project %s", projectNames[id])
+			}
+			fmt.Fprintf(os.Stderr, "Warning: could not list the tenants of project %s\n", projectNames[id])
+			continue
+		}
+		for _, t := range tenants[id] {
+			tops = append(tops, tenantTop{
+				Project:       projectNames[id],
+				Name:          t.Name,
+				ID:            t.ID,
+				Status:        t.Status,
+				ComputeQuota:  t.ComputeQuota,
+				MemoryQuotaGB: t.MemoryQuotaGB,
+			})
+		}
+	}
+
+	if !topTenantsNoUsage {
+		if n := fetchTenantUsage(ctx, tenantAPI, tops); n > 0 {
+			fmt.Fprintf(os.Stderr, "Warning: could not get the usage of %d tenant(s)\n", n)
+		}
+	}
+	sort.SliceStable(tops, func(i, j int) bool { return less(tops[i], tops[j]) })
+
+	// Structured formats get the full entries, without usage that is unknown
+	if output.Format(outputFmt) != output.FormatTable && output.Format(outputFmt) != output.FormatCSV {
+		return formatter.FormatData(tops)
+	}
+
+	if len(tops) == 0 {
+		if !quiet {
+			fmt.Println("No tenants found.")
+		}
+		return nil
+	}
+	rows := make([]map[string]interface{}, 0, len(tops))
+	for _, t := range tops {
+		rows = append(rows, map[string]interface{}{
+			"project":         t.Project,
+			"name":            t.Name,
+			"status":          t.Status,
+			"compute_quota":   t.ComputeQuota,
+			"memory_quota_gb": t.MemoryQuotaGB,
+			"cpu_usage":       formatUsage(t.CPUUsage),
+			"memory_usage_gb": formatUsage(t.MemoryUsageGB),
+		})
+	}
+	return formatter.FormatRows(rows, []string{"project", "name", "status", "compute_quota", "memory_quota_gb", "cpu_usage", "memory_usage_gb"})
+}
+
+// fetchTenantUsage fills in the usage of the given tenants, --concurrency at a time. Usage
+// that has not been collected yet, or that the server is too old to report, is
+// left unset; the number of tenants whose usage could not be fetched for other
+// reasons is returned.
+func fetchTenantUsage(ctx context.Context, tenantAPI *api.TenantAPI, tops []tenantTop) int {
+	var mu sync.Mutex
+	failed := 0
+
+	parallel.ForEach(len(tops), concurrency, func(i int) {
+		t := &tops[i]
+		usage, err := tenantAPI.GetTenantUsage(ctx, t.ID)
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case errors.Is(err, api.ErrNotFound), errors.Is(err, api.ErrUnsupported):
+		case err != nil:
+			failed++
+		default:
+			t.CPUUsage = &usage.CPUCores
+			t.MemoryUsageGB = &usage.MemoryGB
+		}
+	})
+	return failed
+}
+
+// topTenantsLess returns the ordering for a --sort-by value, biggest first
+func topTenantsLess(sortBy string) (func(a, b tenantTop) bool, error) {
+	switch sortBy {
+	case "compute":
+		return func(a, b tenantTop) bool {
+			if a.ComputeQuota != b.ComputeQuota {
+				return a.ComputeQuota > b.ComputeQuota
+			}
+			return a.MemoryQuotaGB > b.MemoryQuotaGB
+		}, nil
+	case "memory":
+		return func(a, b tenantTop) bool {
+			if a.MemoryQuotaGB != b.MemoryQuotaGB {
+				return a.MemoryQuotaGB > b.MemoryQuotaGB
+			}
+			return a.ComputeQuota > b.ComputeQuota
+		}, nil
+	case "cpu-usage":
+		return func(a, b tenantTop) bool { return usageGreater(a.CPUUsage, b.CPUUsage) }, nil
+	case "memory-usage":
+		return func(a, b tenantTop) bool { return usageGreater(a.MemoryUsageGB, b.MemoryUsageGB) }, nil
+	default:
+		return nil, fmt.Errorf("invalid --sort-by %q: use compute, memory, cpu-usage, or memory-usage", sortBy)
+	}
+}
+
+// usageGreater orders usage biggest first, with unknown usage last
+func usageGreater(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a != nil && b == nil
+	}
+	return *a > *b
+}
+
+// formatUsage formats a usage value for tables, or "-" when it is unknown
+func formatUsage(v *float64) string {
+	if v == nil {
+		return "-"
+	}
+	return strconv.FormatFloat(*v, 'f', 2, 64)
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"spacectl/internal/api"
+	"spacectl/internal/tui"
+
+	"github.com/spf13/cobra"
+)
+
+// uiCmd represents the ui command
+var uiCmd = &cobra.Command{
+	Use:   "ui",
+	Short: "Browse organizations, projects, and tenants in a terminal UI",
+	Long: `Browse organizations, projects, and tenants in an interactive terminal UI.
+The current list is refreshed periodically so tenant status stays up to date.
+
+Keys:
+  ↑/↓, j/k     Move the selection
+  enter, l     Open the selected organization or project
+  esc, h       Go back
+  r            Refresh now
+  d            Delete the selected tenant (asks for confirmation)
+  c            Save the selected tenant's kubeconfig to --kubeconfig-dir
+  s            Open a shell with KUBECONFIG set for the selected tenant
+  q            Quit
+
+Examples:
+  spacectl ui
+  spacectl ui --refresh 10s`,
+	Args: cobra.NoArgs,
+	RunE: runUI,
+}
+
+var (
+	uiRefresh       time.Duration
+	uiKubeconfigDir string
+)
+
+func init() {
+	rootCmd.AddCommand(uiCmd)
+	uiCmd.Flags().DurationVar(&uiRefresh, "refresh", 5*time.Second, "How often to refresh the current list (0 to disable)")
+	uiCmd.Flags().StringVar(&uiKubeconfigDir, "kubeconfig-dir", defaultKubeconfigDir(), "Directory where kubeconfigs are saved")
+}
+
+func runUI(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+	if err := checkInteractive(); err != nil {
+		return fmt.Errorf("the terminal UI cannot run: %w", err)
+	}
+
+	// Create API client
+	client := apiClient()
+	tenantAPI := api.NewTenantAPI(client)
+
+	shell := os.Getenv("SHELL")
+	if shell == "" {
+		shell = "/bin/sh"
+	}
+
+	err := tui.Run(ctx, client, tui.Options{
+		RefreshInterval: uiRefresh,
+		KubeconfigDir:   uiKubeconfigDir,
+		Kubeconfig: func(ctx context.Context, tenantID string) (string, error) {
+			return getOrFetchKubeconfig(ctx, tenantAPI, tenantID, false)
+		},
+		Shell: shell,
+	})
+	if ctx.Err() != nil {
+		// Stop cleanly on Ctrl-C
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to run terminal UI: %w", err)
+	}
+	return nil
+}
+
+// defaultKubeconfigDir returns ~/.kube/spacectl, where the UI saves kubeconfigs
+func defaultKubeconfigDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "kubeconfigs"
+	}
+	return filepath.Join(home, ".kube", "spacectl")
+}
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"fmt"
+	"syscall"
+
+	"spacectl/internal/api"
+	"spacectl/internal/models"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// userCmd represents the user command
+var userCmd = &cobra.Command{
+	Use:   "user",
+	Short: "Manage your user account",
+	Long:  `Manage the account of the currently authenticated user.`,
+}
+
+func init() {
+	rootCmd.AddCommand(userCmd)
+}
+
+// userChangePasswordCmd represents the user change-password command
+var userChangePasswordCmd = &cobra.Command{
+	Use:   "change-password",
+	Short: "Change your password",
+	Long: `Change the password of the currently authenticated user. You are prompted
+for your current password and the new password. All other sessions are signed
+out; this session stays logged in.`,
+	Args: cobra.NoArgs,
+	RunE: runUserChangePassword,
+}
+
+func init() {
+	userCmd.AddCommand(userChangePasswordCmd)
+}
+
+func runUserChangePassword(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	currentPassword, err := readPassword("Current password: ")
+	if err != nil {
+		return err
+	}
+	newPassword, err := readPassword("New password: ")
+	if err != nil {
+		return err
+	}
+	confirmPassword, err := readPassword("Confirm new password: ")
+	if err != nil {
+		return err
+	}
+	if newPassword == "" {
+		return fmt.Errorf("new password cannot be empty")
+	}
+	if newPassword != confirmPassword {
+		return fmt.Errorf("new passwords do not match")
+	}
+
+	// Create API client
+	client := apiClient()
+	authAPI := api.NewAuthAPI(client)
+
+	// Change password
+	loginResp, err := authAPI.ChangePassword(ctx, currentPassword, newPassword)
+	if err != nil {
+		return fmt.Errorf("failed to change password: %w", err)
+	}
+
+	// Other sessions are revoked, so keep this one alive with the fresh tokens
+	if loginResp.AccessToken != "" {
+		cfg.UpdateTokens(loginResp.AccessToken, loginResp.RefreshToken, cfg.UserEmail)
+		if err := cfg.Save(); err != nil {
+			return fmt.Errorf("failed to save config: %w", err)
+		}
+	}
+
+	// Output success message
+	if !quiet {
+		fmt.Printf("Successfully changed password. All other sessions have been signed out.\n")
+	}
+
+	return nil
+}
+
+// userUpdateCmd represents the user update command
+var userUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update your profile",
+	Long: `Update the profile of the currently authenticated user.
+
+Examples:
+  spacectl user update --display-name "Jane Doe"`,
+	Args: cobra.NoArgs,
+	RunE: runUserUpdate,
+}
+
+var userUpdateDisplayName string
+
+func init() {
+	userCmd.AddCommand(userUpdateCmd)
+	userUpdateCmd.Flags().StringVar(&userUpdateDisplayName, "display-name", "", "Name shown to other users")
+}
+
+func runUserUpdate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Check if user is authenticated
+	if !cfg.IsAuthenticated() {
+		return errNotAuthenticated
+	}
+
+	// Build request from the flags that were explicitly set
+	var req models.UpdateUserRequest
+	if cmd.Flags().Changed("display-name") {
+		req.DisplayName = &userUpdateDisplayName
+	}
+	if req.DisplayName == nil {
+		return fmt.Errorf("nothing to update. Use --display-name")
+	}
+
+	// Create API client
+	client := apiClient()
+	authAPI := api.NewAuthAPI(client)
+
+	// Update profile
+	user, err := authAPI.UpdateUser(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to update profile: %w", err)
+	}
+
+	// Output success message
+	if !quiet {
+		fmt.Printf("Successfully updated profile for %s\n", user.Email)
+	}
+
+	return nil
+}
+
+// readPassword prompts for a password without echoing it
+func readPassword(label string) (string, error) {
+	if err := promptUnavailable("a password", "run the command in a terminal"); err != nil {
+		return "", err
+	}
+	fmt.Print(label)
+	passwordBytes, err := term.ReadPassword(int(syscall.Stdin))
+	fmt.Println() // New line after password input
+	if err != nil {
+		return "", fmt.Errorf("failed to read password: %w", err)
+	}
+	return string(passwordBytes), nil
+}
@@ -0,0 +1,245 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"spacectl/internal/api"
+	"spacectl/internal/manifest"
+	"spacectl/internal/names"
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+)
+
+// validateCmd represents the validate command
+var validateCmd = &cobra.Command{
+	Use:   "validate -f <file|dir>",
+	Short: "Check manifests against their schemas",
+	Long: `Check manifest files, or the .yaml, .yml, and .json files in a directory,
+against the JSON Schema of each kind: required fields, field types, and unknown
+fields. Project and tenant names are checked against the naming rules. When you
+are logged in, the cloud providers, regions, and Kubernetes versions of tenants
+are also checked against the ones the API offers. Nothing is created, changed,
+or deleted.
+
+The schemas are generated from the same definitions as 'spacectl explain'.
+Print one with --print-schema, e.g. for editor completion.
+
+Examples:
+  spacectl validate -f tenant.yaml
+  spacectl validate -f ./environments/staging -o json
+  spacectl validate --print-schema tenant > tenant.schema.json`,
+	Args: cobra.NoArgs,
+	RunE: runValidate,
+}
+
+var (
+	validateFiles       []string
+	validatePrintSchema string
+)
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+
+	validateCmd.Flags().StringSliceVarP(&validateFiles, "filename", "f", nil, "Manifest files or directories to validate (use - for stdin)")
+	validateCmd.Flags().StringVar(&validatePrintSchema, "print-schema", "", "Print the JSON Schema of a manifest kind instead of validating")
+	addManifestValueFlags(validateCmd)
+}
+
+// validateProblem is a problem found in a manifest file
+type validateProblem struct {
+	File             string `json:"file" yaml:"file"`
+	manifest.Problem `yaml:",inline"`
+}
+
+func runValidate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if validatePrintSchema != "" {
+		schema, err := manifest.KindSchema(validatePrintSchema)
+		if err != nil {
+			return err
+		}
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode schema: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+	if len(validateFiles) == 0 {
+		return fmt.Errorf("at least one manifest is required. Pass -f <file|dir>")
+	}
+
+	values, err := manifestValues()
+	if err != nil {
+		return err
+	}
+	var files []string
+	for _, path := range validateFiles {
+		found, err := manifest.Files(path)
+		if err != nil {
+			return err
+		}
+		files = append(files, found...)
+	}
+
+	validator := manifest.NewValidator()
+	locations := apiLocations(ctx, validator)
+
+	var problems []validateProblem
+	documents := 0
+	for _, file := range files {
+		data, err := manifest.ReadData(file)
+		if err != nil {
+			return err
+		}
+		data, err = manifest.Expand(data, values)
+		if err != nil {
+			problems = append(problems, validateProblem{File: file, Problem: manifest.Problem{Message: err.Error()}})
+			continue
+		}
+		found, err := validator.Validate(data)
+		if err != nil {
+			problems = append(problems, validateProblem{File: file, Problem: manifest.Problem{Message: err.Error()}})
+			continue
+		}
+		if len(found) == 0 {
+			// The documents match their schemas, so they only fail to decode
+			// on checks across fields, such as a member's email or user ID
+			resources, err := manifest.Decode(data)
+			if err != nil {
+				problems = append(problems, validateProblem{File: file, Problem: manifest.Problem{Message: err.Error()}})
+				continue
+			}
+			documents += len(resources)
+			found = append(checkNames(resources), checkTenantLocations(resources, locations)...)
+		}
+		for _, p := range found {
+			problems = append(problems, validateProblem{File: file, Problem: p})
+		}
+	}
+
+	// Structured formats get the problems as a list, which is empty when all is well
+	if output.Format(outputFmt) != output.FormatTable {
+		if problems == nil {
+			problems = []validateProblem{}
+		}
+		if err := formatter.FormatData(problems); err != nil {
+			return err
+		}
+	} else {
+		for _, p := range problems {
+			fmt.Printf("%s: %s\n", p.File, p.Problem)
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("found %d problems in the manifests", len(problems))
+	}
+	if !quiet && output.Format(outputFmt) == output.FormatTable {
+		fmt.Printf("%d manifests in %d files are valid\n", documents, len(files))
+	}
+	return nil
+}
+
+// apiLocations restricts the tenant fields that name a cloud provider, region,
+// or Kubernetes version to the values the API offers, and returns the regions
+// of each cloud provider. Without a login, or if the API cannot be reached,
+// those fields are not checked.
+func apiLocations(ctx context.Context, validator *manifest.Validator) map[string][]string {
+	if !cfg.IsAuthenticated() {
+		fmt.Fprintln(os.Stderr, "Warning: not logged in; cloud providers, regions, and Kubernetes versions are not checked")
+		return nil
+	}
+
+	// Create API client
+	tenantAPI := api.NewTenantAPI(apiClient())
+
+	var regions map[string][]string
+	if locations, err := tenantAPI.GetAvailableLocations(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not get the available locations, cloud providers and regions are not checked: %v\n", err)
+	} else {
+		regions = make(map[string][]string)
+		var allRegions []string
+		for _, loc := range locations {
+			if !slices.Contains(regions[loc.CloudProvider], loc.Region) {
+				regions[loc.CloudProvider] = append(regions[loc.CloudProvider], loc.Region)
+			}
+			if !slices.Contains(allRegions, loc.Region) {
+				allRegions = append(allRegions, loc.Region)
+			}
+		}
+		clouds := make([]string, 0, len(regions))
+		for cloud := range regions {
+			clouds = append(clouds, cloud)
+		}
+		sort.Strings(clouds)
+		sort.Strings(allRegions)
+		validator.SetEnum(manifest.KindTenant, "spec.cloud_provider", clouds)
+		validator.SetEnum(manifest.KindTenant, "spec.region", allRegions)
+	}
+
+	if versions, err := tenantAPI.GetAvailableKubernetesVersions(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not get the available Kubernetes versions, they are not checked: %v\n", err)
+	} else {
+		names := make([]string, len(versions))
+		for i, v := range versions {
+			names[i] = v.Version
+		}
+		validator.SetEnum(manifest.KindTenant, "spec.kubernetes_version", names)
+	}
+	return regions
+}
+
+// checkNames reports projects and tenants whose names break the platform's
+// naming rules
+func checkNames(resources []interface{}) []manifest.Problem {
+	var problems []manifest.Problem
+	for i, r := range resources {
+		var kind, name string
+		var err error
+		switch m := r.(type) {
+		case *manifest.Project:
+			kind, name, err = manifest.KindProject, m.Metadata.Name, names.ValidateProject(m.Metadata.Name)
+		case *manifest.Tenant:
+			kind, name, err = manifest.KindTenant, m.Metadata.Name, names.ValidateTenant(m.Metadata.Name)
+		}
+		if err != nil {
+			problems = append(problems, manifest.Problem{Document: i + 1, Kind: kind, Name: name, Field: "metadata.name", Message: err.Error()})
+		}
+	}
+	return problems
+}
+
+// checkTenantLocations reports tenants whose region is not offered by their
+// cloud provider
+func checkTenantLocations(resources []interface{}, regions map[string][]string) []manifest.Problem {
+	if regions == nil {
+		return nil
+	}
+	var problems []manifest.Problem
+	for i, r := range resources {
+		t, ok := r.(*manifest.Tenant)
+		if !ok || t.Spec.CloudProvider == "" || t.Spec.Region == "" {
+			continue
+		}
+		available := regions[t.Spec.CloudProvider]
+		if !slices.Contains(available, t.Spec.Region) {
+			problems = append(problems, manifest.Problem{
+				Document: i + 1,
+				Kind:     manifest.KindTenant,
+				Name:     t.Metadata.Name,
+				Field:    "spec.region",
+				Message:  fmt.Sprintf("region %q is not available on %s (available: %s)", t.Spec.Region, t.Spec.CloudProvider, strings.Join(available, ", ")),
+			})
+		}
+	}
+	return problems
+}
@@ -0,0 +1,255 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// The kubectl-style verbs (get, describe, delete) are a thin layer over the
+// resource commands: each invocation is translated to the matching subcommand
+// and its flags, e.g. "get tenants -A" runs "tenant list --all". The global
+// --project and --org flags carry over unchanged.
+
+// resourceKind is a resource type accepted by the verbs
+type resourceKind string
+
+const (
+	kindOrganization resourceKind = "organization"
+	kindProject      resourceKind = "project"
+	kindTenant       resourceKind = "tenant"
+)
+
+// resourceAliases maps the names accepted on the command line to resource kinds
+var resourceAliases = map[string]resourceKind{
+	"org":           kindOrganization,
+	"orgs":          kindOrganization,
+	"organization":  kindOrganization,
+	"organizations": kindOrganization,
+	"project":       kindProject,
+	"projects":      kindProject,
+	"proj":          kindProject,
+	"tenant":        kindTenant,
+	"tenants":       kindTenant,
+}
+
+// getCmd represents the get command
+var getCmd = &cobra.Command{
+	Use:   "get <resource> [name]",
+	Short: "List resources or show one by name",
+	Long: `List organizations, projects, or tenants, or show one of them by name.
+This is a kubectl-style shortcut for the list and get subcommands.
+
+Resources: organizations (org), projects (proj), tenants
+
+Examples:
+  spacectl get orgs
+  spacectl get projects --org-name acme
+  spacectl get tenants -p my-project
+  spacectl get tenants -A
+  spacectl get tenant dev -p my-project -o yaml`,
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: completeResourceKinds,
+	RunE:              runGet,
+}
+
+// describeCmd represents the describe command
+var describeCmd = &cobra.Command{
+	Use:   "describe <resource> <name>",
+	Short: "Show details of a resource",
+	Long: `Show a detailed view of an organization (its projects and tenants), a
+project (quota usage, members, and tenants), or a tenant.
+This is a kubectl-style shortcut for the describe and get subcommands.
+
+Examples:
+  spacectl describe org acme
+  spacectl describe project my-project
+  spacectl describe tenant dev -p my-project`,
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: completeResourceKinds,
+	RunE:              runDescribe,
+}
+
+// deleteCmd represents the delete command
+var deleteCmd = &cobra.Command{
+	Use:   "delete (<resource> <name> | -f <file|dir>)",
+	Short: "Delete a resource by name or the resources in manifests",
+	Long: `Delete an organization, project, or tenant by name.
+This is a kubectl-style shortcut for the delete subcommands.
+
+With -f, delete the resources described by manifest files instead, or by the
+.yaml, .yml, and .json files in a directory. Resources are deleted tenants
+first, then projects, then organizations, after a preview and a single
+confirmation; resources that no longer exist are skipped. With --wait, each
+kind is waited on until it is gone before its parents are deleted.
+
+Examples:
+  spacectl delete tenant dev -p my-project
+  spacectl delete project my-project --force
+  spacectl delete -f tenant.yaml
+  spacectl delete -f ./environments/staging --wait`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(verbFiles) > 0 {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.ExactArgs(2)(cmd, args)
+	},
+	ValidArgsFunction: completeResourceKinds,
+	RunE:              runDelete,
+}
+
+var (
+	verbAll         bool
+	verbForce       bool
+	verbFiles       []string
+	verbWait        bool
+	verbWaitTimeout time.Duration
+)
+
+func init() {
+	rootCmd.AddCommand(getCmd, describeCmd, deleteCmd)
+
+	getCmd.Flags().BoolVarP(&verbAll, "all", "A", false, "List projects from all organizations or tenants from all projects")
+	addListFlags(getCmd)
+	addConcurrencyFlag(getCmd)
+	deleteCmd.Flags().BoolVar(&verbForce, "force", false, "Skip confirmation prompt")
+	deleteCmd.Flags().StringSliceVarP(&verbFiles, "filename", "f", nil, "Manifest files or directories describing the resources to delete (use - for stdin)")
+	deleteCmd.Flags().BoolVar(&verbWait, "wait", false, "With -f, wait for each kind of resource to be gone before deleting the next")
+	deleteCmd.Flags().DurationVar(&verbWaitTimeout, "wait-timeout", 10*time.Minute, "How long --wait waits for each kind of resource")
+	addManifestValueFlags(deleteCmd)
+	addConcurrencyFlag(deleteCmd)
+}
+
+func runGet(cmd *cobra.Command, args []string) error {
+	kind, err := parseResourceKind(args[0])
+	if err != nil {
+		return err
+	}
+	name := ""
+	if len(args) == 2 {
+		name = args[1]
+	}
+	if name != "" && verbAll {
+		return fmt.Errorf("--all cannot be used with a name")
+	}
+
+	switch kind {
+	case kindOrganization:
+		if name == "" {
+			return runLayered(cmd, orgListCmd, nil)
+		}
+		return runLayered(cmd, orgGetCmd, flagValues{{"name", name}})
+
+	case kindProject:
+		if name == "" {
+			return runLayered(cmd, projectListCmd, flagValues{{"all", boolFlag(verbAll)}})
+		}
+		if err := selectProject(cmd.Context(), name); err != nil {
+			return err
+		}
+		return runLayered(cmd, projectGetCmd, nil)
+
+	default:
+		if name == "" {
+			return runLayered(cmd, tenantListCmd, flagValues{{"all", boolFlag(verbAll)}})
+		}
+		return runLayered(cmd, tenantGetCmd, flagValues{{"name", name}})
+	}
+}
+
+func runDescribe(cmd *cobra.Command, args []string) error {
+	kind, err := parseResourceKind(args[0])
+	if err != nil {
+		return err
+	}
+	name := args[1]
+
+	switch kind {
+	case kindOrganization:
+		return runLayered(cmd, orgDescribeCmd, flagValues{{"name", name}})
+	case kindProject:
+		if err := selectProject(cmd.Context(), name); err != nil {
+			return err
+		}
+		return runLayered(cmd, projectDescribeCmd, nil)
+	default:
+		return runLayered(cmd, tenantGetCmd, flagValues{{"name", name}})
+	}
+}
+
+func runDelete(cmd *cobra.Command, args []string) error {
+	if len(verbFiles) > 0 {
+		return runDeleteFile(cmd, verbFiles)
+	}
+	if verbWait {
+		return fmt.Errorf("--wait can only be used with -f")
+	}
+
+	kind, err := parseResourceKind(args[0])
+	if err != nil {
+		return err
+	}
+	name := args[1]
+	force := boolFlag(verbForce)
+
+	switch kind {
+	case kindOrganization:
+		return runLayered(cmd, orgDeleteCmd, flagValues{{"name", name}, {"force", force}})
+	case kindProject:
+		return runLayered(cmd, projectDeleteCmd, flagValues{{"name", name}, {"force", force}})
+	default:
+		return runLayered(cmd, tenantDeleteCmd, flagValues{{"name", name}, {"force", force}})
+	}
+}
+
+// flagValues are flags to set on a layered command, in order; empty values are skipped
+type flagValues [][2]string
+
+// boolFlag returns the flag value for a boolean, or "" to leave the flag unset
+func boolFlag(b bool) string {
+	if b {
+		return "true"
+	}
+	return ""
+}
+
+// runLayered runs a resource subcommand with the given flags set, as if it had
+// been invoked directly
+func runLayered(cmd, target *cobra.Command, flags flagValues) error {
+	for _, flag := range flags {
+		if flag[1] == "" {
+			continue
+		}
+		if err := target.Flags().Set(flag[0], flag[1]); err != nil {
+			return fmt.Errorf("invalid value for --%s: %w", flag[0], err)
+		}
+	}
+	target.SetContext(cmd.Context())
+	return target.RunE(target, nil)
+}
+
+// parseResourceKind resolves a resource name or alias such as "tenants" or "org"
+func parseResourceKind(name string) (resourceKind, error) {
+	if kind, ok := resourceAliases[strings.ToLower(name)]; ok {
+		return kind, nil
+	}
+	return "", fmt.Errorf("unknown resource type %q (use organizations, projects, or tenants)", name)
+}
+
+// completeResourceKinds completes the resource type argument of the verbs
+func completeResourceKinds(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var names []string
+	for name := range resourceAliases {
+		if strings.HasPrefix(name, toComplete) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
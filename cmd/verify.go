@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"fmt"
+
+	"spacectl/internal/api"
+
+	"github.com/spf13/cobra"
+)
+
+// verifyCmd represents the auth verify command
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify your email address",
+	Long: `Verify the email address of a new account with the code sent to it after
+'spacectl register'. Logging in fails until the address is verified. If the
+code has expired or never arrived, request a new one with 'auth verify resend'.
+
+Examples:
+  spacectl auth verify --email me@example.com --code 123456
+  spacectl auth verify resend --email me@example.com`,
+	Args: cobra.NoArgs,
+	RunE: runVerify,
+}
+
+// verifyResendCmd represents the auth verify resend command
+var verifyResendCmd = &cobra.Command{
+	Use:   "resend",
+	Short: "Send a new verification code",
+	Long: `Send a new email verification code to the address of an account that is not
+verified yet.
+
+Examples:
+  spacectl auth verify resend --email me@example.com`,
+	Args: cobra.NoArgs,
+	RunE: runVerifyResend,
+}
+
+var (
+	verifyEmail string
+	verifyCode  string
+)
+
+func init() {
+	authCmd.AddCommand(verifyCmd)
+	verifyCmd.AddCommand(verifyResendCmd)
+
+	verifyCmd.PersistentFlags().StringVar(&verifyEmail, "email", "", "Email address of the account")
+	verifyCmd.Flags().StringVar(&verifyCode, "code", "", "Verification code from the email")
+}
+
+func runVerify(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	email, err := promptVerifyEmail()
+	if err != nil {
+		return err
+	}
+	if verifyCode == "" {
+		if err := promptUnavailable("the verification code", "pass --code"); err != nil {
+			return err
+		}
+		if verifyCode, err = promptString("Verification code", ""); err != nil {
+			return err
+		}
+	}
+
+	// Create API client
+	client := apiClient()
+	authAPI := api.NewAuthAPI(client)
+
+	if err := authAPI.VerifyEmail(ctx, email, verifyCode); err != nil {
+		return fmt.Errorf("failed to verify email: %w", err)
+	}
+
+	if !quiet {
+		fmt.Printf("Verified %s. You can now log in with 'spacectl auth login'\n", email)
+	}
+	return nil
+}
+
+func runVerifyResend(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	email, err := promptVerifyEmail()
+	if err != nil {
+		return err
+	}
+
+	// Create API client
+	client := apiClient()
+	authAPI := api.NewAuthAPI(client)
+
+	if err := authAPI.ResendVerificationCode(ctx, email); err != nil {
+		return fmt.Errorf("failed to resend verification code: %w", err)
+	}
+
+	if !quiet {
+		fmt.Printf("Sent a new verification code to %s\n", email)
+	}
+	return nil
+}
+
+// promptVerifyEmail returns the email address given with --email, or asks for it
+func promptVerifyEmail() (string, error) {
+	if verifyEmail != "" {
+		return verifyEmail, nil
+	}
+	if err := promptUnavailable("an email address", "pass --email"); err != nil {
+		return "", err
+	}
+	return promptString("Email", cfg.UserEmail)
+}
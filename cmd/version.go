@@ -1,8 +1,14 @@
 package cmd
 
 import (
+	"crypto/tls"
 	"fmt"
+	"net/http"
+	"os"
+	"time"
 
+	"spacectl/internal/api"
+	"spacectl/internal/output"
 	"spacectl/internal/version"
 
 	"github.com/spf13/cobra"
@@ -12,12 +18,112 @@ import (
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print the version number",
-	Long:  `Print the version number of spacectl.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("spacectl", version.Version)
-	},
+	Long: `Print the version number of spacectl.
+
+With --check, also compare it against the latest spacectl release and against the
+oldest client version supported by the Kubespaces API server, and warn when an
+update is available or required.
+
+Examples:
+  spacectl version
+  spacectl version --check`,
+	Args: cobra.NoArgs,
+	RunE: runVersion,
 }
 
+var versionCheck bool
+
+// releaseCheckTimeout bounds the request for the latest release
+const releaseCheckTimeout = 10 * time.Second
+
 func init() {
 	rootCmd.AddCommand(versionCmd)
+
+	versionCmd.Flags().BoolVar(&versionCheck, "check", false, "Check for a newer release and for compatibility with the API server")
+}
+
+// versionReport is the structured form of version --check used for JSON/YAML output
+type versionReport struct {
+	Version          string `json:"version" yaml:"version"`
+	LatestRelease    string `json:"latest_release,omitempty" yaml:"latest_release,omitempty"`
+	UpdateAvailable  bool   `json:"update_available" yaml:"update_available"`
+	ServerVersion    string `json:"server_version,omitempty" yaml:"server_version,omitempty"`
+	MinClientVersion string `json:"min_client_version,omitempty" yaml:"min_client_version,omitempty"`
+	Compatible       bool   `json:"compatible" yaml:"compatible"`
+}
+
+func runVersion(cmd *cobra.Command, args []string) error {
+	if !versionCheck {
+		fmt.Println("spacectl", version.Version)
+		return nil
+	}
+	ctx := cmd.Context()
+
+	report := versionReport{Version: version.Version, Compatible: true}
+
+	// Failed checks are reported but do not fail the command
+	if latest, err := version.LatestRelease(ctx, releaseCheckClient()); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not check for the latest release: %v\n", err)
+	} else {
+		report.LatestRelease = latest
+		if cmp, err := version.Compare(version.Version, latest); err == nil && cmp < 0 {
+			report.UpdateAvailable = true
+		}
+	}
+
+	if server, err := api.NewVersionAPI(apiClient()).GetServerVersion(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not get the server version from %s: %v\n", cfg.APIURL, err)
+	} else {
+		report.ServerVersion = server.Version
+		report.MinClientVersion = server.MinClientVersion
+		if server.MinClientVersion != "" {
+			if cmp, err := version.Compare(version.Version, server.MinClientVersion); err == nil && cmp < 0 {
+				report.Compatible = false
+			}
+		}
+	}
+
+	// Structured formats get the whole report as a single document
+	if output.Format(outputFmt) != output.FormatTable {
+		return formatter.FormatData(report)
+	}
+
+	fmt.Printf("Client version:      %s\n", report.Version)
+	fmt.Printf("Latest release:      %s\n", valueOrUnknown(report.LatestRelease))
+	fmt.Printf("Server version:      %s\n", valueOrUnknown(report.ServerVersion))
+	if report.ServerVersion != "" {
+		fmt.Printf("Min client version:  %s\n", valueOrNone(report.MinClientVersion))
+	}
+
+	if !report.Compatible {
+		fmt.Fprintf(os.Stderr, "\nWarning: spacectl %s is older than %s, the oldest version supported by the server at %s. Upgrade spacectl before using it with this server.\n",
+			report.Version, report.MinClientVersion, cfg.APIURL)
+	} else if report.UpdateAvailable && !quiet {
+		fmt.Printf("\nA newer release is available: %s\n", report.LatestRelease)
+	}
+	return nil
+}
+
+// releaseCheckClient returns an HTTP client for the release check that goes
+// through the configured proxy, like requests to the API. Of the API's TLS
+// settings only the extra CA certificates are trusted: GitHub is verified even
+// with --insecure-skip-tls-verify and is never sent the client certificate.
+func releaseCheckClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	if proxyURL != nil {
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	if tlsConfig != nil && tlsConfig.RootCAs != nil {
+		transport.TLSClientConfig = &tls.Config{RootCAs: tlsConfig.RootCAs}
+	}
+	return &http.Client{Transport: transport, Timeout: releaseCheckTimeout}
+}
+
+// valueOrUnknown returns s, or "(unknown)" if it is empty
+func valueOrUnknown(s string) string {
+	if s == "" {
+		return "(unknown)"
+	}
+	return s
 }
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"testing"
+)
+
+func TestReleaseCheckClientTLS(t *testing.T) {
+	pool := x509.NewCertPool()
+	setForTest(t, &tlsConfig, &tls.Config{
+		InsecureSkipVerify: true,
+		RootCAs:            pool,
+		Certificates:       []tls.Certificate{{}},
+	})
+
+	got := releaseCheckClient().Transport.(*http.Transport).TLSClientConfig
+	if got == nil || got.RootCAs != pool {
+		t.Fatalf("expected the extra CA certificates to be trusted, got %+v", got)
+	}
+	if got.InsecureSkipVerify {
+		t.Error("expected the release check to verify certificates")
+	}
+	if len(got.Certificates) != 0 {
+		t.Error("expected the client certificate not to be sent to GitHub")
+	}
+
+	// Without an extra CA the default settings are kept
+	setForTest(t, &tlsConfig, &tls.Config{InsecureSkipVerify: true})
+	if got := releaseCheckClient().Transport.(*http.Transport).TLSClientConfig; got != nil && (got.InsecureSkipVerify || got.RootCAs != nil) {
+		t.Errorf("expected the default TLS settings, got %+v", got)
+	}
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"spacectl/internal/output"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// Watch flags, registered on every list command by addListFlags
+var (
+	listWatch         bool
+	listWatchInterval time.Duration
+)
+
+// watching is set while a watch runs, so commands run by a watched command,
+// such as the list commands behind 'get', render once per refresh
+var watching bool
+
+// watchable wraps a list command's RunE so that with --watch it re-fetches and
+// re-renders the list every --interval
+func watchable(run func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if !listWatch || watching {
+			return run(cmd, args)
+		}
+		title := strings.Join(append([]string{cmd.CommandPath()}, args...), " ")
+		return watch(cmd.Context(), title, listWatchInterval, func() error {
+			return run(cmd, args)
+		})
+	}
+}
+
+// watch calls render every interval until Ctrl-C, redrawing the screen with
+// what it writes through the formatter. On a terminal, lines that changed since
+// the previous refresh are highlighted.
+func watch(ctx context.Context, title string, interval time.Duration, render func() error) error {
+	if interval <= 0 {
+		return fmt.Errorf("--interval must be greater than zero")
+	}
+	watching = true
+	defer func() { watching = false }()
+
+	tty := term.IsTerminal(int(os.Stdout.Fd()))
+	previous := ""
+	for {
+		// Render into a buffer so the refresh can be compared with the previous one
+		var buf bytes.Buffer
+		stdout := formatter
+		formatter = stdout.WithWriter(&buf)
+		err := render()
+		formatter = stdout
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		current := buf.String()
+		if note := truncationNote(); note != "" {
+			current += note + "\n"
+		}
+		if tty {
+			// Clear the screen before each refresh
+			fmt.Print("\033[H\033[2J")
+		}
+		fmt.Printf("Every %s: %s (%s)\n\n", interval, title, time.Now().Format(time.RFC1123))
+		if tty {
+			fmt.Print(output.HighlightChanges(previous, current))
+		} else {
+			fmt.Println(current)
+		}
+		previous = current
+
+		// Stop cleanly on Ctrl-C
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -21,17 +21,19 @@ func init() {
 }
 
 func runWhoami(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
 	// Check if user is authenticated
 	if !cfg.IsAuthenticated() {
-		return fmt.Errorf("not authenticated. Please run 'spacectl login' first")
+		return errNotAuthenticated
 	}
 
 	// Create API client
-    client := api.NewClient(cfg.APIURL, cfg, debug)
+	client := apiClient()
 	authAPI := api.NewAuthAPI(client)
 
 	// Get user info
-	user, err := authAPI.GetUserInfo()
+	user, err := authAPI.GetUserInfo(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get user info: %w", err)
 	}
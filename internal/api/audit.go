@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"spacectl/internal/models"
+)
+
+// AuditAPI handles audit log API calls
+type AuditAPI struct {
+	client *Client
+}
+
+// NewAuditAPI creates a new AuditAPI
+func NewAuditAPI(client *Client) *AuditAPI {
+	return &AuditAPI{client: client}
+}
+
+// AuditFilter restricts audit log entries to a project or a time range. Zero
+// fields match all.
+type AuditFilter struct {
+	ProjectID string
+	// Since and Until bound the entries to [Since, Until)
+	Since time.Time
+	Until time.Time
+}
+
+// path returns the audit log endpoint of an organization with the filter as
+// query parameters
+func (f AuditFilter) path(orgID string) string {
+	endpoint := fmt.Sprintf("/api/v1/organizations/%s/audit-log", orgID)
+	q := url.Values{}
+	if f.ProjectID != "" {
+		q.Set("project_id", f.ProjectID)
+	}
+	if !f.Since.IsZero() {
+		q.Set("since", f.Since.UTC().Format(time.RFC3339))
+	}
+	if !f.Until.IsZero() {
+		q.Set("until", f.Until.UTC().Format(time.RFC3339))
+	}
+	if len(q) == 0 {
+		return endpoint
+	}
+	return endpoint + "?" + q.Encode()
+}
+
+// ListAuditLog lists the audit log entries of an organization, oldest first
+func (a *AuditAPI) ListAuditLog(ctx context.Context, orgID string, filter AuditFilter) ([]models.AuditEntry, error) {
+	if err := a.client.RequireFeature(ctx, FeatureAuditLog); err != nil {
+		return nil, err
+	}
+	return list[models.AuditEntry](ctx, a.client, filter.path(orgID))
+}
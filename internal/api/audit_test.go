@@ -0,0 +1,19 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestAuditAPI(t *testing.T) {
+	runAPICases(t, "audit", []apiCase{
+		{name: "list_audit_log", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewAuditAPI(c).ListAuditLog(ctx, "org-1", AuditFilter{
+				ProjectID: "proj-1",
+				Since:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				Until:     time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+			})
+		}},
+	})
+}
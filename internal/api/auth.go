@@ -1,10 +1,10 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"spacectl/internal/models"
-	"time"
 )
 
 // AuthAPI handles authentication-related API calls
@@ -18,13 +18,14 @@ func NewAuthAPI(client *Client) *AuthAPI {
 }
 
 // Login authenticates a user with email and password
-func (a *AuthAPI) Login(email, password string) (*models.LoginResponse, error) {
+func (a *AuthAPI) Login(ctx context.Context, email, password string) (*models.LoginResponse, error) {
 	req := models.LoginRequest{
 		Email:    email,
 		Password: password,
 	}
 
-	resp, err := a.client.doRequest("POST", "/api/v1/user/login", req)
+	// A 401 means wrong credentials, not an expired session of a previous login
+	resp, err := a.client.doRequest(withoutRefresh(ctx), "POST", "/api/v1/user/login", req)
 	if err != nil {
 		return nil, err
 	}
@@ -38,13 +39,13 @@ func (a *AuthAPI) Login(email, password string) (*models.LoginResponse, error) {
 }
 
 // Register registers a new user
-func (a *AuthAPI) Register(email, password string) error {
+func (a *AuthAPI) Register(ctx context.Context, email, password string) error {
 	req := models.LoginRequest{
 		Email:    email,
 		Password: password,
 	}
 
-	resp, err := a.client.doRequest("POST", "/api/v1/user/register", req)
+	resp, err := a.client.doRequest(ctx, "POST", "/api/v1/user/register", req)
 	if err != nil {
 		return err
 	}
@@ -53,13 +54,13 @@ func (a *AuthAPI) Register(email, password string) error {
 }
 
 // VerifyEmail verifies a user's email with a code
-func (a *AuthAPI) VerifyEmail(email, code string) error {
+func (a *AuthAPI) VerifyEmail(ctx context.Context, email, code string) error {
 	req := models.VerifyEmailRequest{
 		Email: email,
 		Code:  code,
 	}
 
-	resp, err := a.client.doRequest("POST", "/api/v1/user/verify", req)
+	resp, err := a.client.doRequest(ctx, "POST", "/api/v1/user/verify", req)
 	if err != nil {
 		return err
 	}
@@ -68,12 +69,12 @@ func (a *AuthAPI) VerifyEmail(email, code string) error {
 }
 
 // ResendVerificationCode resends a verification code
-func (a *AuthAPI) ResendVerificationCode(email string) error {
+func (a *AuthAPI) ResendVerificationCode(ctx context.Context, email string) error {
 	req := models.ResendVerificationRequest{
 		Email: email,
 	}
 
-	resp, err := a.client.doRequest("POST", "/api/v1/user/verify/resend", req)
+	resp, err := a.client.doRequest(ctx, "POST", "/api/v1/user/verify/resend", req)
 	if err != nil {
 		return err
 	}
@@ -81,9 +82,66 @@ func (a *AuthAPI) ResendVerificationCode(email string) error {
 	return a.client.handleResponse(resp, nil)
 }
 
+// ChangePassword changes the current user's password and revokes their other sessions.
+// The response carries fresh tokens for the current session.
+func (a *AuthAPI) ChangePassword(ctx context.Context, currentPassword, newPassword string) (*models.LoginResponse, error) {
+	req := models.ChangePasswordRequest{
+		CurrentPassword:     currentPassword,
+		NewPassword:         newPassword,
+		RevokeOtherSessions: true,
+	}
+
+	resp, err := a.client.doRequest(ctx, "POST", "/api/v1/user/password", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var loginResp models.LoginResponse
+	if err := a.client.handleResponse(resp, &loginResp); err != nil {
+		return nil, err
+	}
+
+	return &loginResp, nil
+}
+
 // GetUserInfo gets the current user's information
-func (a *AuthAPI) GetUserInfo() (*models.User, error) {
-	resp, err := a.client.doRequest("GET", "/api/v1/user/info", nil)
+func (a *AuthAPI) GetUserInfo(ctx context.Context) (*models.User, error) {
+	resp, err := a.client.doRequest(ctx, "GET", "/api/v1/user/info", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var user models.User
+	if err := a.client.handleResponse(resp, &user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// CheckAccess asks the server whether the current user may take an action on a
+// kind of resource in the organization or project of the request
+func (a *AuthAPI) CheckAccess(ctx context.Context, req models.AccessCheckRequest) (*models.AccessCheck, error) {
+	if err := a.client.RequireFeature(ctx, FeatureAccessCheck); err != nil {
+		return nil, err
+	}
+
+	resp, err := a.client.doRequest(ctx, "POST", "/api/v1/user/access-check", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var check models.AccessCheck
+	if err := a.client.handleResponse(resp, &check); err != nil {
+		return nil, err
+	}
+
+	return &check, nil
+}
+
+// UpdateUser updates the current user's profile
+func (a *AuthAPI) UpdateUser(ctx context.Context, req models.UpdateUserRequest) (*models.User, error) {
+	resp, err := a.client.doRequest(ctx, "PATCH", "/api/v1/user/info", req)
 	if err != nil {
 		return nil, err
 	}
@@ -97,8 +155,8 @@ func (a *AuthAPI) GetUserInfo() (*models.User, error) {
 }
 
 // UpdatePreferences updates user preferences
-func (a *AuthAPI) UpdatePreferences(prefs *models.UserPreferences) error {
-	resp, err := a.client.doRequest("PUT", "/api/v1/user/preferences", prefs)
+func (a *AuthAPI) UpdatePreferences(ctx context.Context, prefs *models.UserPreferences) error {
+	resp, err := a.client.doRequest(ctx, "PUT", "/api/v1/user/preferences", prefs)
 	if err != nil {
 		return err
 	}
@@ -107,7 +165,7 @@ func (a *AuthAPI) UpdatePreferences(prefs *models.UserPreferences) error {
 }
 
 // GetGithubAuthURL gets the GitHub OAuth authorization URL
-func (a *AuthAPI) GetGithubAuthURL(callbackPort string) (string, error) {
+func (a *AuthAPI) GetGithubAuthURL(ctx context.Context, callbackPort string) (string, error) {
 	// Use a simple GET request to trigger the OAuth flow
 	// The backend will redirect to GitHub with proper state handling
 	url := "/api/v1/auth/github?cli=true"
@@ -118,21 +176,23 @@ func (a *AuthAPI) GetGithubAuthURL(callbackPort string) (string, error) {
 	// Create a custom HTTP client that doesn't follow redirects
 	// so we can capture the Location header
 	client := &http.Client{
+		Transport: a.client.transport,
 		CheckRedirect: func(req *http.Request, via []*http.Request) error {
 			return http.ErrUseLastResponse // Don't follow redirects
 		},
-		Timeout: 30 * time.Second,
+		Timeout: a.client.httpClient.Timeout,
 	}
 
-	req, err := http.NewRequest("GET", a.client.baseURL+url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", a.client.baseURL+url, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
 	req.Header.Set("Content-Type", "application/json")
-	if a.client.config.AccessToken != "" {
-		req.Header.Set("Authorization", "Bearer "+a.client.config.AccessToken)
+	req.Header.Set("User-Agent", userAgent())
+	if token := a.client.accessToken(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
 
 	resp, err := client.Do(req)
@@ -153,10 +213,10 @@ func (a *AuthAPI) GetGithubAuthURL(callbackPort string) (string, error) {
 }
 
 // HandleGithubCallback handles the GitHub OAuth callback
-func (a *AuthAPI) HandleGithubCallback(code, state string) (*models.LoginResponse, error) {
+func (a *AuthAPI) HandleGithubCallback(ctx context.Context, code, state string) (*models.LoginResponse, error) {
 	url := fmt.Sprintf("/api/v1/auth/github/callback?code=%s&state=%s", code, state)
 
-	resp, err := a.client.doRequest("GET", url, nil)
+	resp, err := a.client.doRequest(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"spacectl/internal/models"
+)
+
+func TestAuthAPI(t *testing.T) {
+	displayName := "Dev User"
+
+	runAPICases(t, "auth", []apiCase{
+		{name: "login", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewAuthAPI(c).Login(ctx, "user@example.com", "s3cret-pass")
+		}},
+		{name: "register", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewAuthAPI(c).Register(ctx, "user@example.com", "s3cret-pass")
+		}},
+		{name: "verify_email", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewAuthAPI(c).VerifyEmail(ctx, "user@example.com", "123456")
+		}},
+		{name: "resend_verification_code", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewAuthAPI(c).ResendVerificationCode(ctx, "user@example.com")
+		}},
+		{name: "change_password", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewAuthAPI(c).ChangePassword(ctx, "s3cret-pass", "n3w-pass")
+		}},
+		{name: "get_user_info", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewAuthAPI(c).GetUserInfo(ctx)
+		}},
+		{name: "check_access", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewAuthAPI(c).CheckAccess(ctx, models.AccessCheckRequest{Action: "create", Resource: "tenant", ProjectID: "proj-1"})
+		}},
+		{name: "update_user", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewAuthAPI(c).UpdateUser(ctx, models.UpdateUserRequest{DisplayName: &displayName})
+		}},
+		{name: "update_preferences", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewAuthAPI(c).UpdatePreferences(ctx, &models.UserPreferences{WelcomeDismissed: true, Theme: "dark"})
+		}},
+		{name: "get_github_auth_url", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewAuthAPI(c).GetGithubAuthURL(ctx, "8765")
+		}},
+		{name: "handle_github_callback", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewAuthAPI(c).HandleGithubCallback(ctx, "gh-code", "gh-state")
+		}},
+	})
+}
+
+func TestUserAPI(t *testing.T) {
+	runAPICases(t, "users", []apiCase{
+		{name: "lookup_user_by_email", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewUserAPI(c).LookupUserByEmail(ctx, "dev+test@example.com")
+		}},
+		{name: "lookup_user_by_email_not_found", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewUserAPI(c).LookupUserByEmail(ctx, "nobody@example.com")
+		}},
+		{name: "list_users_by_id", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewUserAPI(c).ListUsersByID(ctx, []string{"user-1", "user-2"})
+		}},
+	})
+}
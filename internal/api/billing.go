@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"spacectl/internal/models"
+)
+
+// BillingAPI handles billing API calls
+type BillingAPI struct {
+	client *Client
+}
+
+// NewBillingAPI creates a new BillingAPI
+func NewBillingAPI(client *Client) *BillingAPI {
+	return &BillingAPI{client: client}
+}
+
+// ListTenantCosts lists what each tenant of an organization cost in the calendar
+// month containing month. A non-empty projectID restricts it to that project's
+// tenants.
+func (b *BillingAPI) ListTenantCosts(ctx context.Context, orgID string, month time.Time, projectID string) ([]models.TenantCost, error) {
+	if err := b.client.RequireFeature(ctx, FeatureTenantCosts); err != nil {
+		return nil, err
+	}
+
+	q := url.Values{}
+	q.Set("month", month.Format("2006-01"))
+	if projectID != "" {
+		q.Set("project_id", projectID)
+	}
+	path := fmt.Sprintf("/api/v1/organizations/%s/costs?%s", orgID, q.Encode())
+	return list[models.TenantCost](ctx, b.client, path)
+}
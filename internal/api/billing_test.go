@@ -0,0 +1,16 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBillingAPI(t *testing.T) {
+	runAPICases(t, "billing", []apiCase{
+		{name: "list_tenant_costs", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			month := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			return NewBillingAPI(c).ListTenantCosts(ctx, "org-1", month, "proj-1")
+		}},
+	})
+}
@@ -0,0 +1,212 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ErrOffline is returned in offline mode for requests that need the API, such as
+// changes or reads that were never cached
+var ErrOffline = errors.New("not available in offline mode")
+
+// cacheEntry is a stored GET response for a slow-changing endpoint
+type cacheEntry struct {
+	URL      string      `json:"url"`
+	ETag     string      `json:"etag,omitempty"`
+	Header   http.Header `json:"header,omitempty"`
+	Body     []byte      `json:"body"`
+	StoredAt time.Time   `json:"stored_at"`
+}
+
+// responseCache stores responses on disk, one file per URL and user
+type responseCache struct {
+	dir string
+}
+
+// DefaultCacheDir returns the directory used for cached API responses
+func DefaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "spacectl", "responses")
+}
+
+// EnableCache caches responses of read requests (locations, Kubernetes and server
+// versions, organizations, projects, tenants) in dir, revalidating them with ETags
+func (c *Client) EnableCache(dir string) {
+	c.cache = &responseCache{dir: dir}
+}
+
+// SetOffline serves read requests from the response cache without contacting the
+// API. Other requests fail with ErrOffline.
+func (c *Client) SetOffline(offline bool) {
+	c.offline = offline
+}
+
+// SetCacheMaxAge uses cached responses younger than maxAge without contacting
+// the API, e.g. for shell completion, which must answer quickly. Older ones are
+// revalidated as usual; 0, the default, always revalidates.
+func (c *Client) SetCacheMaxAge(maxAge time.Duration) {
+	c.cacheMaxAge = maxAge
+}
+
+// OfflineAsOf returns when the oldest cached response served in offline mode was
+// stored, or the zero time if none was served
+func (c *Client) OfflineAsOf() time.Time {
+	c.offlineMu.Lock()
+	defer c.offlineMu.Unlock()
+	return c.offlineAsOf
+}
+
+// path returns the file for a URL, keyed by user so accounts never share entries
+func (rc *responseCache) path(url, user string) string {
+	sum := sha256.Sum256([]byte(user + "\x00" + url))
+	return filepath.Join(rc.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (rc *responseCache) load(url, user string) *cacheEntry {
+	data, err := os.ReadFile(rc.path(url, user))
+	if err != nil {
+		return nil
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.URL != url {
+		return nil
+	}
+	return &entry
+}
+
+func (rc *responseCache) store(user string, entry *cacheEntry) error {
+	if err := os.MkdirAll(rc.dir, 0700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so concurrent readers never see a partial entry
+	tmp, err := os.CreateTemp(rc.dir, "entry-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), rc.path(entry.URL, user))
+}
+
+// getCached performs a GET through the response cache. A cached response with an
+// ETag is revalidated with If-None-Match and reused when the server answers 304.
+// In offline mode the cached response is returned as-is. Without a cache it is a
+// plain GET.
+func (c *Client) getCached(ctx context.Context, path string) (*http.Response, error) {
+	if c.cache == nil {
+		return c.doRequest(ctx, "GET", path, nil)
+	}
+
+	url := c.baseURL + path
+	user := c.config.UserEmail
+	entry := c.cache.load(url, user)
+
+	if c.offline {
+		if entry == nil {
+			return nil, fmt.Errorf("%w: no cached response for %s, run the command once while online", ErrOffline, path)
+		}
+		c.offlineMu.Lock()
+		if c.offlineAsOf.IsZero() || entry.StoredAt.Before(c.offlineAsOf) {
+			c.offlineAsOf = entry.StoredAt
+		}
+		c.offlineMu.Unlock()
+		return cachedResponse(nil, entry), nil
+	}
+	if entry != nil && c.cacheMaxAge > 0 && time.Since(entry.StoredAt) < c.cacheMaxAge {
+		c.log.Debug("using fresh cached response", "url", url)
+		return cachedResponse(nil, entry), nil
+	}
+
+	header := http.Header{}
+	if entry != nil && entry.ETag != "" {
+		header.Set("If-None-Match", entry.ETag)
+	}
+
+	resp, err := c.doRequestWithHeader(ctx, "GET", path, nil, header)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && entry != nil:
+		discardBody(resp)
+		c.log.Debug("using cached response", "url", url)
+		entry.StoredAt = time.Now()
+		c.storeCacheEntry(user, entry)
+		return cachedResponse(resp.Request, entry), nil
+
+	case resp.StatusCode == http.StatusOK:
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		entry := &cacheEntry{
+			URL:      url,
+			ETag:     resp.Header.Get("ETag"),
+			Header:   cachedHeaders(resp.Header),
+			Body:     body,
+			StoredAt: time.Now(),
+		}
+		c.storeCacheEntry(user, entry)
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return resp, nil
+
+	default:
+		return resp, nil
+	}
+}
+
+// storeCacheEntry saves an entry, treating failures as a cache miss next time
+func (c *Client) storeCacheEntry(user string, entry *cacheEntry) {
+	if err := c.cache.store(user, entry); err != nil {
+		c.log.Warn("failed to cache response", "url", entry.URL, "error", err)
+	}
+}
+
+// cachedHeaders keeps the response headers needed to replay a cached response
+func cachedHeaders(header http.Header) http.Header {
+	kept := http.Header{}
+	for _, key := range []string{"Content-Type", "ETag", "Link"} {
+		if values := header.Values(key); len(values) > 0 {
+			kept[key] = values
+		}
+	}
+	return kept
+}
+
+// cachedResponse rebuilds a 200 response from a cache entry
+func cachedResponse(req *http.Request, entry *cacheEntry) *http.Response {
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     entry.Header.Clone(),
+		Body:       io.NopCloser(bytes.NewReader(entry.Body)),
+		Request:    req,
+	}
+}
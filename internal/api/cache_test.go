@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"spacectl/internal/config"
+)
+
+func TestGetCachedRevalidatesWithETag(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`["aws","gcp"]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{UserEmail: "user@example.com"}, false)
+	client.EnableCache(t.TempDir())
+	tenantAPI := NewTenantAPI(client)
+
+	for i := 0; i < 2; i++ {
+		clouds, err := tenantAPI.GetAvailableClouds(context.Background())
+		if err != nil {
+			t.Fatalf("request %d: unexpected error: %v", i+1, err)
+		}
+		if len(clouds) != 2 || clouds[1] != "gcp" {
+			t.Fatalf("request %d: unexpected clouds %v", i+1, clouds)
+		}
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestGetCachedServesFreshEntries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`["aws","gcp"]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{UserEmail: "user@example.com"}, false)
+	client.EnableCache(t.TempDir())
+	client.SetCacheMaxAge(time.Minute)
+	tenantAPI := NewTenantAPI(client)
+
+	for i := 0; i < 2; i++ {
+		clouds, err := tenantAPI.GetAvailableClouds(context.Background())
+		if err != nil {
+			t.Fatalf("request %d: unexpected error: %v", i+1, err)
+		}
+		if len(clouds) != 2 {
+			t.Fatalf("request %d: unexpected clouds %v", i+1, clouds)
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("expected the fresh entry to be used without a request, got %d requests", requests)
+	}
+}
+
+func TestGetCachedIsPerUser(t *testing.T) {
+	var conditional []bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conditional = append(conditional, r.Header.Get("If-None-Match") != "")
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	for _, user := range []string{"alice@example.com", "bob@example.com"} {
+		client := NewClient(server.URL, &config.Config{UserEmail: user}, false)
+		client.EnableCache(dir)
+		if _, err := NewOrganizationAPI(client).ListUserOrganizations(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(conditional) != 2 || conditional[1] {
+		t.Fatalf("expected the second user not to reuse the first user's entry, got %v", conditional)
+	}
+}
+
+func TestGetCachedWithoutCache(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			t.Errorf("expected no conditional request without a cache")
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{}, false)
+	for i := 0; i < 2; i++ {
+		if _, err := NewTenantAPI(client).GetAvailableLocations(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func TestOfflineServesCachedResponses(t *testing.T) {
+	online := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !online {
+			t.Errorf("unexpected request in offline mode: %s %s", r.Method, r.URL)
+		}
+		w.Write([]byte(`[{"id":"t-1","name":"dev"}]`))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	client := NewClient(server.URL, &config.Config{UserEmail: "user@example.com"}, false)
+	client.EnableCache(dir)
+	if _, err := NewTenantAPI(client).ListProjectTenants(context.Background(), "p-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	online = false
+	client = NewClient(server.URL, &config.Config{UserEmail: "user@example.com"}, false)
+	client.EnableCache(dir)
+	client.SetOffline(true)
+	tenantAPI := NewTenantAPI(client)
+
+	tenants, err := tenantAPI.ListProjectTenants(context.Background(), "p-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tenants) != 1 || tenants[0].Name != "dev" {
+		t.Fatalf("unexpected tenants %+v", tenants)
+	}
+	if client.OfflineAsOf().IsZero() {
+		t.Fatalf("expected the age of the cached data to be recorded")
+	}
+
+	if _, err := tenantAPI.ListProjectTenants(context.Background(), "p-2"); !errors.Is(err, ErrOffline) {
+		t.Fatalf("expected ErrOffline for an uncached list, got %v", err)
+	}
+	if err := tenantAPI.DeleteTenant(context.Background(), "t-1"); !errors.Is(err, ErrOffline) {
+		t.Fatalf("expected ErrOffline for a change, got %v", err)
+	}
+}
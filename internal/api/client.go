@@ -2,99 +2,428 @@ package api
 
 import (
 	"bytes"
+	"context"
+	crand "crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
+	"math/rand"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"spacectl/internal/config"
+	"spacectl/internal/logging"
 	"spacectl/internal/models"
+	"spacectl/internal/version"
+)
+
+// Defaults for request retries and timeouts
+const (
+	DefaultRetries        = 3
+	DefaultTimeout        = 30 * time.Second
+	DefaultConnectTimeout = 10 * time.Second
+)
+
+// requestIDHeader carries the ID used to correlate a request with server logs
+const requestIDHeader = "X-Request-ID"
+
+// maxIdleConnsPerHost keeps enough idle connections to the API for concurrent
+// requests (such as per-project tenant counts) to reuse them instead of redialing
+const maxIdleConnsPerHost = 16
+
+// Backoff bounds for retried requests
+var (
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 10 * time.Second
+	maxRetryAfter  = 2 * time.Minute
 )
 
 // Client represents the API client
 type Client struct {
 	baseURL    string
 	httpClient *http.Client
+	transport  *http.Transport
 	config     *config.Config
-	debug      bool
+	log        *slog.Logger
+	retries    int
+	cache      *responseCache
+	// cacheMaxAge is how long cached responses are used without revalidation
+	cacheMaxAge time.Duration
+	// curl receives the equivalent curl command of each API call
+	curl io.Writer
+
+	// offline serves reads from cache; offlineAsOf is the age of the oldest one served
+	offline     bool
+	offlineMu   sync.Mutex
+	offlineAsOf time.Time
+
+	// authMu guards the tokens in config so that concurrent requests hitting
+	// 401 refresh them once and the config file is written by one goroutine
+	authMu sync.Mutex
+
+	// warnings receives each distinct Warning header from the API once
+	warnings     io.Writer
+	warningsMu   sync.Mutex
+	seenWarnings map[string]bool
+
+	// serverVersion is fetched once, on the first feature check
+	serverVersionMu      sync.Mutex
+	serverVersionFetched bool
+	serverVersion        string
 }
 
-// NewClient creates a new API client
+// NewClient creates a new API client. With debug set, requests are logged to
+// stderr; use SetLogger to log elsewhere or at another level.
 func NewClient(baseURL string, cfg *config.Config, debug bool) *Client {
-	return &Client{
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	c := &Client{
 		baseURL: baseURL,
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Transport: transport,
 		},
-		config: cfg,
-		debug:  debug,
+		transport: transport,
+		config:    cfg,
+		log:       logging.Discard(),
+		retries:   DefaultRetries,
+		warnings:  os.Stderr,
+	}
+	if debug {
+		c.log = logging.New(os.Stderr, slog.LevelDebug)
+	}
+	c.SetTimeouts(DefaultConnectTimeout, DefaultTimeout)
+	return c
+}
+
+// SetTimeouts sets how long to wait for a connection (including the TLS handshake)
+// and for a whole request including reading the response. Zero leaves a timeout unchanged.
+func (c *Client) SetTimeouts(connect, overall time.Duration) {
+	if connect > 0 {
+		dialer := &net.Dialer{Timeout: connect, KeepAlive: 30 * time.Second}
+		c.transport.DialContext = dialer.DialContext
+		c.transport.TLSHandshakeTimeout = connect
+	}
+	if overall > 0 {
+		c.httpClient.Timeout = overall
+	}
+}
+
+// SetProxy routes requests through the given proxy. A nil proxy uses the
+// HTTP_PROXY, HTTPS_PROXY, and NO_PROXY environment variables.
+func (c *Client) SetProxy(proxy *url.URL) {
+	if proxy == nil {
+		c.transport.Proxy = http.ProxyFromEnvironment
+		return
+	}
+	c.transport.Proxy = http.ProxyURL(proxy)
+}
+
+// SetLogger sets where requests, their timing, retries, and token refreshes are
+// logged. Nil disables logging.
+func (c *Client) SetLogger(logger *slog.Logger) {
+	if logger == nil {
+		logger = logging.Discard()
+	}
+	c.log = logger
+}
+
+// SetRetries sets how many times idempotent requests are retried on server or network errors
+func (c *Client) SetRetries(retries int) {
+	if retries < 0 {
+		retries = 0
 	}
+	c.retries = retries
 }
 
 // doRequest performs an HTTP request with authentication
-func (c *Client) doRequest(method, path string, body interface{}) (*http.Response, error) {
-	var reqBody io.Reader
-	var debugBody []byte
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	return c.doRequestWithHeader(ctx, method, path, body, nil)
+}
+
+// doRequestWithHeader performs an HTTP request with authentication and extra headers
+func (c *Client) doRequestWithHeader(ctx context.Context, method, path string, body interface{}, header http.Header) (*http.Response, error) {
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewBuffer(jsonBody)
-		debugBody = jsonBody
 	}
+	return c.doRawRequest(ctx, method, path, jsonBody, header)
+}
 
-	req, err := http.NewRequest(method, c.baseURL+path, reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+// doRawRequest performs an HTTP request with an already encoded JSON body
+func (c *Client) doRawRequest(ctx context.Context, method, path string, jsonBody []byte, header http.Header) (*http.Response, error) {
+	if c.offline {
+		return nil, fmt.Errorf("%w: %s %s needs the API", ErrOffline, method, path)
 	}
 
-	// Set headers
-	req.Header.Set("Content-Type", "application/json")
-	if c.config.AccessToken != "" {
-		req.Header.Set("Authorization", "Bearer "+c.config.AccessToken)
-	}
+	// Retries of this request share its ID so they can be traced together
+	ctx = context.WithValue(ctx, requestIDKey{}, newRequestID())
 
-	if c.debug {
-		fmt.Fprintf(os.Stderr, "[spacectl] -> %s %s\n", method, c.baseURL+path)
-		if len(debugBody) > 0 {
-			redacted := redactSensitiveJSON(debugBody)
-			fmt.Fprintf(os.Stderr, "[spacectl]    body: %s\n", string(redacted))
+	url := c.baseURL + path
+	if logging.Enabled(c.log, slog.LevelDebug) {
+		attrs := []any{"method", method, "url", url, "request_id", requestIDFrom(ctx)}
+		if len(jsonBody) > 0 {
+			attrs = append(attrs, "body", string(redactSensitiveJSON(jsonBody)))
 		}
+		c.log.Debug("request", attrs...)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	c.printCurl(method, url, c.requestHeader(ctx, header), jsonBody)
+
+	start := time.Now()
+	resp, err := c.sendWithRetry(ctx, method, url, jsonBody, header)
 	if err != nil {
+		c.log.Error("request failed", "method", method, "url", url, "request_id", requestIDFrom(ctx),
+			"duration", time.Since(start), "error", err)
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
 	// Handle 401 - try to refresh token
-	if resp.StatusCode == http.StatusUnauthorized && c.config.RefreshToken != "" {
-		resp.Body.Close()
+	if resp.StatusCode == http.StatusUnauthorized && ctx.Value(noRefreshKey{}) == nil && c.canRefresh() {
+		staleToken := strings.TrimPrefix(resp.Request.Header.Get("Authorization"), "Bearer ")
+		discardBody(resp)
 
-		// Try to refresh token
-		if err := c.refreshToken(); err != nil {
+		// Try to refresh token, unless another request already has
+		if err := c.refreshToken(ctx, staleToken); err != nil {
 			return nil, fmt.Errorf("authentication failed: %w", err)
 		}
 
 		// Retry request with new token
-		req.Header.Set("Authorization", "Bearer "+c.config.AccessToken)
-		resp, err = c.httpClient.Do(req)
+		resp, err = c.sendWithRetry(ctx, method, url, jsonBody, header)
 		if err != nil {
 			return nil, fmt.Errorf("retry request failed: %w", err)
 		}
 	}
 
-	if c.debug {
-		fmt.Fprintf(os.Stderr, "[spacectl] <- %s %s : %d\n", method, c.baseURL+path, resp.StatusCode)
-	}
+	c.log.Info("response", "method", method, "url", url, "status", resp.StatusCode,
+		"request_id", responseRequestID(resp, nil), "duration", time.Since(start))
+	c.printWarnings(resp)
 
 	return resp, nil
 }
 
+// sendWithRetry sends a request, retrying idempotent methods on 5xx responses and
+// network errors with jittered exponential backoff. Rate-limited (429) requests
+// were not processed by the server, so they are retried for any method after the
+// delay from the Retry-After header. The last response is returned as-is so the
+// caller can report the server's error.
+func (c *Client) sendWithRetry(ctx context.Context, method, url string, body []byte, header http.Header) (*http.Response, error) {
+	attempts := 1 + c.retries
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(ctx, method, url, body, header)
+		if attempt >= attempts || ctx.Err() != nil {
+			return resp, err
+		}
+
+		var delay time.Duration
+		switch {
+		case err == nil && resp.StatusCode == http.StatusTooManyRequests:
+			delay = retryAfter(resp.Header.Get("Retry-After"), attempt)
+			discardBody(resp)
+			c.log.Warn("rate limited, retrying", "method", method, "url", url,
+				"delay", delay.Round(time.Second), "attempt", attempt+1, "attempts", attempts)
+		case !isIdempotent(method) || (err == nil && resp.StatusCode < 500):
+			return resp, err
+		default:
+			reason := ""
+			if err != nil {
+				reason = err.Error()
+			} else {
+				reason = resp.Status
+				discardBody(resp)
+			}
+			delay = retryDelay(attempt)
+			c.log.Warn("retrying", "method", method, "url", url, "delay", delay.Round(time.Millisecond),
+				"attempt", attempt+1, "attempts", attempts, "reason", reason)
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// send performs a single HTTP request with the current credentials
+func (c *Client) send(ctx context.Context, method, url string, body []byte, header http.Header) (*http.Response, error) {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header = c.requestHeader(ctx, header)
+
+	return c.httpClient.Do(req)
+}
+
+// requestHeader returns the headers of a request: the given extra headers plus the
+// content type, user agent, current credentials, and request ID
+func (c *Client) requestHeader(ctx context.Context, extra http.Header) http.Header {
+	header := extra.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Content-Type", "application/json")
+	header.Set("User-Agent", userAgent())
+	if token := c.accessToken(); token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+	if id := requestIDFrom(ctx); id != "" {
+		header.Set(requestIDHeader, id)
+	}
+	return header
+}
+
+// userAgent identifies the client version and platform, e.g. "spacectl/v0.1.0 (linux/amd64)"
+func userAgent() string {
+	return fmt.Sprintf("spacectl/%s (%s/%s)", version.Version, runtime.GOOS, runtime.GOARCH)
+}
+
+// printWarnings shows the API's Warning headers, such as deprecation notices,
+// printing each distinct message once per client
+func (c *Client) printWarnings(resp *http.Response) {
+	values := resp.Header.Values("Warning")
+	if len(values) == 0 {
+		return
+	}
+
+	for _, value := range values {
+		c.warnOnce(warningText(value))
+	}
+}
+
+// warnOnce prints a warning unless the client has already printed it
+func (c *Client) warnOnce(text string) {
+	c.warningsMu.Lock()
+	defer c.warningsMu.Unlock()
+	if c.seenWarnings == nil {
+		c.seenWarnings = make(map[string]bool)
+	}
+	if text == "" || c.seenWarnings[text] {
+		return
+	}
+	c.seenWarnings[text] = true
+	fmt.Fprintf(c.warnings, "Warning: %s\n", text)
+}
+
+// warningText extracts the message from a Warning header value of the form
+// `299 - "message"` (optionally followed by a date), returning the raw value when
+// it is not in that form
+func warningText(value string) string {
+	value = strings.TrimSpace(value)
+	parts := strings.SplitN(value, " ", 3)
+	if len(parts) == 3 {
+		if _, err := strconv.Atoi(parts[0]); err == nil {
+			if quoted, err := strconv.QuotedPrefix(strings.TrimSpace(parts[2])); err == nil {
+				if text, err := strconv.Unquote(quoted); err == nil {
+					return text
+				}
+			}
+		}
+	}
+	return value
+}
+
+type requestIDKey struct{}
+
+// newRequestID returns a random ID for the X-Request-ID header
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := crand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+func requestIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// responseRequestID returns the request ID to quote in errors, preferring the
+// one the server reported over the one that was sent
+func responseRequestID(resp *http.Response, errorResp *models.ErrorResponse) string {
+	if errorResp != nil && errorResp.RequestID != "" {
+		return errorResp.RequestID
+	}
+	if id := resp.Header.Get(requestIDHeader); id != "" {
+		return id
+	}
+	if resp.Request != nil {
+		return resp.Request.Header.Get(requestIDHeader)
+	}
+	return ""
+}
+
+// discardBody drains and closes a response body that will not be read so its
+// connection can be reused
+func discardBody(resp *http.Response) {
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	resp.Body.Close()
+}
+
+// isIdempotent reports whether a request with the given method is safe to retry
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter returns how long to wait before retrying a rate-limited request. The
+// Retry-After header may hold a number of seconds or an HTTP date; when it is missing
+// or invalid the regular backoff is used. The wait is capped at maxRetryAfter.
+func retryAfter(header string, attempt int) time.Duration {
+	delay := retryDelay(attempt)
+	if header != "" {
+		if seconds, err := strconv.Atoi(strings.TrimSpace(header)); err == nil && seconds >= 0 {
+			delay = time.Duration(seconds) * time.Second
+		} else if at, err := http.ParseTime(header); err == nil {
+			delay = time.Until(at)
+		}
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	if delay > maxRetryAfter {
+		delay = maxRetryAfter
+	}
+	return delay
+}
+
+// retryDelay returns the backoff before the given retry attempt, doubling each time
+// up to retryMaxDelay, with jitter so concurrent clients do not retry in lockstep
+func retryDelay(attempt int) time.Duration {
+	delay := retryBaseDelay << (attempt - 1)
+	if delay <= 0 || delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
 // redactSensitiveJSON masks sensitive fields in a JSON payload.
 // It makes a best-effort attempt to redact common secrets like passwords and tokens.
 func redactSensitiveJSON(raw []byte) []byte {
@@ -134,17 +463,67 @@ func redactRecursive(v *interface{}) {
 	}
 }
 
+// isSensitiveKey reports whether a JSON field holds a secret, such as
+// current_password, client_secret, or refresh_token
 func isSensitiveKey(key string) bool {
-	switch strings.ToLower(key) {
-	case "password", "pass", "pwd", "access_token", "refresh_token", "token", "authorization":
+	key = strings.ToLower(key)
+	switch key {
+	case "pass", "pwd", "authorization":
 		return true
-	default:
-		return false
 	}
+	for _, s := range []string{"password", "secret", "token"} {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
 }
 
-// refreshToken refreshes the access token using the refresh token
-func (c *Client) refreshToken() error {
+// accessToken returns the current access token
+func (c *Client) accessToken() string {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	return c.config.AccessToken
+}
+
+// noRefreshKey marks requests whose 401 means wrong credentials rather than an
+// expired session, such as logins, so it is returned without refreshing tokens
+type noRefreshKey struct{}
+
+// withoutRefresh returns a context whose requests are not retried with
+// refreshed tokens when they are rejected with 401
+func withoutRefresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRefreshKey{}, true)
+}
+
+// canRefresh reports whether there is a refresh token to renew the session with
+func (c *Client) canRefresh() bool {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	return c.config.RefreshToken != ""
+}
+
+// refreshToken refreshes the access token using the refresh token after a request
+// was rejected with staleToken. Concurrent callers are serialized: once one of them
+// has refreshed, the others pick up its tokens instead of refreshing again, and
+// tokens saved by another spacectl process in the meantime are reused as well.
+func (c *Client) refreshToken(ctx context.Context, staleToken string) error {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	if c.config.AccessToken != staleToken {
+		if c.config.AccessToken == "" {
+			return fmt.Errorf("%w: session expired. Please run 'spacectl login' to re-authenticate", ErrUnauthenticated)
+		}
+		return nil
+	}
+	if saved, err := config.Load(); err == nil && saved.AccessToken != "" && saved.AccessToken != staleToken &&
+		saved.RefreshToken != "" && saved.UserEmail == c.config.UserEmail {
+		c.log.Info("using tokens refreshed by another process")
+		c.config.UpdateTokens(saved.AccessToken, saved.RefreshToken, saved.UserEmail)
+		return nil
+	}
+
 	// Build request directly to avoid recursive auto-refresh
 	payload := models.RefreshTokenRequest{RefreshToken: c.config.RefreshToken}
 	body, err := json.Marshal(payload)
@@ -153,32 +532,31 @@ func (c *Client) refreshToken() error {
 	}
 
 	url := c.baseURL + "/api/v1/user/refresh"
-	if c.debug {
-		fmt.Fprintf(os.Stderr, "[spacectl] -> POST %s\n", url)
-		fmt.Fprintf(os.Stderr, "[spacectl]    body: %s\n", string(redactSensitiveJSON(body)))
-	}
+	c.log.Debug("request", "method", "POST", "url", url, "body", string(redactSensitiveJSON(body)))
+	start := time.Now()
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
 	if err != nil {
 		return fmt.Errorf("failed to create refresh request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent())
+	c.printCurl("POST", url, req.Header, body)
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		c.log.Error("token refresh failed", "url", url, "duration", time.Since(start), "error", err)
 		return fmt.Errorf("refresh request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
-	if c.debug {
-		fmt.Fprintf(os.Stderr, "[spacectl] <- POST %s : %d\n", url, resp.StatusCode)
-	}
+	c.log.Info("response", "method", "POST", "url", url, "status", resp.StatusCode, "duration", time.Since(start))
 
 	if resp.StatusCode != http.StatusOK {
 		// Invalidate local tokens to avoid repeated failures
 		c.config.ClearAuth()
 		_ = c.config.Save()
-		return fmt.Errorf("session expired (HTTP %d). Please run 'spacectl login' to re-authenticate", resp.StatusCode)
+		return fmt.Errorf("%w: session expired (HTTP %d). Please run 'spacectl login' to re-authenticate", ErrUnauthenticated, resp.StatusCode)
 	}
 
 	var loginResp models.LoginResponse
@@ -216,12 +594,15 @@ func (c *Client) handleResponse(resp *http.Response, result interface{}) error {
 	}
 
 	// Try to parse error response
+	apiErr := &APIError{StatusCode: resp.StatusCode, Message: string(body)}
 	var errorResp models.ErrorResponse
 	if err := json.Unmarshal(body, &errorResp); err == nil {
-		return fmt.Errorf("API error (%d): %s", resp.StatusCode, errorResp.Error)
+		apiErr.Message = errorResp.Error
+		apiErr.Code = errorResp.Code
 	}
+	apiErr.RequestID = responseRequestID(resp, &errorResp)
 
-	return fmt.Errorf("API error (%d): %s", resp.StatusCode, string(body))
+	return apiErr
 }
 
 // IsAuthenticated returns true if the client has valid authentication
@@ -1,8 +1,21 @@
 package api
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"spacectl/internal/config"
+	"spacectl/internal/logging"
 )
 
 func TestRedactSensitiveJSON(t *testing.T) {
@@ -70,17 +83,388 @@ func TestRedactSensitiveJSONHandlesInvalidJSON(t *testing.T) {
 }
 
 func TestIsSensitiveKey(t *testing.T) {
-	sensitive := []string{"password", "PASS", "Pwd", "access_token", "Refresh_Token", "token", "authorization"}
+	sensitive := []string{"password", "PASS", "Pwd", "access_token", "Refresh_Token", "token", "authorization",
+		"current_password", "new_password", "NewPassword", "client_secret", "secret", "api_token"}
 	for _, key := range sensitive {
 		if !isSensitiveKey(key) {
 			t.Fatalf("expected %q to be considered sensitive", key)
 		}
 	}
 
-	nonSensitive := []string{"username", "email", "role"}
+	nonSensitive := []string{"username", "email", "role", "passport", "revoke_other_sessions"}
 	for _, key := range nonSensitive {
 		if isSensitiveKey(key) {
 			t.Fatalf("expected %q to be considered non-sensitive", key)
 		}
 	}
 }
+
+func newRetryTestClient(t *testing.T, handler func(w http.ResponseWriter, attempt int)) (*Client, *int) {
+	t.Helper()
+
+	baseDelay := retryBaseDelay
+	retryBaseDelay = time.Millisecond
+	t.Cleanup(func() { retryBaseDelay = baseDelay })
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		handler(w, calls)
+	}))
+	t.Cleanup(server.Close)
+
+	return NewClient(server.URL, &config.Config{}, false), &calls
+}
+
+func TestDoRequestRetriesIdempotentRequests(t *testing.T) {
+	client, calls := newRetryTestClient(t, func(w http.ResponseWriter, attempt int) {
+		if attempt < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id":"org-1"}`))
+	})
+
+	resp, err := client.doRequest(context.Background(), "GET", "/api/v1/organizations/org-1", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var out map[string]string
+	if err := client.handleResponse(resp, &out); err != nil {
+		t.Fatalf("expected request to succeed after retries, got %v", err)
+	}
+	if *calls != 3 {
+		t.Fatalf("expected 3 attempts, got %d", *calls)
+	}
+}
+
+func TestRequestsAreLoggedWithTiming(t *testing.T) {
+	client, _ := newRetryTestClient(t, func(w http.ResponseWriter, attempt int) {
+		if attempt < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+	var logs bytes.Buffer
+	client.SetLogger(logging.New(&logs, slog.LevelDebug))
+
+	resp, err := client.doRequest(context.Background(), "PUT", "/api/v1/user/password", map[string]string{"password": "secret"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	discardBody(resp)
+
+	out := logs.String()
+	for _, want := range []string{"level=DEBUG msg=request method=PUT", "REDACTED", "level=WARN msg=retrying", "level=INFO msg=response", "status=200", "duration="} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected logs to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "secret") {
+		t.Errorf("expected the request body to be redacted, got:\n%s", out)
+	}
+}
+
+func TestDoRequestGivesUpAfterRetries(t *testing.T) {
+	client, calls := newRetryTestClient(t, func(w http.ResponseWriter, attempt int) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	client.SetRetries(2)
+
+	resp, err := client.doRequest(context.Background(), "DELETE", "/api/v1/projects/p-1", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.handleResponse(resp, nil); err == nil {
+		t.Fatal("expected the final 502 to be reported as an error")
+	}
+	if *calls != 3 {
+		t.Fatalf("expected 3 attempts, got %d", *calls)
+	}
+}
+
+func TestDoRequestDoesNotRetryNonIdempotentRequests(t *testing.T) {
+	client, calls := newRetryTestClient(t, func(w http.ResponseWriter, attempt int) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	resp, err := client.doRequest(context.Background(), "POST", "/api/v1/projects", map[string]string{"name": "p"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if *calls != 1 {
+		t.Fatalf("expected POST to be sent once, got %d attempts", *calls)
+	}
+}
+
+func TestRetryDelayIsBounded(t *testing.T) {
+	for attempt := 1; attempt <= 40; attempt++ {
+		delay := retryDelay(attempt)
+		if delay < 0 || delay > retryMaxDelay {
+			t.Fatalf("attempt %d: delay %s outside [0, %s]", attempt, delay, retryMaxDelay)
+		}
+	}
+}
+
+func TestDoRequestRetriesRateLimitedRequests(t *testing.T) {
+	client, calls := newRetryTestClient(t, func(w http.ResponseWriter, attempt int) {
+		if attempt == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	resp, err := client.doRequest(context.Background(), "POST", "/api/v1/projects", map[string]string{"name": "p"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated || *calls != 2 {
+		t.Fatalf("expected POST to succeed on the second attempt, got %d after %d attempts", resp.StatusCode, *calls)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	if got := retryAfter("7", 1); got != 7*time.Second {
+		t.Fatalf("expected 7s from a seconds value, got %s", got)
+	}
+	if got := retryAfter("86400", 1); got != maxRetryAfter {
+		t.Fatalf("expected long waits to be capped at %s, got %s", maxRetryAfter, got)
+	}
+	date := time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)
+	if got := retryAfter(date, 1); got < 25*time.Second || got > 30*time.Second {
+		t.Fatalf("expected about 30s from an HTTP date, got %s", got)
+	}
+	if got := retryAfter("soon", 1); got > retryMaxDelay {
+		t.Fatalf("expected fallback to the regular backoff, got %s", got)
+	}
+}
+
+func TestRequestIDIsSentAndReportedInErrors(t *testing.T) {
+	var sent []string
+	client, _ := newRetryTestClient(t, func(w http.ResponseWriter, attempt int) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	client.httpClient.Transport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		sent = append(sent, r.Header.Get(requestIDHeader))
+		return http.DefaultTransport.RoundTrip(r)
+	})
+	client.SetRetries(1)
+
+	resp, err := client.doRequest(context.Background(), "GET", "/api/v1/organizations", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = client.handleResponse(resp, nil)
+
+	if len(sent) != 2 || sent[0] == "" || sent[0] != sent[1] {
+		t.Fatalf("expected retries to share one non-empty request ID, got %q", sent)
+	}
+	if err == nil || !strings.Contains(err.Error(), "request ID: "+sent[0]) {
+		t.Fatalf("expected error to include request ID %q, got %v", sent[0], err)
+	}
+}
+
+func TestServerRequestIDTakesPrecedence(t *testing.T) {
+	client, _ := newRetryTestClient(t, func(w http.ResponseWriter, attempt int) {
+		w.Header().Set(requestIDHeader, "server-header-id")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"project not found","request_id":"server-body-id"}`))
+	})
+
+	resp, err := client.doRequest(context.Background(), "GET", "/api/v1/projects/p-1", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = client.handleResponse(resp, nil)
+	want := "API error (404): project not found (request ID: server-body-id)"
+	if err == nil || err.Error() != want {
+		t.Fatalf("expected %q, got %v", want, err)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestUserAgentAndWarnings(t *testing.T) {
+	var agent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		agent = r.Header.Get("User-Agent")
+		w.Header().Add("Warning", `299 - "API v1 is deprecated, upgrade spacectl"`)
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{}, false)
+	var warnings bytes.Buffer
+	client.warnings = &warnings
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.doRequest(context.Background(), "GET", "/api/v1/organizations", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if !strings.HasPrefix(agent, "spacectl/") || !strings.Contains(agent, "(") {
+		t.Fatalf("unexpected User-Agent %q", agent)
+	}
+	if got := warnings.String(); got != "Warning: API v1 is deprecated, upgrade spacectl\n" {
+		t.Fatalf("expected the warning to be printed once, got %q", got)
+	}
+}
+
+func TestWarningText(t *testing.T) {
+	cases := map[string]string{
+		`299 - "Deprecated endpoint"`:                                    "Deprecated endpoint",
+		`299 api.kubespaces.io "Use v2" "Wed, 21 Oct 2026 07:28:00 GMT"`: "Use v2",
+		`plain text warning`:                                             "plain text warning",
+	}
+	for input, want := range cases {
+		if got := warningText(input); got != want {
+			t.Errorf("warningText(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestConcurrentUnauthorizedRequestsRefreshOnce(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var refreshes int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/user/refresh" {
+			atomic.AddInt32(&refreshes, 1)
+			time.Sleep(20 * time.Millisecond)
+			w.Write([]byte(`{"access_token":"fresh","refresh_token":"refresh-2","user":{"email":"user@example.com"}}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{AccessToken: "stale", RefreshToken: "refresh-1", UserEmail: "user@example.com"}
+	client := NewClient(server.URL, cfg, false)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.doRequest(context.Background(), "GET", "/api/v1/organizations", nil)
+			if err != nil {
+				errs <- err
+				return
+			}
+			errs <- client.handleResponse(resp, nil)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if n := atomic.LoadInt32(&refreshes); n != 1 {
+		t.Fatalf("expected a single refresh, got %d", n)
+	}
+	if cfg.AccessToken != "fresh" || cfg.RefreshToken != "refresh-2" {
+		t.Fatalf("expected refreshed tokens in config, got %q/%q", cfg.AccessToken, cfg.RefreshToken)
+	}
+}
+
+func TestLoginRejectedWithoutRefresh(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/user/refresh" {
+			t.Errorf("expected no refresh request")
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":"invalid credentials"}`))
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{AccessToken: "old", RefreshToken: "refresh-1", UserEmail: "user@example.com"}
+	_, err := NewAuthAPI(NewClient(server.URL, cfg, false)).Login(context.Background(), "user@example.com", "wrong")
+	if !errors.Is(err, ErrUnauthenticated) {
+		t.Fatalf("expected ErrUnauthenticated, got %v", err)
+	}
+	if cfg.AccessToken != "old" {
+		t.Errorf("expected the previous session to be kept, got token %q", cfg.AccessToken)
+	}
+}
+
+func TestRefreshReusesTokensSavedByAnotherProcess(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	saved := &config.Config{AccessToken: "fresh", RefreshToken: "refresh-2", UserEmail: "user@example.com"}
+	if err := saved.Save(); err != nil {
+		t.Fatalf("failed to save config: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/user/refresh" {
+			t.Errorf("expected no refresh request")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{AccessToken: "stale", RefreshToken: "refresh-1", UserEmail: "user@example.com"}
+	client := NewClient(server.URL, cfg, false)
+	resp, err := client.doRequest(context.Background(), "GET", "/api/v1/organizations", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.handleResponse(resp, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.AccessToken != "fresh" {
+		t.Fatalf("expected tokens from the saved config, got %q", cfg.AccessToken)
+	}
+}
+
+func TestChangePasswordIsRedacted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"access_token":"a","refresh_token":"r"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{AccessToken: "secret-token"}, false)
+	var logs, curl bytes.Buffer
+	client.SetLogger(logging.New(&logs, slog.LevelDebug))
+	client.SetCurlOutput(&curl)
+
+	if _, err := NewAuthAPI(client).ChangePassword(context.Background(), "old-pa55word", "new-pa55word"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, out := range map[string]string{"logs": logs.String(), "curl command": curl.String()} {
+		if !strings.Contains(out, "REDACTED") {
+			t.Errorf("expected the %s to show redacted fields, got:\n%s", name, out)
+		}
+		if strings.Contains(out, "pa55word") {
+			t.Errorf("expected the passwords to be redacted from the %s, got:\n%s", name, out)
+		}
+	}
+}
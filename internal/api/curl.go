@@ -0,0 +1,65 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// redactedToken replaces the access token in printed curl commands
+const redactedToken = "<redacted>"
+
+// SetCurlOutput prints the equivalent curl command of each API call to w, so
+// failing requests can be reproduced and shared with support. The access token
+// and sensitive body fields are redacted. Nil disables printing.
+func (c *Client) SetCurlOutput(w io.Writer) {
+	c.curl = w
+}
+
+// printCurl writes the curl command for a request if curl output is enabled
+func (c *Client) printCurl(method, url string, header http.Header, body []byte) {
+	if c.curl == nil {
+		return
+	}
+	fmt.Fprintln(c.curl, curlCommand(method, url, header, body))
+}
+
+// curlCommand builds a shell-quoted curl command line for a request. Headers are
+// sorted so the output is stable.
+func curlCommand(method, url string, header http.Header, body []byte) string {
+	parts := []string{"curl"}
+	if header.Get("Accept") == "text/event-stream" {
+		// Print streamed events as they arrive
+		parts = append(parts, "-N")
+	}
+	if method != http.MethodGet || len(body) > 0 {
+		parts = append(parts, "-X", method)
+	}
+	parts = append(parts, shellQuote(url))
+
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range header[key] {
+			if http.CanonicalHeaderKey(key) == "Authorization" {
+				value = "Bearer " + redactedToken
+			}
+			parts = append(parts, "-H", shellQuote(key+": "+value))
+		}
+	}
+
+	if len(body) > 0 {
+		parts = append(parts, "--data-raw", shellQuote(string(redactSensitiveJSON(body))))
+	}
+	return strings.Join(parts, " ")
+}
+
+// shellQuote quotes s for POSIX shells
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"spacectl/internal/config"
+)
+
+func TestCurlCommand(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer secret-token")
+	header.Set("Content-Type", "application/json")
+
+	got := curlCommand("POST", "https://api.example.com/api/v1/organizations", header, []byte(`{"name":"it's","password":"hunter2"}`))
+	want := `curl -X POST 'https://api.example.com/api/v1/organizations' -H 'Authorization: Bearer <redacted>' ` +
+		`-H 'Content-Type: application/json' --data-raw '{"name":"it'\''s","password":"***REDACTED***"}'`
+	if got != want {
+		t.Errorf("expected\n%s\ngot\n%s", want, got)
+	}
+
+	if got := curlCommand("GET", "https://api.example.com/a?b=c", http.Header{}, nil); got != `curl 'https://api.example.com/a?b=c'` {
+		t.Errorf("expected a plain GET, got %s", got)
+	}
+}
+
+func TestCurlIsPrintedForEachCall(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{AccessToken: "secret-token"}, false)
+	var out bytes.Buffer
+	client.SetCurlOutput(&out)
+
+	resp, err := client.doRequest(context.Background(), "DELETE", "/api/v1/tenants/t-1", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	discardBody(resp)
+
+	got := out.String()
+	if !strings.HasPrefix(got, "curl -X DELETE '"+server.URL+"/api/v1/tenants/t-1'") {
+		t.Errorf("unexpected curl command: %s", got)
+	}
+	if strings.Contains(got, "secret-token") {
+		t.Errorf("expected the token to be redacted: %s", got)
+	}
+	if !strings.Contains(got, "X-Request-Id: ") {
+		t.Errorf("expected the request ID header to be included: %s", got)
+	}
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Kinds of API failure. Errors returned by API methods can be matched against
+// these with errors.Is.
+var (
+	ErrUnauthenticated = errors.New("not authenticated")
+	ErrForbidden       = errors.New("forbidden")
+	ErrNotFound        = errors.New("not found")
+	ErrConflict        = errors.New("conflict")
+	ErrQuotaExceeded   = errors.New("quota exceeded")
+	// ErrEmailNotVerified is returned by login until the account's email address
+	// has been verified
+	ErrEmailNotVerified = errors.New("email not verified")
+)
+
+// quotaExceededCode is the error code the API uses when a request would exceed a quota
+const quotaExceededCode = "quota_exceeded"
+
+// emailNotVerifiedCode is the error code the API uses when logging in to an
+// account whose email address is not verified yet
+const emailNotVerifiedCode = "email_not_verified"
+
+// APIError is an error response from the Kubespaces API
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	RequestID  string
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("API error (%d): %s (request ID: %s)", e.StatusCode, e.Message, e.RequestID)
+	}
+	return fmt.Sprintf("API error (%d): %s", e.StatusCode, e.Message)
+}
+
+// Is reports whether the error is of the given kind, such as ErrNotFound
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrQuotaExceeded:
+		return e.isQuotaExceeded()
+	case ErrEmailNotVerified:
+		return e.isEmailNotVerified()
+	case ErrUnauthenticated:
+		return e.StatusCode == http.StatusUnauthorized && !e.isEmailNotVerified()
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden && !e.isQuotaExceeded() && !e.isEmailNotVerified()
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict && !e.isQuotaExceeded()
+	default:
+		return false
+	}
+}
+
+// isQuotaExceeded detects quota failures by their error code, falling back to the
+// message for servers that do not send one
+func (e *APIError) isQuotaExceeded() bool {
+	if e.Code != "" {
+		return e.Code == quotaExceededCode
+	}
+	switch e.StatusCode {
+	case http.StatusForbidden, http.StatusConflict, http.StatusUnprocessableEntity:
+		return strings.Contains(strings.ToLower(e.Message), "quota")
+	default:
+		return false
+	}
+}
+
+// isEmailNotVerified detects logins refused for an unverified email address by
+// their error code, falling back to the message for servers that do not send one
+func (e *APIError) isEmailNotVerified() bool {
+	if e.Code != "" {
+		return e.Code == emailNotVerifiedCode
+	}
+	switch e.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return strings.Contains(strings.ToLower(e.Message), "not verified")
+	default:
+		return false
+	}
+}
+
+// kindError is an error with its own message that matches one of the error kinds
+type kindError struct {
+	kind error
+	msg  string
+}
+
+func (e *kindError) Error() string        { return e.msg }
+func (e *kindError) Is(target error) bool { return target == e.kind }
+
+// NotFoundError returns an error with the formatted message that matches
+// ErrNotFound, for lookups that fail on the client side (e.g. no resource by name)
+func NotFoundError(format string, args ...interface{}) error {
+	return &kindError{kind: ErrNotFound, msg: fmt.Sprintf(format, args...)}
+}
+
+// ConflictError returns an error with the formatted message that matches
+// ErrConflict, for collisions detected on the client side (e.g. a name in use)
+func ConflictError(format string, args ...interface{}) error {
+	return &kindError{kind: ErrConflict, msg: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"spacectl/internal/config"
+)
+
+func TestAPIErrorKinds(t *testing.T) {
+	kinds := []error{ErrUnauthenticated, ErrForbidden, ErrNotFound, ErrConflict, ErrQuotaExceeded, ErrEmailNotVerified}
+
+	cases := []struct {
+		name string
+		err  *APIError
+		want error
+	}{
+		{"unauthorized", &APIError{StatusCode: 401, Message: "token expired"}, ErrUnauthenticated},
+		{"forbidden", &APIError{StatusCode: 403, Message: "not a project admin"}, ErrForbidden},
+		{"not found", &APIError{StatusCode: 404, Message: "tenant not found"}, ErrNotFound},
+		{"conflict", &APIError{StatusCode: 409, Message: "name already taken"}, ErrConflict},
+		{"quota by code", &APIError{StatusCode: 403, Code: "quota_exceeded", Message: "limit reached"}, ErrQuotaExceeded},
+		{"quota by message", &APIError{StatusCode: 422, Message: "project tenant quota exceeded"}, ErrQuotaExceeded},
+		{"email not verified by code", &APIError{StatusCode: 403, Code: "email_not_verified", Message: "verify your email first"}, ErrEmailNotVerified},
+		{"email not verified by message", &APIError{StatusCode: 401, Message: "Email address not verified"}, ErrEmailNotVerified},
+		{"other code wins over message", &APIError{StatusCode: 409, Code: "name_taken", Message: "quota-team exists"}, ErrConflict},
+		{"server error", &APIError{StatusCode: 500, Message: "boom"}, nil},
+	}
+
+	for _, tc := range cases {
+		// Callers wrap API errors, so match through a wrapper
+		err := fmt.Errorf("failed to do thing: %w", tc.err)
+		for _, kind := range kinds {
+			if got := errors.Is(err, kind); got != (kind == tc.want) {
+				t.Errorf("%s: errors.Is(err, %v) = %v", tc.name, kind, got)
+			}
+		}
+	}
+}
+
+func TestHandleResponseReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error":"tenant limit reached","code":"quota_exceeded"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{}, false)
+	resp, err := client.doRequest(context.Background(), "POST", "/api/v1/projects/p-1/tenants", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = client.handleResponse(resp, nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+	if apiErr.StatusCode != http.StatusForbidden || apiErr.Code != "quota_exceeded" || apiErr.Message != "tenant limit reached" {
+		t.Fatalf("unexpected API error %+v", apiErr)
+	}
+	if !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("expected a quota error")
+	}
+}
+
+func TestNotFoundError(t *testing.T) {
+	err := fmt.Errorf("failed to resolve tenant: %w", NotFoundError("tenant with name %q not found", "dev"))
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected NotFoundError to match ErrNotFound")
+	}
+	if errors.Is(err, ErrConflict) {
+		t.Fatalf("expected NotFoundError not to match ErrConflict")
+	}
+	if got := err.Error(); got != `failed to resolve tenant: tenant with name "dev" not found` {
+		t.Fatalf("unexpected message %q", got)
+	}
+}
+
+func TestConflictError(t *testing.T) {
+	err := ConflictError("tenant %q already exists", "dev")
+	if !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected ConflictError to match ErrConflict")
+	}
+	if errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ConflictError not to match ErrNotFound")
+	}
+	if got := err.Error(); got != `tenant "dev" already exists` {
+		t.Fatalf("unexpected message %q", got)
+	}
+}
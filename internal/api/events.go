@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"spacectl/internal/models"
+)
+
+// defaultStreamRetry is how long to wait before reconnecting to an event stream
+// when the server does not send a retry delay
+var defaultStreamRetry = 3 * time.Second
+
+// EventAPI handles tenant and project lifecycle event API calls
+type EventAPI struct {
+	client *Client
+}
+
+// NewEventAPI creates a new EventAPI
+func NewEventAPI(client *Client) *EventAPI {
+	return &EventAPI{client: client}
+}
+
+// EventFilter restricts events to a project or organization. Empty fields match all.
+type EventFilter struct {
+	ProjectID      string
+	OrganizationID string
+}
+
+// path returns an events endpoint with the filter as query parameters
+func (f EventFilter) path(endpoint string) string {
+	q := url.Values{}
+	if f.ProjectID != "" {
+		q.Set("project_id", f.ProjectID)
+	}
+	if f.OrganizationID != "" {
+		q.Set("organization_id", f.OrganizationID)
+	}
+	if len(q) == 0 {
+		return endpoint
+	}
+	return endpoint + "?" + q.Encode()
+}
+
+// ListEvents lists recent events
+func (e *EventAPI) ListEvents(ctx context.Context, filter EventFilter) ([]models.Event, error) {
+	return list[models.Event](ctx, e.client, filter.path("/api/v1/events"))
+}
+
+// StreamEvents streams events as they happen, calling handle for each one until
+// the context is canceled or handle returns an error. Dropped connections are
+// re-established and resumed after the last event received, so no events are
+// missed. It returns the context's error when canceled.
+func (e *EventAPI) StreamEvents(ctx context.Context, filter EventFilter, handle func(models.Event) error) error {
+	path := filter.path("/api/v1/events/stream")
+	lastID := ""
+	delay := defaultStreamRetry
+	failures := 0
+
+	for {
+		resp, err := e.client.openStream(ctx, path, lastID)
+		var urlErr *url.Error
+		switch {
+		case ctx.Err() != nil:
+			if err == nil {
+				resp.Body.Close()
+			}
+			return ctx.Err()
+
+		case err != nil && !errors.As(err, &urlErr):
+			return err
+
+		case err != nil:
+			// Network error, retry with backoff
+
+		case resp.StatusCode == http.StatusOK:
+			failures = 0
+			var handleErr error
+			err = readSSE(resp.Body, func(event sseEvent) error {
+				if event.Retry > 0 {
+					delay = event.Retry
+				}
+				if event.ID != "" {
+					lastID = event.ID
+				}
+				if event.Data == "" {
+					return nil
+				}
+
+				var ev models.Event
+				if err := json.Unmarshal([]byte(event.Data), &ev); err != nil {
+					handleErr = fmt.Errorf("failed to unmarshal event: %w", err)
+					return handleErr
+				}
+				if ev.Type == "" {
+					ev.Type = event.Event
+				}
+				handleErr = handle(ev)
+				return handleErr
+			})
+			resp.Body.Close()
+			if handleErr != nil {
+				return handleErr
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			reason := "stream closed by server"
+			if err != nil {
+				reason = err.Error()
+			}
+			e.client.log.Info("reconnecting to event stream", "reason", reason, "delay", delay, "last_event_id", lastID)
+			if !sleepContext(ctx, delay) {
+				return ctx.Err()
+			}
+			continue
+
+		case resp.StatusCode == http.StatusNoContent:
+			// The server asks clients to stop reconnecting
+			resp.Body.Close()
+			return nil
+
+		case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+			err = fmt.Errorf("%s", resp.Status)
+			discardBody(resp)
+
+		default:
+			return e.client.handleResponse(resp, nil)
+		}
+
+		failures++
+		wait := retryDelay(failures)
+		e.client.log.Warn("event stream unavailable, retrying", "delay", wait.Round(time.Millisecond), "error", err)
+		if !sleepContext(ctx, wait) {
+			return ctx.Err()
+		}
+	}
+}
+
+// sleepContext waits for d, returning false if the context is canceled first
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"spacectl/internal/config"
+	"spacectl/internal/models"
+)
+
+func TestEventAPI(t *testing.T) {
+	runAPICases(t, "events", []apiCase{
+		{name: "list_events", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewEventAPI(c).ListEvents(ctx, EventFilter{ProjectID: "proj-1"})
+		}},
+		{name: "list_events_forbidden", wantErr: ErrForbidden, call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewEventAPI(c).ListEvents(ctx, EventFilter{OrganizationID: "org-2"})
+		}},
+	})
+}
+
+func TestStreamEventsResumesAfterDisconnect(t *testing.T) {
+	var connects []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RequestURI() != "/api/v1/events/stream?project_id=proj-1" {
+			t.Errorf("unexpected request %s", r.URL.RequestURI())
+		}
+		if accept := r.Header.Get("Accept"); accept != "text/event-stream" {
+			t.Errorf("expected Accept text/event-stream, got %q", accept)
+		}
+		connects = append(connects, r.Header.Get("Last-Event-ID"))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		switch len(connects) {
+		case 1:
+			fmt.Fprint(w, "retry: 10\n\n")
+			fmt.Fprint(w, ": heartbeat\n\n")
+			fmt.Fprint(w, "id: evt-1\nevent: tenant.created\ndata: {\"id\":\"evt-1\",\"resource_type\":\"tenant\",\"resource_id\":\"t-1\"}\n\n")
+		case 2:
+			// The server closed the first stream; this one resumes after evt-1
+			fmt.Fprint(w, "id: evt-2\ndata: {\"id\":\"evt-2\",\"type\":\"tenant.status_changed\",\n")
+			fmt.Fprint(w, "data: \"resource_type\":\"tenant\",\"resource_id\":\"t-1\",\"status\":\"Ready\"}\n\n")
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{AccessToken: fakeAccessToken}, false)
+
+	var got []models.Event
+	err := NewEventAPI(client).StreamEvents(context.Background(), EventFilter{ProjectID: "proj-1"}, func(e models.Event) error {
+		got = append(got, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(connects) != 3 || connects[0] != "" || connects[1] != "evt-1" || connects[2] != "evt-2" {
+		t.Errorf("expected to resume after each event, got Last-Event-ID %q", connects)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(got))
+	}
+	if got[0].Type != "tenant.created" {
+		t.Errorf("expected the event name to fill in the type, got %q", got[0].Type)
+	}
+	if got[1].Status != "Ready" {
+		t.Errorf("expected multi-line data to be joined, got %+v", got[1])
+	}
+}
+
+func TestStreamEventsStopsOnCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "data: {\"id\":\"evt-1\",\"type\":\"project.archived\"}\n\n")
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{AccessToken: fakeAccessToken}, false)
+	// The stream must not be cut off by the regular request timeout
+	client.SetTimeouts(0, 50*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- NewEventAPI(client).StreamEvents(ctx, EventFilter{}, func(e models.Event) error {
+			time.AfterFunc(100*time.Millisecond, cancel)
+			return nil
+		})
+	}()
+
+	select {
+	case err := <-done:
+		if err != context.Canceled {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream did not stop after cancel")
+	}
+}
+
+func TestStreamEventsReturnsAPIErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":"project not found"}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{AccessToken: fakeAccessToken}, false)
+	err := NewEventAPI(client).StreamEvents(context.Background(), EventFilter{ProjectID: "missing"}, func(models.Event) error {
+		t.Error("unexpected event")
+		return nil
+	})
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"spacectl/internal/config"
+)
+
+// update rewrites the request and result of each golden fixture from the current
+// client code: go test ./internal/api -run TestTenantAPI -update
+var update = flag.Bool("update", false, "update golden fixtures in testdata")
+
+// fakeAccessToken is the token the fake server expects on every request
+const fakeAccessToken = "test-access-token"
+
+// fixture is a recorded exchange with the Kubespaces API, stored as
+// testdata/<module>/<case>.json. The response is what the fake server replies
+// with; the request and result are golden values checked against the client.
+type fixture struct {
+	Request  fixtureRequest  `json:"request"`
+	Response fixtureResponse `json:"response"`
+	// Result is the value returned by the API method, encoded as JSON
+	Result json.RawMessage `json:"result,omitempty"`
+}
+
+type fixtureRequest struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+type fixtureResponse struct {
+	Status int               `json:"status"`
+	Header map[string]string `json:"header,omitempty"`
+	// Body is a JSON response body; Text is used instead for other content
+	Body json.RawMessage `json:"body,omitempty"`
+	Text string          `json:"text,omitempty"`
+}
+
+// apiCase is one table-driven test of an API method against a fixture
+type apiCase struct {
+	// name is the fixture file name without extension
+	name string
+	call func(ctx context.Context, c *Client) (interface{}, error)
+	// wantErr is the kind of error expected, e.g. ErrNotFound
+	wantErr error
+}
+
+func loadFixture(t *testing.T, path string) *fixture {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	var fx fixture
+	if err := json.Unmarshal(data, &fx); err != nil {
+		t.Fatalf("failed to parse fixture %s: %v", path, err)
+	}
+	return &fx
+}
+
+func writeFixture(t *testing.T, path string, fx *fixture) {
+	t.Helper()
+
+	data, err := json.MarshalIndent(fx, "", "  ")
+	if err != nil {
+		t.Fatalf("failed to encode fixture: %v", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+}
+
+// newFakeServer starts a server that replies to a single request with the
+// fixture's response. It returns a client for the server and a function that
+// returns the request the server received, or nil if there was none.
+func newFakeServer(t *testing.T, fx *fixture) (*Client, func() *fixtureRequest) {
+	t.Helper()
+
+	var got *fixtureRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got != nil {
+			t.Errorf("unexpected second request: %s %s", r.Method, r.URL.RequestURI())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer "+fakeAccessToken {
+			t.Errorf("expected the access token to be sent, got Authorization %q", auth)
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read request body: %v", err)
+		}
+		got = &fixtureRequest{Method: r.Method, Path: r.URL.RequestURI()}
+		if len(body) > 0 {
+			got.Body = json.RawMessage(body)
+		}
+
+		for key, value := range fx.Response.Header {
+			w.Header().Set(key, value)
+		}
+		if fx.Response.Text == "" {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		w.WriteHeader(fx.Response.Status)
+		if fx.Response.Text != "" {
+			w.Write([]byte(fx.Response.Text))
+		} else {
+			w.Write(fx.Response.Body)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := &config.Config{AccessToken: fakeAccessToken, UserEmail: "user@example.com"}
+	client := NewClient(server.URL, cfg, false)
+	client.SetRetries(0)
+	// Fixtures record a single request, so feature checks must not fetch the
+	// server version; features_test.go covers them
+	client.serverVersionFetched = true
+
+	return client, func() *fixtureRequest { return got }
+}
+
+// runAPICases runs each case against the fake server with its fixture from
+// testdata/<module>, checking the request the client sent and the value it
+// returned against the fixture
+func runAPICases(t *testing.T, module string, cases []apiCase) {
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join("testdata", module, tc.name+".json")
+			fx := loadFixture(t, path)
+			client, received := newFakeServer(t, fx)
+
+			result, err := tc.call(context.Background(), client)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+				}
+				result = nil
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			req := received()
+			if req == nil {
+				t.Fatalf("expected a request to be sent")
+			}
+			var gotResult json.RawMessage
+			if result != nil {
+				if gotResult, err = json.Marshal(result); err != nil {
+					t.Fatalf("failed to encode result: %v", err)
+				}
+			}
+
+			if *update {
+				fx.Request = *req
+				fx.Result = gotResult
+				writeFixture(t, path, fx)
+				return
+			}
+
+			if req.Method != fx.Request.Method || req.Path != fx.Request.Path {
+				t.Errorf("expected request %s %s, got %s %s", fx.Request.Method, fx.Request.Path, req.Method, req.Path)
+			}
+			if !jsonEqual(t, req.Body, fx.Request.Body) {
+				t.Errorf("request body mismatch\nwant: %s\ngot:  %s", fx.Request.Body, req.Body)
+			}
+			if !jsonEqual(t, gotResult, fx.Result) {
+				t.Errorf("result mismatch\nwant: %s\ngot:  %s", fx.Result, gotResult)
+			}
+		})
+	}
+}
+
+// jsonEqual reports whether two JSON documents are equal, treating an empty
+// document like null
+func jsonEqual(t *testing.T, a, b json.RawMessage) bool {
+	t.Helper()
+
+	decode := func(raw json.RawMessage) interface{} {
+		if len(bytes.TrimSpace(raw)) == 0 {
+			return nil
+		}
+		var v interface{}
+		if err := json.Unmarshal(raw, &v); err != nil {
+			t.Fatalf("invalid JSON %q: %v", raw, err)
+		}
+		return v
+	}
+	return reflect.DeepEqual(decode(a), decode(b))
+}
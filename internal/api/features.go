@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"spacectl/internal/version"
+)
+
+// ErrUnsupported is returned for features the API server is too old to have
+var ErrUnsupported = errors.New("not supported by the server")
+
+// Server versions this client is built for: at least minServerVersion and below
+// maxServerVersion. Other servers are used with a warning.
+const (
+	minServerVersion = "1.0.0"
+	maxServerVersion = "2.0.0"
+)
+
+// Feature is an API capability added after minServerVersion
+type Feature struct {
+	// Name describes the feature in errors, e.g. "the audit log"
+	Name string
+	// MinServerVersion is the first server version with the feature
+	MinServerVersion string
+}
+
+// Features whose endpoints older servers do not have
+var (
+	FeatureAccessCheck      = Feature{Name: "checking permissions", MinServerVersion: "1.7.0"}
+	FeatureAuditLog         = Feature{Name: "the audit log", MinServerVersion: "1.5.0"}
+	FeatureHealth           = Feature{Name: "the health check", MinServerVersion: "1.5.0"}
+	FeatureMemberKubeconfig = Feature{Name: "kubeconfigs for other members", MinServerVersion: "1.7.0"}
+	FeatureRoles            = Feature{Name: "the role catalog", MinServerVersion: "1.7.0"}
+	FeatureScopedKubeconfig = Feature{Name: "read-only kubeconfigs", MinServerVersion: "1.7.0"}
+	FeatureTenantCosts      = Feature{Name: "the tenant cost report", MinServerVersion: "1.6.0"}
+	FeatureTenantUsage      = Feature{Name: "tenant usage", MinServerVersion: "1.6.0"}
+	FeatureUserLookup       = Feature{Name: "looking up users by ID", MinServerVersion: "1.6.0"}
+)
+
+// UnsupportedError reports a feature the API server is too old to have
+type UnsupportedError struct {
+	Feature       Feature
+	ServerVersion string
+}
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("%s needs Kubespaces API %s or later, but the server runs %s",
+		e.Feature.Name, e.Feature.MinServerVersion, e.ServerVersion)
+}
+
+// Is makes the error match ErrUnsupported
+func (e *UnsupportedError) Is(target error) bool { return target == ErrUnsupported }
+
+// ServerVersion returns the version of the API server, fetched on the first call
+// only. It is empty when the version is unknown, e.g. because the server is too
+// old to report it or could not be reached; the failure shows when the command
+// makes its own requests.
+func (c *Client) ServerVersion(ctx context.Context) string {
+	c.serverVersionMu.Lock()
+	defer c.serverVersionMu.Unlock()
+	if c.serverVersionFetched {
+		return c.serverVersion
+	}
+
+	server, err := NewVersionAPI(c).GetServerVersion(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			c.serverVersionFetched = true
+		}
+		c.log.Debug("server version unknown", "error", err)
+		return ""
+	}
+	c.serverVersionFetched = true
+	c.serverVersion = server.Version
+
+	if !versionInRange(server.Version, minServerVersion, maxServerVersion) {
+		c.warnOnce(fmt.Sprintf("the API server runs %s, but this spacectl supports versions %s up to %s; some commands may fail",
+			server.Version, minServerVersion, maxServerVersion))
+	}
+	return c.serverVersion
+}
+
+// RequireFeature returns an UnsupportedError when the API server is older than
+// the feature. Servers of unknown version are assumed to have it.
+func (c *Client) RequireFeature(ctx context.Context, feature Feature) error {
+	server := c.ServerVersion(ctx)
+	if server == "" {
+		return nil
+	}
+	if cmp, err := version.Compare(server, feature.MinServerVersion); err == nil && cmp < 0 {
+		return &UnsupportedError{Feature: feature, ServerVersion: server}
+	}
+	return nil
+}
+
+// versionInRange reports whether v is at least min and below max. Versions that
+// cannot be parsed, such as development builds, are in range.
+func versionInRange(v, min, max string) bool {
+	low, err := version.Compare(v, min)
+	if err != nil {
+		return true
+	}
+	high, err := version.Compare(v, max)
+	if err != nil {
+		return true
+	}
+	return low >= 0 && high < 0
+}
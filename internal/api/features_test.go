@@ -0,0 +1,100 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"spacectl/internal/config"
+)
+
+// newVersionedServer starts a server that reports the given version, or has no
+// version endpoint when it is empty, and answers every other path with an empty
+// list. It returns a client for the server and the number of version requests.
+func newVersionedServer(t *testing.T, serverVersion string) (*Client, *int32) {
+	t.Helper()
+
+	var versionRequests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/v1/version" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		atomic.AddInt32(&versionRequests, 1)
+		if serverVersion == "" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"not found"}`))
+			return
+		}
+		w.Write([]byte(`{"version":"` + serverVersion + `"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, &config.Config{AccessToken: fakeAccessToken}, false)
+	client.SetRetries(0)
+	client.warnings = &bytes.Buffer{}
+	return client, &versionRequests
+}
+
+func TestRequireFeature(t *testing.T) {
+	tests := []struct {
+		name          string
+		serverVersion string
+		wantErr       bool
+	}{
+		{name: "older server", serverVersion: "1.4.0", wantErr: true},
+		{name: "same version", serverVersion: "1.5.0"},
+		{name: "newer server", serverVersion: "v1.7.2"},
+		{name: "unknown version", serverVersion: ""},
+		{name: "development build", serverVersion: "dev"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, versionRequests := newVersionedServer(t, tt.serverVersion)
+
+			for i := 0; i < 2; i++ {
+				_, err := NewAuditAPI(client).ListAuditLog(context.Background(), "org-1", AuditFilter{})
+				if tt.wantErr {
+					var unsupported *UnsupportedError
+					if !errors.As(err, &unsupported) || !errors.Is(err, ErrUnsupported) {
+						t.Fatalf("expected an UnsupportedError, got %v", err)
+					}
+					if !strings.Contains(err.Error(), "1.5.0 or later") || !strings.Contains(err.Error(), tt.serverVersion) {
+						t.Errorf("unexpected message: %v", err)
+					}
+				} else if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if n := atomic.LoadInt32(versionRequests); n != 1 {
+				t.Errorf("expected the server version to be fetched once, got %d requests", n)
+			}
+		})
+	}
+}
+
+func TestServerVersionOutOfRange(t *testing.T) {
+	for _, serverVersion := range []string{"2.0.0", "0.9.1"} {
+		client, _ := newVersionedServer(t, serverVersion)
+		warnings := client.warnings.(*bytes.Buffer)
+
+		client.ServerVersion(context.Background())
+		client.RequireFeature(context.Background(), FeatureAuditLog)
+		if got := warnings.String(); strings.Count(got, "Warning: the API server runs "+serverVersion) != 1 {
+			t.Errorf("expected one warning about server %s, got %q", serverVersion, got)
+		}
+	}
+
+	client, _ := newVersionedServer(t, "1.9.0")
+	client.ServerVersion(context.Background())
+	if got := client.warnings.(*bytes.Buffer).String(); got != "" {
+		t.Errorf("expected no warning for a supported server, got %q", got)
+	}
+}
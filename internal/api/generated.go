@@ -0,0 +1,39 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"spacectl/internal/api/openapi"
+)
+
+// OpenAPI returns the low-level client generated from the Kubespaces OpenAPI spec,
+// for endpoints and fields that the API types in this package do not wrap yet.
+// Its requests go through this client, so they share its authentication, token
+// refresh, retries and debug logging.
+func (c *Client) OpenAPI() (*openapi.ClientWithResponses, error) {
+	return openapi.NewClientWithResponses(c.baseURL, openapi.WithHTTPClient(c))
+}
+
+// Do sends a request built by the generated client. It implements
+// openapi.HttpRequestDoer.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	path := strings.TrimPrefix(req.URL.String(), c.baseURL)
+	if path == req.URL.String() {
+		return nil, fmt.Errorf("request to %s is not for the API at %s", req.URL, c.baseURL)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+
+	return c.doRawRequest(req.Context(), req.Method, path, body, req.Header)
+}
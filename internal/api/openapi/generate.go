@@ -0,0 +1,6 @@
+package openapi
+
+// The client in client.gen.go is generated from openapi/kubespaces.yaml and uses
+// the types in spacectl/internal/models. Run `make generate` after changing the spec.
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.0 -config ../../../openapi/client.config.yaml ../../../openapi/kubespaces.yaml
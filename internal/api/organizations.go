@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 
 	"spacectl/internal/models"
@@ -17,23 +18,13 @@ func NewOrganizationAPI(client *Client) *OrganizationAPI {
 }
 
 // ListUserOrganizations lists organizations the user belongs to
-func (o *OrganizationAPI) ListUserOrganizations() ([]models.OrganizationMembershipResponse, error) {
-	resp, err := o.client.doRequest("GET", "/api/v1/organizations", nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var orgs []models.OrganizationMembershipResponse
-	if err := o.client.handleResponse(resp, &orgs); err != nil {
-		return nil, err
-	}
-
-	return orgs, nil
+func (o *OrganizationAPI) ListUserOrganizations(ctx context.Context) ([]models.OrganizationMembershipResponse, error) {
+	return list[models.OrganizationMembershipResponse](ctx, o.client, "/api/v1/organizations")
 }
 
 // GetDefaultOrganization gets the user's default organization
-func (o *OrganizationAPI) GetDefaultOrganization() (*models.Organization, error) {
-	resp, err := o.client.doRequest("GET", "/api/v1/organizations/default", nil)
+func (o *OrganizationAPI) GetDefaultOrganization(ctx context.Context) (*models.Organization, error) {
+	resp, err := o.client.getCached(ctx, "/api/v1/organizations/default")
 	if err != nil {
 		return nil, err
 	}
@@ -47,8 +38,8 @@ func (o *OrganizationAPI) GetDefaultOrganization() (*models.Organization, error)
 }
 
 // GetOrganizationByName gets an organization by name
-func (o *OrganizationAPI) GetOrganizationByName(name string) (*models.Organization, error) {
-	resp, err := o.client.doRequest("GET", fmt.Sprintf("/api/v1/organizations/by-name/%s", name), nil)
+func (o *OrganizationAPI) GetOrganizationByName(ctx context.Context, name string) (*models.Organization, error) {
+	resp, err := o.client.getCached(ctx, fmt.Sprintf("/api/v1/organizations/by-name/%s", name))
 	if err != nil {
 		return nil, err
 	}
@@ -62,8 +53,8 @@ func (o *OrganizationAPI) GetOrganizationByName(name string) (*models.Organizati
 }
 
 // GetOrganization gets an organization by ID
-func (o *OrganizationAPI) GetOrganization(id string) (*models.Organization, error) {
-	resp, err := o.client.doRequest("GET", fmt.Sprintf("/api/v1/organizations/%s", id), nil)
+func (o *OrganizationAPI) GetOrganization(ctx context.Context, id string) (*models.Organization, error) {
+	resp, err := o.client.getCached(ctx, fmt.Sprintf("/api/v1/organizations/%s", id))
 	if err != nil {
 		return nil, err
 	}
@@ -77,7 +68,7 @@ func (o *OrganizationAPI) GetOrganization(id string) (*models.Organization, erro
 }
 
 // CreateOrganization creates a new organization
-func (o *OrganizationAPI) CreateOrganization(name, description string) (*models.Organization, error) {
+func (o *OrganizationAPI) CreateOrganization(ctx context.Context, name, description string) (*models.Organization, error) {
 	var descPtr *string
 	if description != "" {
 		descPtr = &description
@@ -88,7 +79,7 @@ func (o *OrganizationAPI) CreateOrganization(name, description string) (*models.
 		Description: descPtr,
 	}
 
-	resp, err := o.client.doRequest("POST", "/api/v1/organizations", req)
+	resp, err := o.client.doRequest(ctx, "POST", "/api/v1/organizations", req)
 	if err != nil {
 		return nil, err
 	}
@@ -102,12 +93,12 @@ func (o *OrganizationAPI) CreateOrganization(name, description string) (*models.
 }
 
 // UpdateOrganization updates an organization
-func (o *OrganizationAPI) UpdateOrganization(id, name string) (*models.Organization, error) {
+func (o *OrganizationAPI) UpdateOrganization(ctx context.Context, id, name string) (*models.Organization, error) {
 	req := models.UpdateOrganizationRequest{
 		Name: name,
 	}
 
-	resp, err := o.client.doRequest("PUT", fmt.Sprintf("/api/v1/organizations/%s", id), req)
+	resp, err := o.client.doRequest(ctx, "PUT", fmt.Sprintf("/api/v1/organizations/%s", id), req)
 	if err != nil {
 		return nil, err
 	}
@@ -121,8 +112,8 @@ func (o *OrganizationAPI) UpdateOrganization(id, name string) (*models.Organizat
 }
 
 // DeleteOrganization deletes an organization
-func (o *OrganizationAPI) DeleteOrganization(id string) error {
-	resp, err := o.client.doRequest("DELETE", fmt.Sprintf("/api/v1/organizations/%s", id), nil)
+func (o *OrganizationAPI) DeleteOrganization(ctx context.Context, id string) error {
+	resp, err := o.client.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/organizations/%s", id), nil)
 	if err != nil {
 		return err
 	}
@@ -131,8 +122,8 @@ func (o *OrganizationAPI) DeleteOrganization(id string) error {
 }
 
 // SetDefaultOrganization sets an organization as default
-func (o *OrganizationAPI) SetDefaultOrganization(id string) error {
-	resp, err := o.client.doRequest("PUT", fmt.Sprintf("/api/v1/organizations/%s/default", id), nil)
+func (o *OrganizationAPI) SetDefaultOrganization(ctx context.Context, id string) error {
+	resp, err := o.client.doRequest(ctx, "PUT", fmt.Sprintf("/api/v1/organizations/%s/default", id), nil)
 	if err != nil {
 		return err
 	}
@@ -141,13 +132,13 @@ func (o *OrganizationAPI) SetDefaultOrganization(id string) error {
 }
 
 // AddUserToOrganization adds a user to an organization
-func (o *OrganizationAPI) AddUserToOrganization(orgID, userID, role string) error {
+func (o *OrganizationAPI) AddUserToOrganization(ctx context.Context, orgID, userID, role string) error {
 	req := models.AddUserToOrganizationRequest{
 		UserID: userID,
 		Role:   role,
 	}
 
-	resp, err := o.client.doRequest("POST", fmt.Sprintf("/api/v1/organizations/%s/users", orgID), req)
+	resp, err := o.client.doRequest(ctx, "POST", fmt.Sprintf("/api/v1/organizations/%s/users", orgID), req)
 	if err != nil {
 		return err
 	}
@@ -156,8 +147,8 @@ func (o *OrganizationAPI) AddUserToOrganization(orgID, userID, role string) erro
 }
 
 // RemoveUserFromOrganization removes a user from an organization
-func (o *OrganizationAPI) RemoveUserFromOrganization(orgID, userID string) error {
-	resp, err := o.client.doRequest("DELETE", fmt.Sprintf("/api/v1/organizations/%s/users/%s", orgID, userID), nil)
+func (o *OrganizationAPI) RemoveUserFromOrganization(ctx context.Context, orgID, userID string) error {
+	resp, err := o.client.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/organizations/%s/users/%s", orgID, userID), nil)
 	if err != nil {
 		return err
 	}
@@ -166,12 +157,12 @@ func (o *OrganizationAPI) RemoveUserFromOrganization(orgID, userID string) error
 }
 
 // ChangeUserRole changes a user's role in an organization
-func (o *OrganizationAPI) ChangeUserRole(orgID, userID, role string) error {
+func (o *OrganizationAPI) ChangeUserRole(ctx context.Context, orgID, userID, role string) error {
 	req := models.ChangeUserRoleRequest{
 		Role: role,
 	}
 
-	resp, err := o.client.doRequest("PATCH", fmt.Sprintf("/api/v1/organizations/%s/users/%s/role", orgID, userID), req)
+	resp, err := o.client.doRequest(ctx, "PATCH", fmt.Sprintf("/api/v1/organizations/%s/users/%s/role", orgID, userID), req)
 	if err != nil {
 		return err
 	}
@@ -179,54 +170,83 @@ func (o *OrganizationAPI) ChangeUserRole(orgID, userID, role string) error {
 	return o.client.handleResponse(resp, nil)
 }
 
-// SendInvitation sends an organization invitation
-func (o *OrganizationAPI) SendInvitation(orgID, email, role string) error {
-	req := models.CreateInvitationRequest{
-		Email: email,
-		Role:  role,
+// GetOrganizationSettings gets an organization's settings
+func (o *OrganizationAPI) GetOrganizationSettings(ctx context.Context, orgID string) (*models.OrganizationSettings, error) {
+	resp, err := o.client.getCached(ctx, fmt.Sprintf("/api/v1/organizations/%s/settings", orgID))
+	if err != nil {
+		return nil, err
 	}
 
-	resp, err := o.client.doRequest("POST", fmt.Sprintf("/api/v1/organizations/%s/invitations", orgID), req)
-	if err != nil {
-		return err
+	var settings models.OrganizationSettings
+	if err := o.client.handleResponse(resp, &settings); err != nil {
+		return nil, err
 	}
 
-	return o.client.handleResponse(resp, nil)
+	return &settings, nil
 }
 
-// ListOrganizationInvitations lists invitations sent by an organization
-func (o *OrganizationAPI) ListOrganizationInvitations(orgID string) ([]models.Invitation, error) {
-	resp, err := o.client.doRequest("GET", fmt.Sprintf("/api/v1/organizations/%s/invitations", orgID), nil)
+// UpdateOrganizationSettings updates the given organization settings, leaving the rest unchanged
+func (o *OrganizationAPI) UpdateOrganizationSettings(ctx context.Context, orgID string, req models.UpdateOrganizationSettingsRequest) (*models.OrganizationSettings, error) {
+	resp, err := o.client.doRequest(ctx, "PATCH", fmt.Sprintf("/api/v1/organizations/%s/settings", orgID), req)
 	if err != nil {
 		return nil, err
 	}
 
-	var invitations []models.Invitation
-	if err := o.client.handleResponse(resp, &invitations); err != nil {
+	var settings models.OrganizationSettings
+	if err := o.client.handleResponse(resp, &settings); err != nil {
 		return nil, err
 	}
 
-	return invitations, nil
+	return &settings, nil
 }
 
-// ListUserInvitations lists invitations for the current user
-func (o *OrganizationAPI) ListUserInvitations() ([]models.Invitation, error) {
-	resp, err := o.client.doRequest("GET", "/api/v1/organizations/invitations", nil)
+// TransferOwnership transfers ownership of an organization to another member
+func (o *OrganizationAPI) TransferOwnership(ctx context.Context, orgID, newOwnerEmail string) (*models.Organization, error) {
+	req := models.TransferOwnershipRequest{
+		NewOwnerEmail: newOwnerEmail,
+	}
+
+	resp, err := o.client.doRequest(ctx, "POST", fmt.Sprintf("/api/v1/organizations/%s/transfer-ownership", orgID), req)
 	if err != nil {
 		return nil, err
 	}
 
-	var invitations []models.Invitation
-	if err := o.client.handleResponse(resp, &invitations); err != nil {
+	var org models.Organization
+	if err := o.client.handleResponse(resp, &org); err != nil {
 		return nil, err
 	}
 
-	return invitations, nil
+	return &org, nil
+}
+
+// SendInvitation sends an organization invitation
+func (o *OrganizationAPI) SendInvitation(ctx context.Context, orgID, email, role string) error {
+	req := models.CreateInvitationRequest{
+		Email: email,
+		Role:  role,
+	}
+
+	resp, err := o.client.doRequest(ctx, "POST", fmt.Sprintf("/api/v1/organizations/%s/invitations", orgID), req)
+	if err != nil {
+		return err
+	}
+
+	return o.client.handleResponse(resp, nil)
+}
+
+// ListOrganizationInvitations lists invitations sent by an organization
+func (o *OrganizationAPI) ListOrganizationInvitations(ctx context.Context, orgID string) ([]models.Invitation, error) {
+	return list[models.Invitation](ctx, o.client, fmt.Sprintf("/api/v1/organizations/%s/invitations", orgID))
+}
+
+// ListUserInvitations lists invitations for the current user
+func (o *OrganizationAPI) ListUserInvitations(ctx context.Context) ([]models.Invitation, error) {
+	return list[models.Invitation](ctx, o.client, "/api/v1/organizations/invitations")
 }
 
 // AcceptInvitation accepts an organization invitation
-func (o *OrganizationAPI) AcceptInvitation(invitationID string) error {
-	resp, err := o.client.doRequest("POST", fmt.Sprintf("/api/v1/organizations/invitations/%s/accept", invitationID), nil)
+func (o *OrganizationAPI) AcceptInvitation(ctx context.Context, invitationID string) error {
+	resp, err := o.client.doRequest(ctx, "POST", fmt.Sprintf("/api/v1/organizations/invitations/%s/accept", invitationID), nil)
 	if err != nil {
 		return err
 	}
@@ -235,8 +255,8 @@ func (o *OrganizationAPI) AcceptInvitation(invitationID string) error {
 }
 
 // DeclineInvitation declines an organization invitation
-func (o *OrganizationAPI) DeclineInvitation(invitationID string) error {
-	resp, err := o.client.doRequest("POST", fmt.Sprintf("/api/v1/organizations/invitations/%s/decline", invitationID), nil)
+func (o *OrganizationAPI) DeclineInvitation(ctx context.Context, invitationID string) error {
+	resp, err := o.client.doRequest(ctx, "POST", fmt.Sprintf("/api/v1/organizations/invitations/%s/decline", invitationID), nil)
 	if err != nil {
 		return err
 	}
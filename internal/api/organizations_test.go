@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"spacectl/internal/models"
+)
+
+func TestOrganizationAPI(t *testing.T) {
+	version := "1.31"
+	clouds := []string{"aws", "gcp"}
+
+	runAPICases(t, "organizations", []apiCase{
+		{name: "list_user_organizations", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewOrganizationAPI(c).ListUserOrganizations(ctx)
+		}},
+		{name: "get_default_organization", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewOrganizationAPI(c).GetDefaultOrganization(ctx)
+		}},
+		{name: "get_organization_by_name", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewOrganizationAPI(c).GetOrganizationByName(ctx, "acme")
+		}},
+		{name: "get_organization", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewOrganizationAPI(c).GetOrganization(ctx, "org-1")
+		}},
+		{name: "get_organization_unauthenticated", wantErr: ErrUnauthenticated, call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewOrganizationAPI(c).GetOrganization(ctx, "org-1")
+		}},
+		{name: "create_organization", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewOrganizationAPI(c).CreateOrganization(ctx, "acme", "Acme Corp")
+		}},
+		{name: "update_organization", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewOrganizationAPI(c).UpdateOrganization(ctx, "org-1", "acme-eu")
+		}},
+		{name: "delete_organization", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewOrganizationAPI(c).DeleteOrganization(ctx, "org-1")
+		}},
+		{name: "set_default_organization", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewOrganizationAPI(c).SetDefaultOrganization(ctx, "org-1")
+		}},
+		{name: "add_user_to_organization", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewOrganizationAPI(c).AddUserToOrganization(ctx, "org-1", "user-2", "member")
+		}},
+		{name: "remove_user_from_organization", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewOrganizationAPI(c).RemoveUserFromOrganization(ctx, "org-1", "user-2")
+		}},
+		{name: "change_user_role", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewOrganizationAPI(c).ChangeUserRole(ctx, "org-1", "user-2", "admin")
+		}},
+		{name: "get_organization_settings", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewOrganizationAPI(c).GetOrganizationSettings(ctx, "org-1")
+		}},
+		{name: "update_organization_settings", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewOrganizationAPI(c).UpdateOrganizationSettings(ctx, "org-1", models.UpdateOrganizationSettingsRequest{
+				DefaultKubernetesVersion: &version,
+				AllowedCloudProviders:    &clouds,
+			})
+		}},
+		{name: "transfer_ownership", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewOrganizationAPI(c).TransferOwnership(ctx, "org-1", "owner@example.com")
+		}},
+		{name: "send_invitation", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewOrganizationAPI(c).SendInvitation(ctx, "org-1", "dev@example.com", "member")
+		}},
+		{name: "list_organization_invitations", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewOrganizationAPI(c).ListOrganizationInvitations(ctx, "org-1")
+		}},
+		{name: "list_user_invitations", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewOrganizationAPI(c).ListUserInvitations(ctx)
+		}},
+		{name: "accept_invitation", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewOrganizationAPI(c).AcceptInvitation(ctx, "inv-1")
+		}},
+		{name: "decline_invitation", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewOrganizationAPI(c).DeclineInvitation(ctx, "inv-1")
+		}},
+	})
+}
@@ -0,0 +1,178 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// PageOptions controls how list requests are paginated
+type PageOptions struct {
+	// Limit caps the number of items returned; 0 returns every item
+	Limit int
+	// FirstPageOnly stops after the first page instead of following next-page links
+	FirstPageOnly bool
+	// Page returns only the given page, counting from 1; Limit is then the page size
+	Page int
+	// Truncated, if set, is set to true when a list stops before the last item
+	Truncated *atomic.Bool
+}
+
+// markTruncated records that a list stopped with items left to fetch
+func (o PageOptions) markTruncated(more bool) {
+	if more && o.Truncated != nil {
+		o.Truncated.Store(true)
+	}
+}
+
+type pageOptionsKey struct{}
+
+// WithPageOptions returns a context whose list requests use the given page options.
+// List requests made without page options follow every page.
+func WithPageOptions(ctx context.Context, opts PageOptions) context.Context {
+	return context.WithValue(ctx, pageOptionsKey{}, opts)
+}
+
+func pageOptionsFrom(ctx context.Context) PageOptions {
+	opts, _ := ctx.Value(pageOptionsKey{}).(PageOptions)
+	return opts
+}
+
+// list fetches a list endpoint, following pagination until the result set is
+// exhausted. The context's page options can stop it earlier: Limit caps the items
+// returned and is sent as the page size, FirstPageOnly stops after the first page,
+// and Page skips to that page and returns only its items. Endpoints may return a
+// bare JSON array or an {"items": [...], "next_page_token": "..."} envelope; a
+// Link header with rel="next" is followed in either case. Pages go through the
+// response cache so they can be served in offline mode.
+func list[T any](ctx context.Context, c *Client, path string) ([]T, error) {
+	opts := pageOptionsFrom(ctx)
+
+	next := path
+	if opts.Limit > 0 {
+		var err error
+		if next, err = setQueryParam(next, "limit", strconv.Itoa(opts.Limit)); err != nil {
+			return nil, err
+		}
+	}
+
+	items := []T{}
+	for page := 1; next != ""; page++ {
+		resp, err := c.getCached(ctx, next)
+		if err != nil {
+			return nil, err
+		}
+
+		var pageItems []T
+		nextPath, err := c.handlePage(resp, next, &pageItems)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Page > 0 && page < opts.Page {
+			// Skip the pages before the one asked for
+			if len(pageItems) == 0 {
+				break
+			}
+			next = nextPath
+			continue
+		}
+		items = append(items, pageItems...)
+		more := nextPath != "" && len(pageItems) > 0
+
+		if opts.Limit > 0 && len(items) >= opts.Limit {
+			opts.markTruncated(len(items) > opts.Limit || more)
+			return items[:opts.Limit], nil
+		}
+		if opts.Page > 0 || opts.FirstPageOnly || len(pageItems) == 0 {
+			opts.markTruncated(more)
+			break
+		}
+		next = nextPath
+	}
+
+	return items, nil
+}
+
+// handlePage decodes one page of a list response into items and returns the path of
+// the next page, or "" when this is the last page
+func (c *Client) handlePage(resp *http.Response, path string, items interface{}) (string, error) {
+	header := resp.Header
+	var raw json.RawMessage
+	if err := c.handleResponse(resp, &raw); err != nil {
+		return "", err
+	}
+
+	next := c.nextLink(header.Get("Link"))
+
+	raw = bytes.TrimSpace(raw)
+	if len(raw) > 0 && raw[0] == '{' {
+		var envelope struct {
+			Items         json.RawMessage `json:"items"`
+			NextPageToken string          `json:"next_page_token"`
+		}
+		if err := json.Unmarshal(raw, &envelope); err != nil {
+			return "", fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		raw = envelope.Items
+		if envelope.NextPageToken != "" {
+			var err error
+			if next, err = setQueryParam(path, "page_token", envelope.NextPageToken); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	if len(raw) > 0 && string(raw) != "null" {
+		if err := json.Unmarshal(raw, items); err != nil {
+			return "", fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	return next, nil
+}
+
+// nextLink extracts the rel="next" target from an RFC 8288 Link header as a path
+// relative to the client's base URL
+func (c *Client) nextLink(header string) string {
+	for _, link := range strings.Split(header, ",") {
+		parts := strings.Split(link, ";")
+		target := strings.TrimSpace(parts[0])
+		if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+		target = strings.Trim(target, "<>")
+
+		for _, param := range parts[1:] {
+			param = strings.TrimSpace(param)
+			if param != `rel="next"` && param != "rel=next" {
+				continue
+			}
+			if strings.HasPrefix(target, c.baseURL) {
+				return strings.TrimPrefix(target, c.baseURL)
+			}
+			if u, err := url.Parse(target); err == nil && u.IsAbs() {
+				return u.RequestURI()
+			}
+			return target
+		}
+	}
+	return ""
+}
+
+// setQueryParam sets a query parameter on a request path
+func setQueryParam(path, key, value string) (string, error) {
+	u, err := url.Parse(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse request path: %w", err)
+	}
+	q := u.Query()
+	q.Set(key, value)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
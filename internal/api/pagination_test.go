@@ -0,0 +1,187 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"spacectl/internal/config"
+)
+
+type item struct {
+	ID int `json:"id"`
+}
+
+func TestListFollowsLinkHeader(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/items?page=2>; rel="next", <%s/items?page=2>; rel="last"`, server.URL, server.URL))
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{}, false)
+	items, err := list[item](context.Background(), client, "/items")
+	if err != nil {
+		t.Fatalf("list returned error: %v", err)
+	}
+	if len(items) != 3 || items[2].ID != 3 {
+		t.Fatalf("expected 3 items across two pages, got %+v", items)
+	}
+}
+
+func TestListFollowsPageTokens(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cloud") != "aws" {
+			t.Errorf("expected existing query parameters to be kept, got %q", r.URL.RawQuery)
+		}
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			fmt.Fprint(w, `{"items":[{"id":1}],"next_page_token":"abc"}`)
+		case "abc":
+			fmt.Fprint(w, `{"items":[{"id":2}]}`)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{}, false)
+	items, err := list[item](context.Background(), client, "/items?cloud=aws")
+	if err != nil {
+		t.Fatalf("list returned error: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %+v", items)
+	}
+}
+
+func TestListHonorsPageOptions(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprintf(w, `{"items":[{"id":%d},{"id":%d}],"next_page_token":"more"}`, requests*2-1, requests*2)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{}, false)
+
+	ctx := WithPageOptions(context.Background(), PageOptions{Limit: 3})
+	items, err := list[item](ctx, client, "/items")
+	if err != nil {
+		t.Fatalf("list returned error: %v", err)
+	}
+	if len(items) != 3 || requests != 2 {
+		t.Fatalf("expected 3 items from 2 requests, got %d items from %d requests", len(items), requests)
+	}
+
+	requests = 0
+	ctx = WithPageOptions(context.Background(), PageOptions{FirstPageOnly: true})
+	items, err = list[item](ctx, client, "/items")
+	if err != nil {
+		t.Fatalf("list returned error: %v", err)
+	}
+	if len(items) != 2 || requests != 1 {
+		t.Fatalf("expected only the first page, got %d items from %d requests", len(items), requests)
+	}
+}
+
+func TestListReturnsOnePage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("limit") != "2" {
+			t.Errorf("expected the page size as limit, got %q", r.URL.RawQuery)
+		}
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			fmt.Fprint(w, `{"items":[{"id":1},{"id":2}],"next_page_token":"2"}`)
+		case "2":
+			fmt.Fprint(w, `{"items":[{"id":3},{"id":4}],"next_page_token":"3"}`)
+		case "3":
+			fmt.Fprint(w, `{"items":[{"id":5}]}`)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{}, false)
+	for _, tc := range []struct {
+		page      int
+		ids       []int
+		truncated bool
+	}{
+		{page: 1, ids: []int{1, 2}, truncated: true},
+		{page: 2, ids: []int{3, 4}, truncated: true},
+		{page: 3, ids: []int{5}, truncated: false},
+		{page: 4, ids: nil, truncated: false},
+	} {
+		var truncated atomic.Bool
+		ctx := WithPageOptions(context.Background(), PageOptions{Limit: 2, Page: tc.page, Truncated: &truncated})
+		items, err := list[item](ctx, client, "/items")
+		if err != nil {
+			t.Fatalf("page %d: list returned error: %v", tc.page, err)
+		}
+		var ids []int
+		for _, i := range items {
+			ids = append(ids, i.ID)
+		}
+		if fmt.Sprint(ids) != fmt.Sprint(tc.ids) || truncated.Load() != tc.truncated {
+			t.Errorf("page %d: expected %v (truncated %v), got %v (truncated %v)", tc.page, tc.ids, tc.truncated, ids, truncated.Load())
+		}
+	}
+}
+
+func TestListReportsTruncation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page_token") == "" {
+			fmt.Fprint(w, `{"items":[{"id":1},{"id":2}],"next_page_token":"2"}`)
+			return
+		}
+		fmt.Fprint(w, `{"items":[{"id":3}]}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{}, false)
+	for _, tc := range []struct {
+		name      string
+		opts      PageOptions
+		truncated bool
+	}{
+		{name: "all pages", opts: PageOptions{}, truncated: false},
+		{name: "limit within a page", opts: PageOptions{Limit: 1}, truncated: true},
+		{name: "limit at a page boundary", opts: PageOptions{Limit: 2}, truncated: true},
+		{name: "limit of every item", opts: PageOptions{Limit: 3}, truncated: false},
+		{name: "first page only", opts: PageOptions{FirstPageOnly: true}, truncated: true},
+	} {
+		var truncated atomic.Bool
+		tc.opts.Truncated = &truncated
+		if _, err := list[item](WithPageOptions(context.Background(), tc.opts), client, "/items"); err != nil {
+			t.Fatalf("%s: list returned error: %v", tc.name, err)
+		}
+		if truncated.Load() != tc.truncated {
+			t.Errorf("%s: expected truncated %v, got %v", tc.name, tc.truncated, truncated.Load())
+		}
+	}
+}
+
+func TestListReturnsEmptySlice(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `null`)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, &config.Config{}, false)
+	items, err := list[item](context.Background(), client, "/items")
+	if err != nil {
+		t.Fatalf("list returned error: %v", err)
+	}
+	if items == nil || len(items) != 0 {
+		t.Fatalf("expected an empty, non-nil slice, got %#v", items)
+	}
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 
 	"spacectl/internal/models"
@@ -17,38 +18,48 @@ func NewProjectAPI(client *Client) *ProjectAPI {
 }
 
 // ListOrganizationProjects lists projects in an organization
-func (p *ProjectAPI) ListOrganizationProjects(orgID string) ([]models.Project, error) {
-	resp, err := p.client.doRequest("GET", fmt.Sprintf("/api/v1/organizations/%s/projects", orgID), nil)
+func (p *ProjectAPI) ListOrganizationProjects(ctx context.Context, orgID string) ([]models.Project, error) {
+	return list[models.Project](ctx, p.client, fmt.Sprintf("/api/v1/organizations/%s/projects", orgID))
+}
+
+// ListUserProjects lists projects the user participates in
+func (p *ProjectAPI) ListUserProjects(ctx context.Context) ([]models.ProjectMembership, error) {
+	return list[models.ProjectMembership](ctx, p.client, "/api/v1/projects")
+}
+
+// GetProject gets a project by ID
+func (p *ProjectAPI) GetProject(ctx context.Context, id string) (*models.Project, error) {
+	resp, err := p.client.getCached(ctx, fmt.Sprintf("/api/v1/projects/%s", id))
 	if err != nil {
 		return nil, err
 	}
 
-	var projects []models.Project
-	if err := p.client.handleResponse(resp, &projects); err != nil {
+	var project models.Project
+	if err := p.client.handleResponse(resp, &project); err != nil {
 		return nil, err
 	}
 
-	return projects, nil
+	return &project, nil
 }
 
-// ListUserProjects lists projects the user participates in
-func (p *ProjectAPI) ListUserProjects() ([]models.ProjectMembership, error) {
-	resp, err := p.client.doRequest("GET", "/api/v1/projects", nil)
+// CreateProject creates a new project
+func (p *ProjectAPI) CreateProject(ctx context.Context, orgID string, req models.CreateProjectRequest) (*models.Project, error) {
+	resp, err := p.client.doRequest(ctx, "POST", fmt.Sprintf("/api/v1/organizations/%s/projects", orgID), req)
 	if err != nil {
 		return nil, err
 	}
 
-	var projects []models.ProjectMembership
-	if err := p.client.handleResponse(resp, &projects); err != nil {
+	var project models.Project
+	if err := p.client.handleResponse(resp, &project); err != nil {
 		return nil, err
 	}
 
-	return projects, nil
+	return &project, nil
 }
 
-// GetProject gets a project by ID
-func (p *ProjectAPI) GetProject(id string) (*models.Project, error) {
-	resp, err := p.client.doRequest("GET", fmt.Sprintf("/api/v1/projects/%s", id), nil)
+// UpdateProject updates a project
+func (p *ProjectAPI) UpdateProject(ctx context.Context, id string, req models.UpdateProjectRequest) (*models.Project, error) {
+	resp, err := p.client.doRequest(ctx, "PUT", fmt.Sprintf("/api/v1/projects/%s", id), req)
 	if err != nil {
 		return nil, err
 	}
@@ -61,9 +72,9 @@ func (p *ProjectAPI) GetProject(id string) (*models.Project, error) {
 	return &project, nil
 }
 
-// CreateProject creates a new project
-func (p *ProjectAPI) CreateProject(orgID string, req models.CreateProjectRequest) (*models.Project, error) {
-	resp, err := p.client.doRequest("POST", fmt.Sprintf("/api/v1/organizations/%s/projects", orgID), req)
+// PatchProject partially updates a project, changing only the fields that are set
+func (p *ProjectAPI) PatchProject(ctx context.Context, id string, req models.PatchProjectRequest) (*models.Project, error) {
+	resp, err := p.client.doRequest(ctx, "PATCH", fmt.Sprintf("/api/v1/projects/%s", id), req)
 	if err != nil {
 		return nil, err
 	}
@@ -76,9 +87,9 @@ func (p *ProjectAPI) CreateProject(orgID string, req models.CreateProjectRequest
 	return &project, nil
 }
 
-// UpdateProject updates a project
-func (p *ProjectAPI) UpdateProject(id string, req models.UpdateProjectRequest) (*models.Project, error) {
-	resp, err := p.client.doRequest("PUT", fmt.Sprintf("/api/v1/projects/%s", id), req)
+// UpdateProjectQuotas updates project quotas
+func (p *ProjectAPI) UpdateProjectQuotas(ctx context.Context, id string, req models.UpdateProjectQuotasRequest) (*models.Project, error) {
+	resp, err := p.client.doRequest(ctx, "PATCH", fmt.Sprintf("/api/v1/projects/%s/quotas", id), req)
 	if err != nil {
 		return nil, err
 	}
@@ -91,9 +102,9 @@ func (p *ProjectAPI) UpdateProject(id string, req models.UpdateProjectRequest) (
 	return &project, nil
 }
 
-// UpdateProjectQuotas updates project quotas
-func (p *ProjectAPI) UpdateProjectQuotas(id string, req models.UpdateProjectQuotasRequest) (*models.Project, error) {
-	resp, err := p.client.doRequest("PATCH", fmt.Sprintf("/api/v1/projects/%s/quotas", id), req)
+// ArchiveProject archives a project, blocking new tenants while keeping existing data
+func (p *ProjectAPI) ArchiveProject(ctx context.Context, id string) (*models.Project, error) {
+	resp, err := p.client.doRequest(ctx, "POST", fmt.Sprintf("/api/v1/projects/%s/archive", id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -106,39 +117,44 @@ func (p *ProjectAPI) UpdateProjectQuotas(id string, req models.UpdateProjectQuot
 	return &project, nil
 }
 
-// DeleteProject deletes a project
-func (p *ProjectAPI) DeleteProject(id string) error {
-	resp, err := p.client.doRequest("DELETE", fmt.Sprintf("/api/v1/projects/%s", id), nil)
+// UnarchiveProject restores an archived project to active
+func (p *ProjectAPI) UnarchiveProject(ctx context.Context, id string) (*models.Project, error) {
+	resp, err := p.client.doRequest(ctx, "POST", fmt.Sprintf("/api/v1/projects/%s/unarchive", id), nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return p.client.handleResponse(resp, nil)
+	var project models.Project
+	if err := p.client.handleResponse(resp, &project); err != nil {
+		return nil, err
+	}
+
+	return &project, nil
 }
 
-// ListProjectMembers lists project members
-func (p *ProjectAPI) ListProjectMembers(projectID string) ([]models.ProjectMember, error) {
-	resp, err := p.client.doRequest("GET", fmt.Sprintf("/api/v1/projects/%s/users", projectID), nil)
+// DeleteProject deletes a project
+func (p *ProjectAPI) DeleteProject(ctx context.Context, id string) error {
+	resp, err := p.client.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/projects/%s", id), nil)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	var members []models.ProjectMember
-	if err := p.client.handleResponse(resp, &members); err != nil {
-		return nil, err
-	}
+	return p.client.handleResponse(resp, nil)
+}
 
-	return members, nil
+// ListProjectMembers lists project members
+func (p *ProjectAPI) ListProjectMembers(ctx context.Context, projectID string) ([]models.ProjectMember, error) {
+	return list[models.ProjectMember](ctx, p.client, fmt.Sprintf("/api/v1/projects/%s/users", projectID))
 }
 
 // AddUserToProject adds a user to a project
-func (p *ProjectAPI) AddUserToProject(projectID, userID, role string) error {
+func (p *ProjectAPI) AddUserToProject(ctx context.Context, projectID, userID, role string) error {
 	req := models.AddUserToProjectRequest{
 		UserID: userID,
 		Role:   role,
 	}
 
-	resp, err := p.client.doRequest("POST", fmt.Sprintf("/api/v1/projects/%s/users", projectID), req)
+	resp, err := p.client.doRequest(ctx, "POST", fmt.Sprintf("/api/v1/projects/%s/users", projectID), req)
 	if err != nil {
 		return err
 	}
@@ -147,8 +163,8 @@ func (p *ProjectAPI) AddUserToProject(projectID, userID, role string) error {
 }
 
 // RemoveUserFromProject removes a user from a project
-func (p *ProjectAPI) RemoveUserFromProject(projectID, userID string) error {
-	resp, err := p.client.doRequest("DELETE", fmt.Sprintf("/api/v1/projects/%s/users/%s", projectID, userID), nil)
+func (p *ProjectAPI) RemoveUserFromProject(ctx context.Context, projectID, userID string) error {
+	resp, err := p.client.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/projects/%s/users/%s", projectID, userID), nil)
 	if err != nil {
 		return err
 	}
@@ -157,12 +173,12 @@ func (p *ProjectAPI) RemoveUserFromProject(projectID, userID string) error {
 }
 
 // ChangeProjectUserRole changes a user's role in a project
-func (p *ProjectAPI) ChangeProjectUserRole(projectID, userID, role string) error {
+func (p *ProjectAPI) ChangeProjectUserRole(ctx context.Context, projectID, userID, role string) error {
 	req := models.ChangeProjectUserRoleRequest{
 		Role: role,
 	}
 
-	resp, err := p.client.doRequest("PATCH", fmt.Sprintf("/api/v1/projects/%s/users/%s/role", projectID, userID), req)
+	resp, err := p.client.doRequest(ctx, "PATCH", fmt.Sprintf("/api/v1/projects/%s/users/%s/role", projectID, userID), req)
 	if err != nil {
 		return err
 	}
@@ -171,13 +187,13 @@ func (p *ProjectAPI) ChangeProjectUserRole(projectID, userID, role string) error
 }
 
 // SendProjectInvitation sends a project invitation
-func (p *ProjectAPI) SendProjectInvitation(projectID, email, role string) error {
+func (p *ProjectAPI) SendProjectInvitation(ctx context.Context, projectID, email, role string) error {
 	req := models.CreateProjectInvitationRequest{
 		Email: email,
 		Role:  role,
 	}
 
-	resp, err := p.client.doRequest("POST", fmt.Sprintf("/api/v1/projects/%s/invitations", projectID), req)
+	resp, err := p.client.doRequest(ctx, "POST", fmt.Sprintf("/api/v1/projects/%s/invitations", projectID), req)
 	if err != nil {
 		return err
 	}
@@ -186,38 +202,18 @@ func (p *ProjectAPI) SendProjectInvitation(projectID, email, role string) error
 }
 
 // ListProjectInvitations lists invitations sent for a project
-func (p *ProjectAPI) ListProjectInvitations(projectID string) ([]models.ProjectInvitation, error) {
-	resp, err := p.client.doRequest("GET", fmt.Sprintf("/api/v1/projects/%s/invitations", projectID), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var invitations []models.ProjectInvitation
-	if err := p.client.handleResponse(resp, &invitations); err != nil {
-		return nil, err
-	}
-
-	return invitations, nil
+func (p *ProjectAPI) ListProjectInvitations(ctx context.Context, projectID string) ([]models.ProjectInvitation, error) {
+	return list[models.ProjectInvitation](ctx, p.client, fmt.Sprintf("/api/v1/projects/%s/invitations", projectID))
 }
 
 // ListUserProjectInvitations lists project invitations for the current user
-func (p *ProjectAPI) ListUserProjectInvitations() ([]models.ProjectInvitation, error) {
-	resp, err := p.client.doRequest("GET", "/api/v1/projects/invitations", nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var invitations []models.ProjectInvitation
-	if err := p.client.handleResponse(resp, &invitations); err != nil {
-		return nil, err
-	}
-
-	return invitations, nil
+func (p *ProjectAPI) ListUserProjectInvitations(ctx context.Context) ([]models.ProjectInvitation, error) {
+	return list[models.ProjectInvitation](ctx, p.client, "/api/v1/projects/invitations")
 }
 
 // AcceptProjectInvitation accepts a project invitation
-func (p *ProjectAPI) AcceptProjectInvitation(invitationID string) error {
-	resp, err := p.client.doRequest("POST", fmt.Sprintf("/api/v1/projects/invitations/%s/accept", invitationID), nil)
+func (p *ProjectAPI) AcceptProjectInvitation(ctx context.Context, invitationID string) error {
+	resp, err := p.client.doRequest(ctx, "POST", fmt.Sprintf("/api/v1/projects/invitations/%s/accept", invitationID), nil)
 	if err != nil {
 		return err
 	}
@@ -226,8 +222,8 @@ func (p *ProjectAPI) AcceptProjectInvitation(invitationID string) error {
 }
 
 // DeclineProjectInvitation declines a project invitation
-func (p *ProjectAPI) DeclineProjectInvitation(invitationID string) error {
-	resp, err := p.client.doRequest("POST", fmt.Sprintf("/api/v1/projects/invitations/%s/decline", invitationID), nil)
+func (p *ProjectAPI) DeclineProjectInvitation(ctx context.Context, invitationID string) error {
+	resp, err := p.client.doRequest(ctx, "POST", fmt.Sprintf("/api/v1/projects/invitations/%s/decline", invitationID), nil)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"spacectl/internal/models"
+)
+
+func TestProjectAPI(t *testing.T) {
+	description := "Staging workloads"
+	name := "staging-eu"
+	maxTenants := 10
+
+	runAPICases(t, "projects", []apiCase{
+		{name: "list_organization_projects", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewProjectAPI(c).ListOrganizationProjects(ctx, "org-1")
+		}},
+		{name: "list_user_projects", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewProjectAPI(c).ListUserProjects(ctx)
+		}},
+		{name: "get_project", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewProjectAPI(c).GetProject(ctx, "proj-1")
+		}},
+		{name: "get_project_not_found", wantErr: ErrNotFound, call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewProjectAPI(c).GetProject(ctx, "missing")
+		}},
+		{name: "create_project", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewProjectAPI(c).CreateProject(ctx, "org-1", models.CreateProjectRequest{
+				Name:        "staging",
+				Description: &description,
+				MaxTenants:  5,
+				MaxCompute:  16,
+				MaxMemoryGB: 64,
+			})
+		}},
+		{name: "create_project_conflict", wantErr: ErrConflict, call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewProjectAPI(c).CreateProject(ctx, "org-1", models.CreateProjectRequest{Name: "staging"})
+		}},
+		{name: "update_project", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewProjectAPI(c).UpdateProject(ctx, "proj-1", models.UpdateProjectRequest{
+				Name:        "staging",
+				Description: &description,
+				MaxTenants:  10,
+				MaxCompute:  32,
+				MaxMemoryGB: 128,
+			})
+		}},
+		{name: "patch_project", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewProjectAPI(c).PatchProject(ctx, "proj-1", models.PatchProjectRequest{Name: &name})
+		}},
+		{name: "update_project_quotas", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewProjectAPI(c).UpdateProjectQuotas(ctx, "proj-1", models.UpdateProjectQuotasRequest{MaxTenants: &maxTenants})
+		}},
+		{name: "archive_project", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewProjectAPI(c).ArchiveProject(ctx, "proj-1")
+		}},
+		{name: "unarchive_project", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewProjectAPI(c).UnarchiveProject(ctx, "proj-1")
+		}},
+		{name: "delete_project", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewProjectAPI(c).DeleteProject(ctx, "proj-1")
+		}},
+		{name: "list_project_members", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewProjectAPI(c).ListProjectMembers(ctx, "proj-1")
+		}},
+		{name: "add_user_to_project", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewProjectAPI(c).AddUserToProject(ctx, "proj-1", "user-2", "member")
+		}},
+		{name: "remove_user_from_project", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewProjectAPI(c).RemoveUserFromProject(ctx, "proj-1", "user-2")
+		}},
+		{name: "change_project_user_role", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewProjectAPI(c).ChangeProjectUserRole(ctx, "proj-1", "user-2", "admin")
+		}},
+		{name: "send_project_invitation", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewProjectAPI(c).SendProjectInvitation(ctx, "proj-1", "dev@example.com", "member")
+		}},
+		{name: "list_project_invitations", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewProjectAPI(c).ListProjectInvitations(ctx, "proj-1")
+		}},
+		{name: "list_user_project_invitations", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewProjectAPI(c).ListUserProjectInvitations(ctx)
+		}},
+		{name: "accept_project_invitation", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewProjectAPI(c).AcceptProjectInvitation(ctx, "pinv-1")
+		}},
+		{name: "decline_project_invitation", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewProjectAPI(c).DeclineProjectInvitation(ctx, "pinv-1")
+		}},
+	})
+}
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+	"net/url"
+
+	"spacectl/internal/models"
+)
+
+// RoleAPI handles role API calls
+type RoleAPI struct {
+	client *Client
+}
+
+// NewRoleAPI creates a new RoleAPI
+func NewRoleAPI(client *Client) *RoleAPI {
+	return &RoleAPI{client: client}
+}
+
+// ListRoles lists the roles members can be given, with their permissions. A
+// non-empty scope ("org" or "project") restricts it to the roles of that scope.
+func (r *RoleAPI) ListRoles(ctx context.Context, scope string) ([]models.Role, error) {
+	if err := r.client.RequireFeature(ctx, FeatureRoles); err != nil {
+		return nil, err
+	}
+
+	path := "/api/v1/roles"
+	if scope != "" {
+		path += "?" + url.Values{"scope": {scope}}.Encode()
+	}
+	return list[models.Role](ctx, r.client, path)
+}
@@ -0,0 +1,14 @@
+package api
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRoleAPI(t *testing.T) {
+	runAPICases(t, "roles", []apiCase{
+		{name: "list_roles", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewRoleAPI(c).ListRoles(ctx, "project")
+		}},
+	})
+}
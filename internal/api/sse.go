@@ -0,0 +1,125 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSSELine caps the size of a single server-sent event line
+const maxSSELine = 1 << 20
+
+// sseEvent is one event of a text/event-stream response
+type sseEvent struct {
+	ID    string
+	Event string
+	Data  string
+	// Retry is the reconnection delay requested by the server, or 0
+	Retry time.Duration
+}
+
+// readSSE parses a text/event-stream body, calling fn for each complete event.
+// Comment lines (heartbeats) are skipped; blocks with only an id or retry field
+// are passed on with empty Data so the caller can track them. It returns nil when
+// the stream ends, or the first error from fn or the reader.
+func readSSE(r io.Reader, fn func(sseEvent) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64<<10), maxSSELine)
+
+	var event sseEvent
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			// A blank line dispatches the event
+			if len(data) > 0 || event.ID != "" || event.Retry > 0 {
+				event.Data = strings.Join(data, "\n")
+				if err := fn(event); err != nil {
+					return err
+				}
+			}
+			event, data = sseEvent{}, nil
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			event.Event = value
+		case "data":
+			data = append(data, value)
+		case "id":
+			event.ID = value
+		case "retry":
+			if ms, err := strconv.Atoi(value); err == nil && ms >= 0 {
+				event.Retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// openStream starts a long-lived GET of a text/event-stream endpoint. Unlike other
+// requests it has no overall timeout, since the response body stays open for as
+// long as the server sends events. lastEventID resumes the stream after that event.
+func (c *Client) openStream(ctx context.Context, path, lastEventID string) (*http.Response, error) {
+	if c.offline {
+		return nil, fmt.Errorf("%w: streaming %s needs the API", ErrOffline, path)
+	}
+
+	ctx = context.WithValue(ctx, requestIDKey{}, newRequestID())
+	url := c.baseURL + path
+	c.log.Debug("request", "method", "GET", "url", url, "request_id", requestIDFrom(ctx), "stream", true)
+	start := time.Now()
+
+	header := http.Header{}
+	header.Set("Accept", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	if lastEventID != "" {
+		header.Set("Last-Event-ID", lastEventID)
+	}
+	c.printCurl("GET", url, c.requestHeader(ctx, header), nil)
+
+	streamClient := &http.Client{Transport: c.transport}
+	send := func() (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header = c.requestHeader(ctx, header)
+		return streamClient.Do(req)
+	}
+
+	resp, err := send()
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	// Handle 401 - try to refresh token
+	if resp.StatusCode == http.StatusUnauthorized && c.canRefresh() {
+		staleToken := strings.TrimPrefix(resp.Request.Header.Get("Authorization"), "Bearer ")
+		discardBody(resp)
+
+		if err := c.refreshToken(ctx, staleToken); err != nil {
+			return nil, fmt.Errorf("authentication failed: %w", err)
+		}
+		if resp, err = send(); err != nil {
+			return nil, fmt.Errorf("retry request failed: %w", err)
+		}
+	}
+
+	c.log.Info("response", "method", "GET", "url", url, "status", resp.StatusCode,
+		"request_id", responseRequestID(resp, nil), "duration", time.Since(start), "stream", true)
+	c.printWarnings(resp)
+
+	return resp, nil
+}
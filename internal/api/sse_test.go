@@ -0,0 +1,59 @@
+package api
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadSSE(t *testing.T) {
+	stream := strings.Join([]string{
+		": connected",
+		"",
+		"retry: 2500",
+		"",
+		"id: 1",
+		"event: tenant.created",
+		"data: first",
+		"",
+		"data:no space",
+		"data: second line",
+		"",
+		"id: 2",
+		"",
+		"data: unterminated",
+	}, "\n")
+
+	var got []sseEvent
+	err := readSSE(strings.NewReader(stream), func(e sseEvent) error {
+		got = append(got, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []sseEvent{
+		{Retry: 2500 * time.Millisecond},
+		{ID: "1", Event: "tenant.created", Data: "first"},
+		{Data: "no space\nsecond line"},
+		{ID: "2"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestReadSSEStopsOnHandlerError(t *testing.T) {
+	stop := errors.New("stop")
+	calls := 0
+	err := readSSE(strings.NewReader("data: a\n\ndata: b\n\n"), func(sseEvent) error {
+		calls++
+		return stop
+	})
+	if err != stop || calls != 1 {
+		t.Errorf("expected to stop after the first event, got %v after %d calls", err, calls)
+	}
+}
@@ -1,9 +1,11 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
 	"spacectl/internal/models"
 )
@@ -19,23 +21,13 @@ func NewTenantAPI(client *Client) *TenantAPI {
 }
 
 // ListProjectTenants lists tenants in a project
-func (t *TenantAPI) ListProjectTenants(projectID string) ([]models.Tenant, error) {
-	resp, err := t.client.doRequest("GET", fmt.Sprintf("/api/v1/projects/%s/tenants", projectID), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	var tenants []models.Tenant
-	if err := t.client.handleResponse(resp, &tenants); err != nil {
-		return nil, err
-	}
-
-	return tenants, nil
+func (t *TenantAPI) ListProjectTenants(ctx context.Context, projectID string) ([]models.Tenant, error) {
+	return list[models.Tenant](ctx, t.client, fmt.Sprintf("/api/v1/projects/%s/tenants", projectID))
 }
 
 // GetTenant gets a tenant by ID
-func (t *TenantAPI) GetTenant(id string) (*models.Tenant, error) {
-	resp, err := t.client.doRequest("GET", fmt.Sprintf("/api/v1/tenants/%s", id), nil)
+func (t *TenantAPI) GetTenant(ctx context.Context, id string) (*models.Tenant, error) {
+	resp, err := t.client.getCached(ctx, fmt.Sprintf("/api/v1/tenants/%s", id))
 	if err != nil {
 		return nil, err
 	}
@@ -49,8 +41,8 @@ func (t *TenantAPI) GetTenant(id string) (*models.Tenant, error) {
 }
 
 // CreateTenant creates a new tenant
-func (t *TenantAPI) CreateTenant(projectID string, req models.CreateTenantRequest) (*models.Tenant, error) {
-	resp, err := t.client.doRequest("POST", fmt.Sprintf("/api/v1/projects/%s/tenants", projectID), req)
+func (t *TenantAPI) CreateTenant(ctx context.Context, projectID string, req models.CreateTenantRequest) (*models.Tenant, error) {
+	resp, err := t.client.doRequest(ctx, "POST", fmt.Sprintf("/api/v1/projects/%s/tenants", projectID), req)
 	if err != nil {
 		return nil, err
 	}
@@ -64,8 +56,8 @@ func (t *TenantAPI) CreateTenant(projectID string, req models.CreateTenantReques
 }
 
 // UpdateTenant updates a tenant
-func (t *TenantAPI) UpdateTenant(id string, req models.UpdateTenantRequest) (*models.Tenant, error) {
-	resp, err := t.client.doRequest("PATCH", fmt.Sprintf("/api/v1/tenants/%s", id), req)
+func (t *TenantAPI) UpdateTenant(ctx context.Context, id string, req models.UpdateTenantRequest) (*models.Tenant, error) {
+	resp, err := t.client.doRequest(ctx, "PATCH", fmt.Sprintf("/api/v1/tenants/%s", id), req)
 	if err != nil {
 		return nil, err
 	}
@@ -79,8 +71,8 @@ func (t *TenantAPI) UpdateTenant(id string, req models.UpdateTenantRequest) (*mo
 }
 
 // DeleteTenant deletes a tenant
-func (t *TenantAPI) DeleteTenant(id string) error {
-	resp, err := t.client.doRequest("DELETE", fmt.Sprintf("/api/v1/tenants/%s", id), nil)
+func (t *TenantAPI) DeleteTenant(ctx context.Context, id string) error {
+	resp, err := t.client.doRequest(ctx, "DELETE", fmt.Sprintf("/api/v1/tenants/%s", id), nil)
 	if err != nil {
 		return err
 	}
@@ -89,8 +81,8 @@ func (t *TenantAPI) DeleteTenant(id string) error {
 }
 
 // GetTenantStatus gets tenant provisioning status
-func (t *TenantAPI) GetTenantStatus(id string) (*models.TenantStatusResponse, error) {
-	resp, err := t.client.doRequest("GET", fmt.Sprintf("/api/v1/tenants/%s/status", id), nil)
+func (t *TenantAPI) GetTenantStatus(ctx context.Context, id string) (*models.TenantStatusResponse, error) {
+	resp, err := t.client.getCached(ctx, fmt.Sprintf("/api/v1/tenants/%s/status", id))
 	if err != nil {
 		return nil, err
 	}
@@ -103,16 +95,78 @@ func (t *TenantAPI) GetTenantStatus(id string) (*models.TenantStatusResponse, er
 	return &status, nil
 }
 
+// GetTenantUsage gets the current resource usage of a tenant. It is not
+// cached, since usage changes from one call to the next.
+func (t *TenantAPI) GetTenantUsage(ctx context.Context, id string) (*models.TenantUsage, error) {
+	if err := t.client.RequireFeature(ctx, FeatureTenantUsage); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.client.doRequest(ctx, "GET", fmt.Sprintf("/api/v1/tenants/%s/usage", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var usage models.TenantUsage
+	if err := t.client.handleResponse(resp, &usage); err != nil {
+		return nil, err
+	}
+
+	return &usage, nil
+}
+
+// KubeconfigOptions narrows the access a tenant kubeconfig grants. The zero
+// value asks for the default admin kubeconfig.
+type KubeconfigOptions struct {
+	// Role is the access the kubeconfig grants, e.g. "view" for read-only
+	Role string
+	// Namespace is the namespace a view kubeconfig is bound to
+	Namespace string
+	// UserID issues the kubeconfig for another member of the tenant's project,
+	// bound to that member's identity and access. Only project admins may.
+	UserID string
+}
+
 // GetTenantKubeconfig gets tenant kubeconfig
-func (t *TenantAPI) GetTenantKubeconfig(id string) (string, error) {
-	resp, err := t.client.doRequest("GET", fmt.Sprintf("/api/v1/tenants/%s/kubeconfig", id), nil)
+func (t *TenantAPI) GetTenantKubeconfig(ctx context.Context, id string) (string, error) {
+	return t.GetScopedTenantKubeconfig(ctx, id, KubeconfigOptions{})
+}
+
+// GetScopedTenantKubeconfig gets a tenant kubeconfig with the access given in
+// opts, e.g. a read-only credential bound to one namespace
+func (t *TenantAPI) GetScopedTenantKubeconfig(ctx context.Context, id string, opts KubeconfigOptions) (string, error) {
+	path := fmt.Sprintf("/api/v1/tenants/%s/kubeconfig", id)
+	q := url.Values{}
+	if opts.Role != "" || opts.Namespace != "" {
+		if err := t.client.RequireFeature(ctx, FeatureScopedKubeconfig); err != nil {
+			return "", err
+		}
+		if opts.Role != "" {
+			q.Set("role", opts.Role)
+		}
+		if opts.Namespace != "" {
+			q.Set("namespace", opts.Namespace)
+		}
+	}
+	if opts.UserID != "" {
+		if err := t.client.RequireFeature(ctx, FeatureMemberKubeconfig); err != nil {
+			return "", err
+		}
+		q.Set("user_id", opts.UserID)
+	}
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+
+	resp, err := t.client.doRequest(ctx, "GET", path, nil)
 	if err != nil {
 		return "", err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("failed to get kubeconfig: status %d", resp.StatusCode)
+		return "", t.client.handleResponse(resp, nil)
 	}
+	defer resp.Body.Close()
 
 	// Read the response body as string (kubeconfig content)
 	body, err := io.ReadAll(resp.Body)
@@ -124,8 +178,8 @@ func (t *TenantAPI) GetTenantKubeconfig(id string) (string, error) {
 }
 
 // GetAvailableLocations gets available cloud locations
-func (t *TenantAPI) GetAvailableLocations() ([]models.Location, error) {
-	resp, err := t.client.doRequest("GET", "/api/v1/tenants/locations", nil)
+func (t *TenantAPI) GetAvailableLocations(ctx context.Context) ([]models.Location, error) {
+	resp, err := t.client.getCached(ctx, "/api/v1/tenants/locations")
 	if err != nil {
 		return nil, err
 	}
@@ -139,8 +193,8 @@ func (t *TenantAPI) GetAvailableLocations() ([]models.Location, error) {
 }
 
 // GetAvailableClouds gets available cloud providers
-func (t *TenantAPI) GetAvailableClouds() ([]string, error) {
-	resp, err := t.client.doRequest("GET", "/api/v1/tenants/clouds", nil)
+func (t *TenantAPI) GetAvailableClouds(ctx context.Context) ([]string, error) {
+	resp, err := t.client.getCached(ctx, "/api/v1/tenants/clouds")
 	if err != nil {
 		return nil, err
 	}
@@ -154,8 +208,8 @@ func (t *TenantAPI) GetAvailableClouds() ([]string, error) {
 }
 
 // GetAvailableRegions gets available regions for a cloud provider
-func (t *TenantAPI) GetAvailableRegions(cloudProvider string) ([]string, error) {
-	resp, err := t.client.doRequest("GET", fmt.Sprintf("/api/v1/tenants/regions?cloud_provider=%s", cloudProvider), nil)
+func (t *TenantAPI) GetAvailableRegions(ctx context.Context, cloudProvider string) ([]string, error) {
+	resp, err := t.client.getCached(ctx, fmt.Sprintf("/api/v1/tenants/regions?cloud_provider=%s", cloudProvider))
 	if err != nil {
 		return nil, err
 	}
@@ -169,8 +223,8 @@ func (t *TenantAPI) GetAvailableRegions(cloudProvider string) ([]string, error)
 }
 
 // GetAvailableZones gets available zones for a cloud provider and region
-func (t *TenantAPI) GetAvailableZones(cloudProvider, region string) ([]string, error) {
-	resp, err := t.client.doRequest("GET", fmt.Sprintf("/api/v1/tenants/zones?cloud_provider=%s&region=%s", cloudProvider, region), nil)
+func (t *TenantAPI) GetAvailableZones(ctx context.Context, cloudProvider, region string) ([]string, error) {
+	resp, err := t.client.getCached(ctx, fmt.Sprintf("/api/v1/tenants/zones?cloud_provider=%s&region=%s", cloudProvider, region))
 	if err != nil {
 		return nil, err
 	}
@@ -184,8 +238,8 @@ func (t *TenantAPI) GetAvailableZones(cloudProvider, region string) ([]string, e
 }
 
 // GetAvailableKubernetesVersions gets available Kubernetes versions
-func (t *TenantAPI) GetAvailableKubernetesVersions() ([]models.KubernetesVersion, error) {
-	resp, err := t.client.doRequest("GET", "/api/v1/tenants/kubernetes-versions", nil)
+func (t *TenantAPI) GetAvailableKubernetesVersions(ctx context.Context) ([]models.KubernetesVersion, error) {
+	resp, err := t.client.getCached(ctx, "/api/v1/tenants/kubernetes-versions")
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"spacectl/internal/models"
+)
+
+func TestTenantAPI(t *testing.T) {
+	version := "1.31"
+	compute := 4
+
+	runAPICases(t, "tenants", []apiCase{
+		{name: "list_project_tenants", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).ListProjectTenants(ctx, "proj-1")
+		}},
+		{name: "get_tenant", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).GetTenant(ctx, "tenant-1")
+		}},
+		{name: "get_tenant_not_found", wantErr: ErrNotFound, call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).GetTenant(ctx, "missing")
+		}},
+		{name: "create_tenant", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).CreateTenant(ctx, "proj-1", models.CreateTenantRequest{
+				Name:              "dev",
+				CloudProvider:     "aws",
+				Region:            "eu-west-1",
+				KubernetesVersion: "1.30",
+				ComputeQuota:      2,
+				MemoryQuotaGB:     4,
+			})
+		}},
+		{name: "create_tenant_quota_exceeded", wantErr: ErrQuotaExceeded, call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).CreateTenant(ctx, "proj-1", models.CreateTenantRequest{
+				Name:          "big",
+				CloudProvider: "aws",
+				Region:        "eu-west-1",
+				ComputeQuota:  64,
+				MemoryQuotaGB: 256,
+			})
+		}},
+		{name: "update_tenant", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).UpdateTenant(ctx, "tenant-1", models.UpdateTenantRequest{
+				KubernetesVersion: &version,
+				ComputeQuota:      &compute,
+			})
+		}},
+		{name: "delete_tenant", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return nil, NewTenantAPI(c).DeleteTenant(ctx, "tenant-1")
+		}},
+		{name: "get_tenant_status", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).GetTenantStatus(ctx, "tenant-1")
+		}},
+		{name: "get_tenant_usage", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).GetTenantUsage(ctx, "tenant-1")
+		}},
+		{name: "get_tenant_kubeconfig", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).GetTenantKubeconfig(ctx, "tenant-1")
+		}},
+		{name: "get_tenant_kubeconfig_view", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).GetScopedTenantKubeconfig(ctx, "tenant-1", KubeconfigOptions{Role: "view", Namespace: "web"})
+		}},
+		{name: "get_tenant_kubeconfig_for_user", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).GetScopedTenantKubeconfig(ctx, "tenant-1", KubeconfigOptions{UserID: "user-2"})
+		}},
+		{name: "get_tenant_kubeconfig_forbidden", wantErr: ErrForbidden, call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).GetTenantKubeconfig(ctx, "tenant-1")
+		}},
+		{name: "get_available_locations", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).GetAvailableLocations(ctx)
+		}},
+		{name: "get_available_clouds", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).GetAvailableClouds(ctx)
+		}},
+		{name: "get_available_regions", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).GetAvailableRegions(ctx, "aws")
+		}},
+		{name: "get_available_zones", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).GetAvailableZones(ctx, "aws", "eu-west-1")
+		}},
+		{name: "get_available_kubernetes_versions", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewTenantAPI(c).GetAvailableKubernetesVersions(ctx)
+		}},
+	})
+}
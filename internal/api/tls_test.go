@@ -0,0 +1,102 @@
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeTestCertificate writes a self-signed certificate and its key as PEM files
+func writeTestCertificate(t *testing.T, dir string) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "spacectl-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatalf("failed to write certificate: %v", err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+	return certFile, keyFile
+}
+
+func TestLoadTLSConfigReturnsNilWithoutOptions(t *testing.T) {
+	tlsConfig, err := LoadTLSConfig(TLSOptions{})
+	if err != nil || tlsConfig != nil {
+		t.Fatalf("expected nil config without options, got %v, %v", tlsConfig, err)
+	}
+}
+
+func TestLoadTLSConfig(t *testing.T) {
+	certFile, keyFile := writeTestCertificate(t, t.TempDir())
+
+	tlsConfig, err := LoadTLSConfig(TLSOptions{
+		CACertFile:     certFile,
+		ClientCertFile: certFile,
+		ClientKeyFile:  keyFile,
+	})
+	if err != nil {
+		t.Fatalf("LoadTLSConfig returned error: %v", err)
+	}
+	if tlsConfig.RootCAs == nil {
+		t.Fatalf("expected custom root CAs to be set")
+	}
+	if len(tlsConfig.Certificates) != 1 {
+		t.Fatalf("expected one client certificate, got %d", len(tlsConfig.Certificates))
+	}
+	if tlsConfig.InsecureSkipVerify {
+		t.Fatalf("expected certificate verification to stay enabled")
+	}
+}
+
+func TestLoadTLSConfigErrors(t *testing.T) {
+	dir := t.TempDir()
+	certFile, _ := writeTestCertificate(t, dir)
+	notPEM := filepath.Join(dir, "not.pem")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	cases := map[string]TLSOptions{
+		"missing CA file":     {CACertFile: filepath.Join(dir, "missing.pem")},
+		"invalid CA file":     {CACertFile: notPEM},
+		"cert without key":    {ClientCertFile: certFile},
+		"invalid client pair": {ClientCertFile: certFile, ClientKeyFile: notPEM},
+	}
+	for name, opts := range cases {
+		if _, err := LoadTLSConfig(opts); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"spacectl/internal/models"
+)
+
+// UserAPI handles user directory API calls
+type UserAPI struct {
+	client *Client
+}
+
+// NewUserAPI creates a new UserAPI
+func NewUserAPI(client *Client) *UserAPI {
+	return &UserAPI{client: client}
+}
+
+// LookupUserByEmail finds a user by email address.
+// It returns nil without an error when no user has that email.
+func (u *UserAPI) LookupUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	resp, err := u.client.doRequest(ctx, "GET", fmt.Sprintf("/api/v1/users/lookup?email=%s", url.QueryEscape(email)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		discardBody(resp)
+		return nil, nil
+	}
+
+	var user models.User
+	if err := u.client.handleResponse(resp, &user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// userLookupBatchSize is the most user IDs looked up in one request
+const userLookupBatchSize = 100
+
+// ListUsersByID looks up users by ID, in batches of userLookupBatchSize. IDs
+// without a user are left out of the result.
+func (u *UserAPI) ListUsersByID(ctx context.Context, ids []string) ([]models.User, error) {
+	if err := u.client.RequireFeature(ctx, FeatureUserLookup); err != nil {
+		return nil, err
+	}
+
+	var users []models.User
+	for start := 0; start < len(ids); start += userLookupBatchSize {
+		end := min(start+userLookupBatchSize, len(ids))
+		batch, err := list[models.User](ctx, u.client, "/api/v1/users?ids="+url.QueryEscape(strings.Join(ids[start:end], ",")))
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, batch...)
+	}
+	return users, nil
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptrace"
+	"time"
+
+	"spacectl/internal/models"
+)
+
+// VersionAPI handles API calls about the server itself
+type VersionAPI struct {
+	client *Client
+}
+
+// NewVersionAPI creates a new VersionAPI
+func NewVersionAPI(client *Client) *VersionAPI {
+	return &VersionAPI{client: client}
+}
+
+// GetServerVersion gets the server version and the oldest client version it supports.
+// Most commands need it only through Client.ServerVersion, which fetches it once.
+func (v *VersionAPI) GetServerVersion(ctx context.Context) (*models.ServerVersion, error) {
+	resp, err := v.client.getCached(ctx, "/api/v1/version")
+	if err != nil {
+		return nil, err
+	}
+
+	var version models.ServerVersion
+	if err := v.client.handleResponse(resp, &version); err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+// HealthCheck is the server's health report and how the request for it went
+type HealthCheck struct {
+	Health *models.Health
+	// Latency is the time from sending the request to reading the whole response
+	Latency time.Duration
+	// Connect and TLSHandshake are zero when an open connection was reused
+	Connect      time.Duration
+	TLSHandshake time.Duration
+	// Proto is the HTTP version, e.g. HTTP/2.0
+	Proto string
+	// TLS is nil for plain HTTP
+	TLS *tls.ConnectionState
+}
+
+// CheckHealth checks whether the API is up. The request is sent once, without
+// retries, so its latency is that of a single round trip. The check is returned
+// with the error when the server replied with one, such as 503 when it is down.
+// The server version is only checked after a 404, so that the health check is
+// what opens the connection and its timing includes the handshakes.
+func (v *VersionAPI) CheckHealth(ctx context.Context) (*HealthCheck, error) {
+	if v.client.offline {
+		return nil, fmt.Errorf("%w: the health check needs the API", ErrOffline)
+	}
+
+	check := &HealthCheck{}
+	var connectStart, tlsStart time.Time
+	ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		ConnectStart:      func(string, string) { connectStart = time.Now() },
+		ConnectDone:       func(string, string, error) { check.Connect = time.Since(connectStart) },
+		TLSHandshakeStart: func() { tlsStart = time.Now() },
+		TLSHandshakeDone:  func(tls.ConnectionState, error) { check.TLSHandshake = time.Since(tlsStart) },
+	})
+	ctx = context.WithValue(ctx, requestIDKey{}, newRequestID())
+
+	start := time.Now()
+	resp, err := v.client.send(ctx, http.MethodGet, v.client.baseURL+"/api/v1/health", nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	check.Proto = resp.Proto
+	check.TLS = resp.TLS
+
+	var health models.Health
+	err = v.client.handleResponse(resp, &health)
+	check.Latency = time.Since(start)
+	if errors.Is(err, ErrNotFound) {
+		if unsupported := v.client.RequireFeature(ctx, FeatureHealth); unsupported != nil {
+			return check, unsupported
+		}
+	}
+	if err != nil {
+		return check, err
+	}
+	check.Health = &health
+
+	return check, nil
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestVersionAPI(t *testing.T) {
+	runAPICases(t, "version", []apiCase{
+		{name: "get_server_version", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			return NewVersionAPI(c).GetServerVersion(ctx)
+		}},
+		{name: "check_health", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			check, err := NewVersionAPI(c).CheckHealth(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if check.Latency <= 0 || check.Proto != "HTTP/1.1" || check.TLS != nil {
+				t.Errorf("unexpected check of a plain HTTP server: %+v", check)
+			}
+			return check.Health, nil
+		}},
+		{name: "check_health_unavailable", call: func(ctx context.Context, c *Client) (interface{}, error) {
+			// The check is kept with the error so the latency can still be reported
+			check, err := NewVersionAPI(c).CheckHealth(ctx)
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+				t.Errorf("expected a 503 API error, got %v", err)
+			}
+			if check == nil || check.Latency <= 0 {
+				t.Errorf("expected the check to be returned with the error, got %+v", check)
+			}
+			return nil, nil
+		}},
+	})
+}
@@ -0,0 +1,160 @@
+// Package ci writes the annotations and log sections that CI systems render
+// specially, so that failures and summaries stand out in pipeline logs.
+package ci
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// Provider is a CI system whose annotation syntax is supported
+type Provider string
+
+const (
+	GitHub Provider = "github"
+	GitLab Provider = "gitlab"
+)
+
+// ParseProvider parses a --ci value: github or gitlab
+func ParseProvider(name string) (Provider, error) {
+	switch p := Provider(strings.ToLower(strings.TrimSpace(name))); p {
+	case GitHub, GitLab:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unsupported CI provider %q (use github or gitlab)", name)
+	}
+}
+
+// Annotator writes annotations for a provider. The methods of a nil
+// Annotator do nothing, so callers need not check whether CI output is on.
+type Annotator struct {
+	provider Provider
+	w        io.Writer
+
+	// summaryFile is where GitHub job summaries go, from $GITHUB_STEP_SUMMARY
+	summaryFile string
+	now         func() time.Time
+}
+
+// New returns an Annotator writing to w
+func New(provider Provider, w io.Writer) *Annotator {
+	return &Annotator{
+		provider:    provider,
+		w:           w,
+		summaryFile: os.Getenv("GITHUB_STEP_SUMMARY"),
+		now:         time.Now,
+	}
+}
+
+// Error marks a failure. GitHub shows it on the run's summary page; GitLab
+// prints it in red.
+func (a *Annotator) Error(title, message string) {
+	a.annotate("error", title, message)
+}
+
+// Warning marks a problem that did not fail the command
+func (a *Annotator) Warning(title, message string) {
+	a.annotate("warning", title, message)
+}
+
+func (a *Annotator) annotate(level, title, message string) {
+	if a == nil {
+		return
+	}
+	switch a.provider {
+	case GitHub:
+		props := ""
+		if title != "" {
+			props = " title=" + escapeProperty(title)
+		}
+		fmt.Fprintf(a.w, "::%s%s::%s\n", level, props, escapeData(message))
+	case GitLab:
+		color := "31" // red
+		if level == "warning" {
+			color = "33" // yellow
+		}
+		if title != "" {
+			message = title + ": " + message
+		}
+		fmt.Fprintf(a.w, "\x1b[%s;1m%s: %s\x1b[0m\n", color, strings.ToUpper(level), message)
+	}
+}
+
+// StartSection starts a collapsible section of the log. id identifies the
+// section to EndSection and must be unique within the job on GitLab.
+func (a *Annotator) StartSection(id, title string) {
+	if a == nil {
+		return
+	}
+	switch a.provider {
+	case GitHub:
+		fmt.Fprintf(a.w, "::group::%s\n", escapeData(title))
+	case GitLab:
+		fmt.Fprintf(a.w, "\x1b[0Ksection_start:%d:%s\r\x1b[0K%s\n", a.now().Unix(), sectionName(id), title)
+	}
+}
+
+// EndSection ends the section started with the same id
+func (a *Annotator) EndSection(id string) {
+	if a == nil {
+		return
+	}
+	switch a.provider {
+	case GitHub:
+		fmt.Fprintln(a.w, "::endgroup::")
+	case GitLab:
+		fmt.Fprintf(a.w, "\x1b[0Ksection_end:%d:%s\r\x1b[0K\n", a.now().Unix(), sectionName(id))
+	}
+}
+
+// Summary records the outcome of a command as Markdown. On GitHub it is added
+// to the job summary when $GITHUB_STEP_SUMMARY is set; otherwise it is printed
+// in a section of the log.
+func (a *Annotator) Summary(title, markdown string) error {
+	if a == nil {
+		return nil
+	}
+	if a.provider == GitHub && a.summaryFile != "" {
+		f, err := os.OpenFile(a.summaryFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to open job summary: %w", err)
+		}
+		_, err = fmt.Fprintf(f, "### %s\n\n%s\n", title, strings.TrimRight(markdown, "\n"))
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write job summary: %w", err)
+		}
+		return nil
+	}
+	a.StartSection("summary", title)
+	fmt.Fprintln(a.w, strings.TrimRight(markdown, "\n"))
+	a.EndSection("summary")
+	return nil
+}
+
+// escapeData escapes a GitHub workflow command message
+func escapeData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeProperty escapes a GitHub workflow command property value
+func escapeProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
+
+// sectionName makes an id usable as a GitLab section name, which allows only
+// letters, digits, and _.-
+func sectionName(id string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '.', r == '-':
+			return r
+		}
+		return '_'
+	}, id)
+}
@@ -0,0 +1,111 @@
+package ci
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseProvider(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Provider
+		wantErr bool
+	}{
+		{name: "github", want: GitHub},
+		{name: "GitLab", want: GitLab},
+		{name: "jenkins", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseProvider(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseProvider(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseProvider(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestGitHubAnnotations(t *testing.T) {
+	var buf bytes.Buffer
+	a := New(GitHub, &buf)
+	a.summaryFile = ""
+	a.Error("spacectl apply", "failed: 50% done\nsee above")
+	a.Warning("", "slow")
+	a.StartSection("plan", "Plan")
+	a.EndSection("plan")
+
+	want := "::error title=spacectl apply::failed: 50%25 done%0Asee above\n" +
+		"::warning::slow\n" +
+		"::group::Plan\n" +
+		"::endgroup::\n"
+	if got := buf.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestGitLabAnnotations(t *testing.T) {
+	var buf bytes.Buffer
+	a := New(GitLab, &buf)
+	a.now = func() time.Time { return time.Unix(1700000000, 0) }
+	a.Error("spacectl apply", "failed")
+	a.StartSection("apply plan", "Plan")
+	a.EndSection("apply plan")
+
+	want := "\x1b[31;1mERROR: spacectl apply: failed\x1b[0m\n" +
+		"\x1b[0Ksection_start:1700000000:apply_plan\r\x1b[0KPlan\n" +
+		"\x1b[0Ksection_end:1700000000:apply_plan\r\x1b[0K\n"
+	if got := buf.String(); got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestGitHubSummaryFile(t *testing.T) {
+	var buf bytes.Buffer
+	a := New(GitHub, &buf)
+	a.summaryFile = filepath.Join(t.TempDir(), "summary.md")
+	if err := a.Summary("Apply", "| a | b |\n"); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Summary("Delete", "done"); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(a.summaryFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "### Apply\n\n| a | b |\n### Delete\n\ndone\n"; string(data) != want {
+		t.Errorf("summary = %q, want %q", data, want)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing in the log, got %q", buf.String())
+	}
+}
+
+func TestNilAnnotator(t *testing.T) {
+	var a *Annotator
+	a.Error("title", "message")
+	a.StartSection("id", "title")
+	a.EndSection("id")
+	if err := a.Summary("title", "body"); err != nil {
+		t.Errorf("Summary on nil Annotator = %v", err)
+	}
+}
+
+func TestSummaryWithoutFile(t *testing.T) {
+	var buf bytes.Buffer
+	a := New(GitHub, &buf)
+	a.summaryFile = ""
+	if err := a.Summary("Apply", "done\n"); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); !strings.Contains(got, "::group::Apply\ndone\n::endgroup::") {
+		t.Errorf("output = %q", got)
+	}
+}
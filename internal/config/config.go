@@ -3,8 +3,11 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 )
 
 // Config represents the spacectl configuration
@@ -19,6 +22,40 @@ type Config struct {
 	DefaultRegion  string `json:"default_region,omitempty"`
 	DefaultCompute int    `json:"default_compute,omitempty"`
 	DefaultMemory  int    `json:"default_memory,omitempty"`
+
+	// ProjectDefaults overrides the tenant creation defaults for some projects,
+	// keyed by project name or ID, e.g. small tenants for dev and large for prod
+	ProjectDefaults map[string]TenantDefaults `json:"project_defaults,omitempty"`
+
+	// DefaultProject is the project ID used when a command needs a project
+	// and none is given on the command line
+	DefaultProject string `json:"default_project,omitempty"`
+
+	// Request timeouts as durations such as "30s" or "5m"; empty uses the defaults
+	Timeout        string `json:"timeout,omitempty"`
+	ConnectTimeout string `json:"connect_timeout,omitempty"`
+
+	// ProxyURL routes API requests through this proxy instead of the one from
+	// the HTTP_PROXY/HTTPS_PROXY/NO_PROXY environment variables
+	ProxyURL string `json:"proxy_url,omitempty"`
+
+	// ConsoleURL is the address of the Kubespaces web UI; empty derives it
+	// from APIURL
+	ConsoleURL string `json:"console_url,omitempty"`
+
+	// TLS settings for self-hosted APIs behind a private CA or requiring client certificates
+	CACert                string `json:"ca_cert,omitempty"`
+	ClientCert            string `json:"client_cert,omitempty"`
+	ClientKey             string `json:"client_key,omitempty"`
+	InsecureSkipTLSVerify bool   `json:"insecure_skip_tls_verify,omitempty"`
+}
+
+// TenantDefaults are tenant creation settings of a project; unset ones fall back
+// to the global defaults
+type TenantDefaults struct {
+	Compute           int    `json:"compute,omitempty"`
+	Memory            int    `json:"memory,omitempty"`
+	KubernetesVersion string `json:"k8s_version,omitempty"`
 }
 
 // DefaultConfig returns a default configuration
@@ -95,6 +132,58 @@ func (c *Config) UpdateTokens(accessToken, refreshToken, userEmail string) {
 	c.UserEmail = userEmail
 }
 
+// Timeouts parses the configured connect and overall request timeouts.
+// A timeout that is not configured is returned as zero.
+func (c *Config) Timeouts() (connect, overall time.Duration, err error) {
+	if c.ConnectTimeout != "" {
+		if connect, err = time.ParseDuration(c.ConnectTimeout); err != nil {
+			return 0, 0, fmt.Errorf("invalid connect_timeout %q: %w", c.ConnectTimeout, err)
+		}
+	}
+	if c.Timeout != "" {
+		if overall, err = time.ParseDuration(c.Timeout); err != nil {
+			return 0, 0, fmt.Errorf("invalid timeout %q: %w", c.Timeout, err)
+		}
+	}
+	return connect, overall, nil
+}
+
+// Proxy parses the configured proxy URL, returning nil when none is configured
+func (c *Config) Proxy() (*url.URL, error) {
+	if c.ProxyURL == "" {
+		return nil, nil
+	}
+	u, err := url.Parse(c.ProxyURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy_url %q: expected a URL such as http://proxy.example.com:3128", c.ProxyURL)
+	}
+	return u, nil
+}
+
+// Console returns the base URL of the Kubespaces web UI. Unless console_url is
+// configured, it is the origin of the API URL, with a leading "api." in the
+// host name replaced by "app." (https://api.example.com -> https://app.example.com).
+func (c *Config) Console() (*url.URL, error) {
+	raw, key := c.ConsoleURL, "console_url"
+	if raw == "" {
+		raw, key = c.APIURL, "api_url"
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid %s %q: expected a URL such as https://app.example.com", key, raw)
+	}
+	if c.ConsoleURL != "" {
+		u.Path = strings.TrimSuffix(u.Path, "/")
+		return u, nil
+	}
+
+	console := &url.URL{Scheme: u.Scheme, Host: u.Host}
+	if strings.HasPrefix(u.Host, "api.") {
+		console.Host = "app." + strings.TrimPrefix(u.Host, "api.")
+	}
+	return console, nil
+}
+
 // getConfigPath returns the path to the config file
 func getConfigPath() string {
 	homeDir, err := os.UserHomeDir()
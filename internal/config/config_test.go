@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestLoadReturnsDefaultConfigWhenFileMissing(t *testing.T) {
@@ -41,6 +42,16 @@ func TestSaveAndLoadRoundTrip(t *testing.T) {
 		DefaultRegion:  "us-central1",
 		DefaultCompute: 4,
 		DefaultMemory:  16,
+		DefaultProject: "project-id",
+		ProjectDefaults: map[string]TenantDefaults{
+			"prod": {Compute: 8, Memory: 32, KubernetesVersion: "v1.32.0"},
+		},
+		Timeout:        "5m",
+		ConnectTimeout: "15s",
+		ProxyURL:       "http://proxy.example.com:3128",
+		CACert:         "/etc/ssl/kubespaces-ca.pem",
+		ClientCert:     "/etc/ssl/client.pem",
+		ClientKey:      "/etc/ssl/client-key.pem",
 	}
 
 	if err := cfg.Save(); err != nil {
@@ -80,3 +91,65 @@ func TestAuthenticationHelpers(t *testing.T) {
 		t.Fatalf("expected UserEmail to be cleared, got %q", cfg.UserEmail)
 	}
 }
+
+func TestTimeouts(t *testing.T) {
+	connect, overall, err := (&Config{}).Timeouts()
+	if err != nil || connect != 0 || overall != 0 {
+		t.Fatalf("expected unset timeouts to be zero, got %s, %s, %v", connect, overall, err)
+	}
+
+	connect, overall, err = (&Config{Timeout: "5m", ConnectTimeout: "15s"}).Timeouts()
+	if err != nil {
+		t.Fatalf("Timeouts() returned error: %v", err)
+	}
+	if connect != 15*time.Second || overall != 5*time.Minute {
+		t.Fatalf("expected 15s and 5m, got %s and %s", connect, overall)
+	}
+
+	if _, _, err := (&Config{Timeout: "soon"}).Timeouts(); err == nil {
+		t.Fatalf("expected an error for an invalid timeout")
+	}
+}
+
+func TestProxy(t *testing.T) {
+	proxy, err := (&Config{}).Proxy()
+	if err != nil || proxy != nil {
+		t.Fatalf("expected no proxy when unset, got %v, %v", proxy, err)
+	}
+
+	proxy, err = (&Config{ProxyURL: "http://proxy.example.com:3128"}).Proxy()
+	if err != nil {
+		t.Fatalf("Proxy() returned error: %v", err)
+	}
+	if proxy.Host != "proxy.example.com:3128" {
+		t.Fatalf("expected proxy host proxy.example.com:3128, got %q", proxy.Host)
+	}
+
+	if _, err := (&Config{ProxyURL: "proxy.example.com"}).Proxy(); err == nil {
+		t.Fatalf("expected an error for a proxy URL without a scheme")
+	}
+}
+
+func TestConsole(t *testing.T) {
+	tests := []struct {
+		cfg  Config
+		want string
+	}{
+		{cfg: Config{APIURL: "https://api.kubespaces.io/v1"}, want: "https://app.kubespaces.io"},
+		{cfg: Config{APIURL: "http://localhost:8080"}, want: "http://localhost:8080"},
+		{cfg: Config{APIURL: "https://api.kubespaces.io", ConsoleURL: "https://console.example.com/ui/"}, want: "https://console.example.com/ui"},
+	}
+	for _, tt := range tests {
+		got, err := tt.cfg.Console()
+		if err != nil {
+			t.Fatalf("Console() returned error: %v", err)
+		}
+		if got.String() != tt.want {
+			t.Errorf("Console() with %+v = %q, want %q", tt.cfg, got, tt.want)
+		}
+	}
+
+	if _, err := (&Config{ConsoleURL: "app.example.com"}).Console(); err == nil {
+		t.Fatalf("expected an error for a console URL without a scheme")
+	}
+}
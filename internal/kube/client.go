@@ -0,0 +1,82 @@
+// Package kube is a small read-only client for the Kubernetes API of a tenant,
+// configured from its kubeconfig, for status checks on machines without kubectl.
+package kube
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// requestTimeout bounds each request to the Kubernetes API
+const requestTimeout = 30 * time.Second
+
+// listPageSize is the most items requested per page of a list
+const listPageSize = 500
+
+// Client reads resources from a Kubernetes API server
+type Client struct {
+	clientset kubernetes.Interface
+	// Namespace is the namespace of the kubeconfig's current context, or "default"
+	Namespace string
+}
+
+// NewClient creates a client for the current context of the kubeconfig file at
+// path. It authenticates like kubectl, including with exec and auth-provider
+// plugins, and resolves relative file paths in the kubeconfig against its
+// directory.
+func NewClient(path string) (*Client, error) {
+	loader := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: path},
+		&clientcmd.ConfigOverrides{},
+	)
+	restConfig, err := loader.ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	restConfig.Timeout = requestTimeout
+	namespace, _, err := loader.Namespace()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+	return &Client{clientset: clientset, Namespace: namespace}, nil
+}
+
+// list fetches every page of a list, following the continue token. fetch lists
+// one page and returns its items and the token of the next page, or "".
+func list[T any](fetch func(opts metav1.ListOptions) ([]T, string, error)) ([]T, error) {
+	items := []T{}
+	opts := metav1.ListOptions{Limit: listPageSize}
+	for {
+		page, next, err := fetch(opts)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		items = append(items, page...)
+		if next == "" {
+			return items, nil
+		}
+		opts.Continue = next
+	}
+}
+
+// apiError adds the HTTP status to the message of a failed request, e.g.
+// "kubernetes API error (403): nodes is forbidden: ..."
+func apiError(err error) error {
+	var status apierrors.APIStatus
+	if errors.As(err, &status) {
+		s := status.Status()
+		return fmt.Errorf("kubernetes API error (%d): %s", s.Code, s.Message)
+	}
+	return fmt.Errorf("failed to reach the Kubernetes API: %w", err)
+}
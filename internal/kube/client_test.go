@@ -0,0 +1,172 @@
+package kube
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeKubeconfig writes a kubeconfig for the TLS server with the given user to
+// a temporary directory and returns its path. Credentials are only sent over TLS.
+func writeKubeconfig(t *testing.T, server *httptest.Server, user string) string {
+	t.Helper()
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	data := fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev
+  cluster: {server: %q, certificate-authority-data: %s}
+contexts:
+- name: dev
+  context: {cluster: dev, user: dev, namespace: web}
+users:
+- name: dev
+  user: %s
+`, server.URL, base64.StdEncoding.EncodeToString(ca), user)
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// newTestClient returns a client for a kubeconfig pointing at the server
+func newTestClient(t *testing.T, server *httptest.Server) *Client {
+	t.Helper()
+	client, err := NewClient(writeKubeconfig(t, server, "{token: secret}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// authorizationServer replies to any request with an empty pod list and
+// records the Authorization header of the last request
+func authorizationServer(t *testing.T, got *string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*got = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"kind":"PodList","apiVersion":"v1","metadata":{},"items":[]}`)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestListPodsPages(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+			t.Errorf("Authorization = %q", got)
+		}
+		if r.URL.Path != "/api/v1/namespaces/web/pods" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("continue") == "" {
+			fmt.Fprint(w, `{"kind":"PodList","apiVersion":"v1","metadata":{"continue":"page-2"},"items":[{"metadata":{"name":"api-1"}}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"kind":"PodList","apiVersion":"v1","metadata":{},"items":[{"metadata":{"name":"api-2"}}]}`)
+	}))
+	defer server.Close()
+
+	client := newTestClient(t, server)
+	if client.Namespace != "web" {
+		t.Errorf("Namespace = %q", client.Namespace)
+	}
+	pods, err := client.ListPods(context.Background(), client.Namespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pods) != 2 || pods[0].Metadata.Name != "api-1" || pods[1].Metadata.Name != "api-2" {
+		t.Errorf("unexpected pods %+v", pods)
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Forbidden","code":403,"message":"nodes is forbidden: User \"dev\" cannot list resource \"nodes\""}`)
+	}))
+	defer server.Close()
+
+	_, err := newTestClient(t, server).ListNodes(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "kubernetes API error (403): nodes is forbidden") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestRelativePaths(t *testing.T) {
+	var got string
+	server := authorizationServer(t, &got)
+
+	// The token file is next to the kubeconfig, not in the working directory
+	path := writeKubeconfig(t, server, "{tokenFile: token}")
+	if err := os.WriteFile(filepath.Join(filepath.Dir(path), "token"), []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(t.TempDir())
+
+	client, err := NewClient(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ListPods(context.Background(), ""); err != nil {
+		t.Fatal(err)
+	}
+	if got != "Bearer from-file" {
+		t.Errorf("Authorization = %q", got)
+	}
+}
+
+func TestExecPlugin(t *testing.T) {
+	var got string
+	server := authorizationServer(t, &got)
+
+	plugin := filepath.Join(t.TempDir(), "credentials.sh")
+	script := `#!/bin/sh
+echo '{"apiVersion":"client.authentication.k8s.io/v1","kind":"ExecCredential","status":{"token":"from-plugin"}}'
+`
+	if err := os.WriteFile(plugin, []byte(script), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	user := fmt.Sprintf("{exec: {apiVersion: client.authentication.k8s.io/v1, command: %q, interactiveMode: Never}}", plugin)
+
+	client, err := NewClient(writeKubeconfig(t, server, user))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ListPods(context.Background(), ""); err != nil {
+		t.Fatal(err)
+	}
+	if got != "Bearer from-plugin" {
+		t.Errorf("Authorization = %q", got)
+	}
+}
+
+func TestPodDisplayStatus(t *testing.T) {
+	var pod Pod
+	pod.Status.Phase = "Running"
+	pod.Spec.Containers = make([]Container, 2)
+	pod.Status.ContainerStatuses = []ContainerStatus{{Ready: true, RestartCount: 1}, {RestartCount: 4}}
+	pod.Status.ContainerStatuses[1].State.Waiting = &ContainerState{Reason: "CrashLoopBackOff"}
+
+	if got := pod.DisplayStatus(); got != "CrashLoopBackOff" {
+		t.Errorf("DisplayStatus() = %q", got)
+	}
+	if got := pod.Ready(); got != "1/2" {
+		t.Errorf("Ready() = %q", got)
+	}
+	if got := pod.Restarts(); got != 5 {
+		t.Errorf("Restarts() = %d", got)
+	}
+}
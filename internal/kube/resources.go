@@ -0,0 +1,259 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ObjectMeta is the metadata shared by all Kubernetes resources
+type ObjectMeta struct {
+	Name              string            `json:"name" yaml:"name"`
+	Namespace         string            `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	CreationTimestamp time.Time         `json:"creationTimestamp" yaml:"creationTimestamp"`
+	DeletionTimestamp *time.Time        `json:"deletionTimestamp,omitempty" yaml:"deletionTimestamp,omitempty"`
+}
+
+// Condition is a condition of a node's status
+type Condition struct {
+	Type   string `json:"type" yaml:"type"`
+	Status string `json:"status" yaml:"status"`
+}
+
+// Node is a node of the cluster, with the fields spacectl shows
+type Node struct {
+	Metadata ObjectMeta `json:"metadata" yaml:"metadata"`
+	Spec     struct {
+		Unschedulable bool `json:"unschedulable,omitempty" yaml:"unschedulable,omitempty"`
+	} `json:"spec" yaml:"spec"`
+	Status struct {
+		Conditions []Condition `json:"conditions" yaml:"conditions"`
+		NodeInfo   struct {
+			KubeletVersion string `json:"kubeletVersion" yaml:"kubeletVersion"`
+		} `json:"nodeInfo" yaml:"nodeInfo"`
+	} `json:"status" yaml:"status"`
+}
+
+// Container is one of a pod's containers
+type Container struct {
+	Name  string `json:"name" yaml:"name"`
+	Image string `json:"image" yaml:"image"`
+}
+
+// ContainerState is why a container is waiting or terminated
+type ContainerState struct {
+	Reason string `json:"reason" yaml:"reason"`
+}
+
+// ContainerStatus is the state of one of a pod's containers
+type ContainerStatus struct {
+	Name         string `json:"name" yaml:"name"`
+	Ready        bool   `json:"ready" yaml:"ready"`
+	RestartCount int    `json:"restartCount" yaml:"restartCount"`
+	State        struct {
+		Waiting    *ContainerState `json:"waiting,omitempty" yaml:"waiting,omitempty"`
+		Terminated *ContainerState `json:"terminated,omitempty" yaml:"terminated,omitempty"`
+	} `json:"state" yaml:"state"`
+}
+
+// Pod is a pod, with the fields spacectl shows
+type Pod struct {
+	Metadata ObjectMeta `json:"metadata" yaml:"metadata"`
+	Spec     struct {
+		NodeName   string      `json:"nodeName,omitempty" yaml:"nodeName,omitempty"`
+		Containers []Container `json:"containers" yaml:"containers"`
+	} `json:"spec" yaml:"spec"`
+	Status struct {
+		Phase             string            `json:"phase" yaml:"phase"`
+		Reason            string            `json:"reason,omitempty" yaml:"reason,omitempty"`
+		ContainerStatuses []ContainerStatus `json:"containerStatuses,omitempty" yaml:"containerStatuses,omitempty"`
+	} `json:"status" yaml:"status"`
+}
+
+// Namespace is a namespace of the cluster
+type Namespace struct {
+	Metadata ObjectMeta `json:"metadata" yaml:"metadata"`
+	Status   struct {
+		Phase string `json:"phase" yaml:"phase"`
+	} `json:"status" yaml:"status"`
+}
+
+// ListNodes lists the nodes of the cluster
+func (c *Client) ListNodes(ctx context.Context) ([]Node, error) {
+	return list(func(opts metav1.ListOptions) ([]Node, string, error) {
+		page, err := c.clientset.CoreV1().Nodes().List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		nodes := make([]Node, len(page.Items))
+		for i, n := range page.Items {
+			nodes[i] = nodeFrom(n)
+		}
+		return nodes, page.Continue, nil
+	})
+}
+
+// ListPods lists the pods of a namespace, or of all namespaces when it is ""
+func (c *Client) ListPods(ctx context.Context, namespace string) ([]Pod, error) {
+	return list(func(opts metav1.ListOptions) ([]Pod, string, error) {
+		page, err := c.clientset.CoreV1().Pods(namespace).List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		pods := make([]Pod, len(page.Items))
+		for i, p := range page.Items {
+			pods[i] = podFrom(p)
+		}
+		return pods, page.Continue, nil
+	})
+}
+
+// ListNamespaces lists the namespaces of the cluster
+func (c *Client) ListNamespaces(ctx context.Context) ([]Namespace, error) {
+	return list(func(opts metav1.ListOptions) ([]Namespace, string, error) {
+		page, err := c.clientset.CoreV1().Namespaces().List(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+		namespaces := make([]Namespace, len(page.Items))
+		for i, ns := range page.Items {
+			namespaces[i].Metadata = objectMetaFrom(ns.ObjectMeta)
+			namespaces[i].Status.Phase = string(ns.Status.Phase)
+		}
+		return namespaces, page.Continue, nil
+	})
+}
+
+// objectMetaFrom keeps the metadata fields spacectl shows
+func objectMetaFrom(m metav1.ObjectMeta) ObjectMeta {
+	meta := ObjectMeta{
+		Name:              m.Name,
+		Namespace:         m.Namespace,
+		Labels:            m.Labels,
+		CreationTimestamp: m.CreationTimestamp.Time,
+	}
+	if m.DeletionTimestamp != nil {
+		t := m.DeletionTimestamp.Time
+		meta.DeletionTimestamp = &t
+	}
+	return meta
+}
+
+// nodeFrom keeps the node fields spacectl shows
+func nodeFrom(n corev1.Node) Node {
+	var node Node
+	node.Metadata = objectMetaFrom(n.ObjectMeta)
+	node.Spec.Unschedulable = n.Spec.Unschedulable
+	for _, c := range n.Status.Conditions {
+		node.Status.Conditions = append(node.Status.Conditions, Condition{Type: string(c.Type), Status: string(c.Status)})
+	}
+	node.Status.NodeInfo.KubeletVersion = n.Status.NodeInfo.KubeletVersion
+	return node
+}
+
+// podFrom keeps the pod fields spacectl shows
+func podFrom(p corev1.Pod) Pod {
+	var pod Pod
+	pod.Metadata = objectMetaFrom(p.ObjectMeta)
+	pod.Spec.NodeName = p.Spec.NodeName
+	for _, c := range p.Spec.Containers {
+		pod.Spec.Containers = append(pod.Spec.Containers, Container{Name: c.Name, Image: c.Image})
+	}
+	pod.Status.Phase = string(p.Status.Phase)
+	pod.Status.Reason = p.Status.Reason
+	for _, s := range p.Status.ContainerStatuses {
+		status := ContainerStatus{Name: s.Name, Ready: s.Ready, RestartCount: int(s.RestartCount)}
+		if w := s.State.Waiting; w != nil {
+			status.State.Waiting = &ContainerState{Reason: w.Reason}
+		}
+		if t := s.State.Terminated; t != nil {
+			status.State.Terminated = &ContainerState{Reason: t.Reason}
+		}
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, status)
+	}
+	return pod
+}
+
+// nodeRolePrefix is the prefix of the labels that give a node its roles
+const nodeRolePrefix = "node-role.kubernetes.io/"
+
+// DisplayStatus summarizes a node's readiness like kubectl, e.g. "Ready" or
+// "NotReady,SchedulingDisabled"
+func (n Node) DisplayStatus() string {
+	status := "Unknown"
+	for _, c := range n.Status.Conditions {
+		if c.Type == "Ready" {
+			if c.Status == "True" {
+				status = "Ready"
+			} else {
+				status = "NotReady"
+			}
+		}
+	}
+	if n.Spec.Unschedulable {
+		status += ",SchedulingDisabled"
+	}
+	return status
+}
+
+// Roles returns a node's roles from its labels, or "<none>"
+func (n Node) Roles() string {
+	var roles []string
+	for label := range n.Metadata.Labels {
+		if role, ok := strings.CutPrefix(label, nodeRolePrefix); ok && role != "" {
+			roles = append(roles, role)
+		}
+	}
+	if len(roles) == 0 {
+		return "<none>"
+	}
+	sort.Strings(roles)
+	return strings.Join(roles, ",")
+}
+
+// Ready returns how many of a pod's containers are ready, e.g. "1/2"
+func (p Pod) Ready() string {
+	ready := 0
+	for _, s := range p.Status.ContainerStatuses {
+		if s.Ready {
+			ready++
+		}
+	}
+	return fmt.Sprintf("%d/%d", ready, len(p.Spec.Containers))
+}
+
+// Restarts returns the total restart count of a pod's containers
+func (p Pod) Restarts() int {
+	restarts := 0
+	for _, s := range p.Status.ContainerStatuses {
+		restarts += s.RestartCount
+	}
+	return restarts
+}
+
+// DisplayStatus summarizes a pod's state like kubectl: the reason a container is
+// waiting or terminated (e.g. CrashLoopBackOff), Terminating, or else the phase
+func (p Pod) DisplayStatus() string {
+	if p.Metadata.DeletionTimestamp != nil {
+		return "Terminating"
+	}
+	status := p.Status.Phase
+	if p.Status.Reason != "" {
+		status = p.Status.Reason
+	}
+	for _, s := range p.Status.ContainerStatuses {
+		switch {
+		case s.State.Waiting != nil && s.State.Waiting.Reason != "":
+			return s.State.Waiting.Reason
+		case s.State.Terminated != nil && s.State.Terminated.Reason != "" && !s.Ready:
+			status = s.State.Terminated.Reason
+		}
+	}
+	return status
+}
@@ -0,0 +1,175 @@
+// Package kubeconfig rewrites the kubeconfig files of tenants, e.g. to keep only
+// the current context or to rename it, and converts them to YAML or JSON.
+package kubeconfig
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is a parsed kubeconfig file. Fields this package does not know about,
+// such as preferences and extensions, are kept as they are.
+type Config struct {
+	doc map[string]interface{}
+}
+
+// Parse parses a kubeconfig file in YAML or JSON
+func Parse(data []byte) (*Config, error) {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+	if doc == nil {
+		return nil, fmt.Errorf("kubeconfig is empty")
+	}
+	return &Config{doc: doc}, nil
+}
+
+// CurrentContext returns the name of the current context, or of the only
+// context when none is set. It fails when there is no such context.
+func (c *Config) CurrentContext() (string, error) {
+	contexts := c.entries("contexts")
+	current, _ := c.doc["current-context"].(string)
+	if current == "" {
+		if len(contexts) != 1 {
+			return "", fmt.Errorf("kubeconfig has no current context")
+		}
+		return entryName(contexts[0]), nil
+	}
+	if entry(contexts, current) == nil {
+		return "", fmt.Errorf("kubeconfig has no context named %q", current)
+	}
+	return current, nil
+}
+
+// Context is a kubeconfig's current context with the settings of its cluster and
+// user, as found under the "cluster" and "user" keys of their entries
+type Context struct {
+	Name      string
+	Namespace string
+	Cluster   map[string]interface{}
+	User      map[string]interface{}
+}
+
+// Context returns the current context (see CurrentContext)
+func (c *Config) Context() (*Context, error) {
+	current, err := c.CurrentContext()
+	if err != nil {
+		return nil, err
+	}
+	settings, _ := entry(c.entries("contexts"), current)["context"].(map[string]interface{})
+	clusterName, _ := settings["cluster"].(string)
+	userName, _ := settings["user"].(string)
+	namespace, _ := settings["namespace"].(string)
+
+	cluster := entry(c.entries("clusters"), clusterName)
+	if cluster == nil {
+		return nil, fmt.Errorf("kubeconfig has no cluster named %q", clusterName)
+	}
+	ctx := &Context{Name: current, Namespace: namespace, User: map[string]interface{}{}}
+	ctx.Cluster, _ = cluster["cluster"].(map[string]interface{})
+	if user := entry(c.entries("users"), userName); user != nil {
+		if settings, ok := user["user"].(map[string]interface{}); ok {
+			ctx.User = settings
+		}
+	}
+	return ctx, nil
+}
+
+// Minify keeps only the current context, its cluster, and its user, like
+// 'kubectl config view --minify'
+func (c *Config) Minify() error {
+	current, err := c.CurrentContext()
+	if err != nil {
+		return err
+	}
+	ctx := entry(c.entries("contexts"), current)
+	settings, _ := ctx["context"].(map[string]interface{})
+	cluster, _ := settings["cluster"].(string)
+	user, _ := settings["user"].(string)
+
+	c.doc["contexts"] = []interface{}{ctx}
+	c.doc["clusters"] = keep(c.entries("clusters"), cluster)
+	c.doc["users"] = keep(c.entries("users"), user)
+	c.doc["current-context"] = current
+	return nil
+}
+
+// RenameContext renames the current context and keeps it current
+func (c *Config) RenameContext(name string) error {
+	current, err := c.CurrentContext()
+	if err != nil {
+		return err
+	}
+	contexts := c.entries("contexts")
+	if name != current && entry(contexts, name) != nil {
+		return fmt.Errorf("kubeconfig already has a context named %q", name)
+	}
+	entry(contexts, current)["name"] = name
+	c.doc["current-context"] = name
+	return nil
+}
+
+// YAML returns the kubeconfig as YAML, indented like kubectl's
+func (c *Config) YAML() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c.doc); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// JSON returns the kubeconfig as indented JSON
+func (c *Config) JSON() ([]byte, error) {
+	data, err := json.MarshalIndent(c.doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// entries returns the named list of a kubeconfig, such as its contexts
+func (c *Config) entries(key string) []map[string]interface{} {
+	list, _ := c.doc[key].([]interface{})
+	entries := make([]map[string]interface{}, 0, len(list))
+	for _, item := range list {
+		if m, ok := item.(map[string]interface{}); ok {
+			entries = append(entries, m)
+		}
+	}
+	return entries
+}
+
+// entry returns the entry with the given name, or nil if there is none
+func entry(entries []map[string]interface{}, name string) map[string]interface{} {
+	for _, e := range entries {
+		if entryName(e) == name {
+			return e
+		}
+	}
+	return nil
+}
+
+// entryName returns the name of a cluster, context, or user entry
+func entryName(e map[string]interface{}) string {
+	name, _ := e["name"].(string)
+	return name
+}
+
+// keep returns a list holding only the entry with the given name, which is empty
+// when there is no such entry
+func keep(entries []map[string]interface{}, name string) []interface{} {
+	kept := []interface{}{}
+	if e := entry(entries, name); e != nil {
+		kept = append(kept, e)
+	}
+	return kept
+}
@@ -0,0 +1,143 @@
+package kubeconfig
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+current-context: dev
+preferences: {}
+clusters:
+- name: other
+  cluster: {server: "https://other:6443"}
+- name: dev-cluster
+  cluster: {server: "https://dev:6443", certificate-authority-data: Q0E=}
+contexts:
+- name: other
+  context: {cluster: other, user: other-user}
+- name: dev
+  context: {cluster: dev-cluster, user: dev-user, namespace: web-dev}
+users:
+- name: other-user
+  user: {token: other-token}
+- name: dev-user
+  user: {token: dev-token}
+`
+
+// names returns the names of the entries in a list of a kubeconfig
+func names(t *testing.T, c *Config, key string) string {
+	t.Helper()
+	var list []string
+	for _, e := range c.entries(key) {
+		list = append(list, entryName(e))
+	}
+	return strings.Join(list, ",")
+}
+
+func TestMinify(t *testing.T) {
+	c, err := Parse([]byte(testKubeconfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Minify(); err != nil {
+		t.Fatal(err)
+	}
+	for key, want := range map[string]string{"contexts": "dev", "clusters": "dev-cluster", "users": "dev-user"} {
+		if got := names(t, c, key); got != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+	if _, ok := c.doc["preferences"]; !ok {
+		t.Errorf("expected preferences to be kept")
+	}
+}
+
+func TestRenameContext(t *testing.T) {
+	c, err := Parse([]byte(testKubeconfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.RenameContext("other"); err == nil {
+		t.Fatalf("expected renaming to an existing context to fail")
+	}
+	if err := c.RenameContext("acme-web-dev"); err != nil {
+		t.Fatal(err)
+	}
+	if got := names(t, c, "contexts"); got != "other,acme-web-dev" {
+		t.Errorf("contexts = %q", got)
+	}
+	if current, _ := c.CurrentContext(); current != "acme-web-dev" {
+		t.Errorf("current context = %q, want acme-web-dev", current)
+	}
+}
+
+func TestCurrentContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		want    string
+		wantErr bool
+	}{
+		{name: "current", config: testKubeconfig, want: "dev"},
+		{name: "only context", config: "contexts:\n- name: solo\n  context: {cluster: c}\n", want: "solo"},
+		{name: "none", config: "contexts:\n- name: a\n- name: b\n", wantErr: true},
+		{name: "missing", config: "current-context: gone\ncontexts:\n- name: a\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		c, err := Parse([]byte(tt.config))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		got, err := c.CurrentContext()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: CurrentContext() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	c, err := Parse([]byte(testKubeconfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := c.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc struct {
+		CurrentContext string `json:"current-context"`
+		Clusters       []struct {
+			Cluster map[string]string `json:"cluster"`
+		} `json:"clusters"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, data)
+	}
+	if doc.CurrentContext != "dev" || doc.Clusters[1].Cluster["server"] != "https://dev:6443" {
+		t.Errorf("unexpected JSON:\n%s", data)
+	}
+}
+
+func TestContext(t *testing.T) {
+	c, err := Parse([]byte(testKubeconfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, err := c.Context()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ctx.Name != "dev" || ctx.Namespace != "web-dev" {
+		t.Errorf("context = %s in %s, want dev in web-dev", ctx.Name, ctx.Namespace)
+	}
+	if ctx.Cluster["server"] != "https://dev:6443" || ctx.User["token"] != "dev-token" {
+		t.Errorf("unexpected cluster %v or user %v", ctx.Cluster, ctx.User)
+	}
+}
@@ -0,0 +1,60 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// LevelOff disables logging when used as the minimum level
+const LevelOff = slog.Level(100)
+
+// ParseLevel parses a --log-level value: debug, info, warn, error, or off
+func ParseLevel(name string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	case "off", "none":
+		return LevelOff, nil
+	default:
+		return 0, fmt.Errorf("invalid log level %q (use debug, info, warn, error, or off)", name)
+	}
+}
+
+// New returns a logger writing key=value lines at or above level to w
+func New(w io.Writer, level slog.Level) *slog.Logger {
+	if level >= LevelOff {
+		return Discard()
+	}
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{Level: level}))
+}
+
+// Discard returns a logger that drops every record
+func Discard() *slog.Logger {
+	return slog.New(slog.DiscardHandler)
+}
+
+// OpenFile opens a log file for appending, creating it readable only by the user
+// since traces include URLs and account details
+func OpenFile(path string) (*os.File, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log file: %w", err)
+	}
+	return f, nil
+}
+
+// Enabled reports whether the logger records messages at level, to skip
+// building expensive attributes such as redacted request bodies
+func Enabled(logger *slog.Logger, level slog.Level) bool {
+	return logger.Enabled(context.Background(), level)
+}
@@ -0,0 +1,51 @@
+package logging
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    slog.Level
+		wantErr bool
+	}{
+		{name: "debug", want: slog.LevelDebug},
+		{name: "INFO", want: slog.LevelInfo},
+		{name: "warning", want: slog.LevelWarn},
+		{name: "error", want: slog.LevelError},
+		{name: "off", want: LevelOff},
+		{name: "verbose", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseLevel(tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseLevel(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseLevel(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNewFiltersByLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(&buf, slog.LevelInfo)
+	logger.Debug("hidden")
+	logger.Info("response", "status", 200)
+
+	out := buf.String()
+	if strings.Contains(out, "hidden") {
+		t.Errorf("expected debug messages to be dropped, got %q", out)
+	}
+	if !strings.Contains(out, "msg=response status=200") {
+		t.Errorf("expected the info message with its attributes, got %q", out)
+	}
+	if Enabled(New(&buf, LevelOff), slog.LevelError) {
+		t.Error("expected nothing to be enabled at LevelOff")
+	}
+}
@@ -0,0 +1,140 @@
+package manifest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Field describes a manifest field for spacectl explain. The schema is derived
+// from the manifest types: names and optionality come from the yaml tags and
+// descriptions from the description tags.
+type Field struct {
+	Name        string `json:"name" yaml:"name"`
+	Type        string `json:"type" yaml:"type"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Required    bool   `json:"required" yaml:"required"`
+	// Fields are the nested fields of an object, or of the items of a list of objects
+	Fields []Field `json:"fields,omitempty" yaml:"fields,omitempty"`
+}
+
+// kindTypes maps each manifest kind to its Go type
+var kindTypes = map[string]reflect.Type{
+	KindOrganization: reflect.TypeOf(Organization{}),
+	KindProject:      reflect.TypeOf(Project{}),
+	KindTenant:       reflect.TypeOf(Tenant{}),
+}
+
+// kindDescriptions describes each manifest kind
+var kindDescriptions = map[string]string{
+	KindOrganization: "An organization owns projects and their tenants. Organization manifests are read by 'spacectl apply' and 'spacectl delete -f'.",
+	KindProject:      "A project groups tenants under shared quotas and members. Project manifests are written by 'spacectl project export' and read by 'spacectl apply', 'spacectl project import', and 'spacectl delete -f'.",
+	KindTenant:       "A tenant is a Kubernetes environment in a project. Tenant manifests are read by 'spacectl apply', 'spacectl tenant create -f', and 'spacectl delete -f'.",
+}
+
+// Kinds returns the supported manifest kinds, sorted
+func Kinds() []string {
+	kinds := make([]string, 0, len(kindTypes))
+	for kind := range kindTypes {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// Explain returns the schema of a manifest kind, or of one of its fields when
+// path is a dotted field path such as "spec.region". The kind is matched
+// case-insensitively.
+func Explain(kind, path string) (string, *Field, error) {
+	for name, typ := range kindTypes {
+		if !strings.EqualFold(name, kind) {
+			continue
+		}
+		field := &Field{
+			Name:        name,
+			Type:        "object",
+			Description: kindDescriptions[name],
+			Required:    true,
+			Fields:      structFields(typ),
+		}
+		if path == "" {
+			return name, field, nil
+		}
+		for _, part := range strings.Split(path, ".") {
+			next := findField(field.Fields, part)
+			if next == nil {
+				return "", nil, fmt.Errorf("field %q does not exist in %s", path, name)
+			}
+			field = next
+		}
+		return name, field, nil
+	}
+	return "", nil, fmt.Errorf("unknown manifest kind %q (supported: %s)", kind, strings.Join(Kinds(), ", "))
+}
+
+// findField returns the field with the given name, or nil
+func findField(fields []Field, name string) *Field {
+	for i := range fields {
+		if fields[i].Name == name {
+			return &fields[i]
+		}
+	}
+	return nil
+}
+
+// structFields returns the fields of a manifest struct in declaration order,
+// flattening inline structs
+func structFields(typ reflect.Type) []Field {
+	var fields []Field
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		name, opts, _ := strings.Cut(sf.Tag.Get("yaml"), ",")
+		if name == "-" || !sf.IsExported() {
+			continue
+		}
+		if opts == "inline" || (sf.Anonymous && name == "") {
+			fields = append(fields, structFields(sf.Type)...)
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(sf.Name)
+		}
+		field := Field{
+			Name:        name,
+			Description: sf.Tag.Get("description"),
+			Required:    !strings.Contains(opts, "omitempty"),
+		}
+		field.Type, field.Fields = fieldType(sf.Type)
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// fieldType returns the schema type name of a Go type and, for objects and
+// lists of objects, their fields
+func fieldType(typ reflect.Type) (string, []Field) {
+	switch typ.Kind() {
+	case reflect.Ptr:
+		return fieldType(typ.Elem())
+	case reflect.String:
+		return "string", nil
+	case reflect.Bool:
+		return "boolean", nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer", nil
+	case reflect.Float32, reflect.Float64:
+		return "number", nil
+	case reflect.Slice, reflect.Array:
+		elem, fields := fieldType(typ.Elem())
+		return "[]" + elem, fields
+	case reflect.Map:
+		elem, _ := fieldType(typ.Elem())
+		return "map[string]" + elem, nil
+	case reflect.Struct:
+		return "object", structFields(typ)
+	default:
+		return typ.Kind().String(), nil
+	}
+}
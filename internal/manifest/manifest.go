@@ -0,0 +1,258 @@
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// APIVersion is the version written to and accepted in manifests
+const APIVersion = "spacectl.kubespaces.io/v1"
+
+// Supported manifest kinds
+const (
+	KindOrganization = "Organization"
+	KindProject      = "Project"
+	KindTenant       = "Tenant"
+)
+
+// Header holds the fields shared by every manifest and is used to detect its kind
+type Header struct {
+	APIVersion string `json:"apiVersion" yaml:"apiVersion" description:"Version of the manifest format, always spacectl.kubespaces.io/v1"`
+	Kind       string `json:"kind" yaml:"kind" description:"Type of resource the manifest describes"`
+}
+
+// Metadata identifies a resource by name and, where relevant, its parent
+type Metadata struct {
+	Name         string `json:"name" yaml:"name" description:"Name of the resource"`
+	Organization string `json:"organization,omitempty" yaml:"organization,omitempty" description:"Name of the organization the project belongs to; defaults to your default organization"`
+	Project      string `json:"project,omitempty" yaml:"project,omitempty" description:"Name of the project the tenant belongs to; defaults to the selected or default project"`
+}
+
+// Organization is the portable representation of an organization
+type Organization struct {
+	Header   `yaml:",inline"`
+	Metadata Metadata `json:"metadata" yaml:"metadata" description:"Name of the organization"`
+}
+
+// Project is the portable representation of a project
+type Project struct {
+	Header   `yaml:",inline"`
+	Metadata Metadata    `json:"metadata" yaml:"metadata" description:"Name and organization of the project"`
+	Spec     ProjectSpec `json:"spec" yaml:"spec" description:"Settings, quotas, and members of the project"`
+}
+
+// ProjectSpec holds a project's settings, quotas, and members
+type ProjectSpec struct {
+	Description string   `json:"description,omitempty" yaml:"description,omitempty" description:"Free-form description of the project"`
+	MaxTenants  int      `json:"max_tenants" yaml:"max_tenants" description:"Maximum number of tenants in the project"`
+	MaxCompute  int      `json:"max_compute" yaml:"max_compute" description:"Maximum total compute of the project's tenants, in cores"`
+	MaxMemoryGB int      `json:"max_memory_gb" yaml:"max_memory_gb" description:"Maximum total memory of the project's tenants, in GB"`
+	Members     []Member `json:"members,omitempty" yaml:"members,omitempty" description:"Users to add to the project"`
+}
+
+// Member is a project or organization member and their role. Members are
+// found by email when it is given, as user IDs differ between environments.
+type Member struct {
+	Email  string `json:"email,omitempty" yaml:"email,omitempty" description:"Email address of the user; users without an account are invited"`
+	UserID string `json:"user_id,omitempty" yaml:"user_id,omitempty" description:"ID of the user, used when no email is given"`
+	Role   string `json:"role" yaml:"role" description:"Role of the user, such as admin or member"`
+}
+
+// checkMembers checks that each member can be found, by email or user ID
+func checkMembers(members []Member) error {
+	for i, m := range members {
+		if m.Email == "" && m.UserID == "" {
+			return fmt.Errorf("spec.members[%d]: either email or user_id is required", i)
+		}
+	}
+	return nil
+}
+
+// Tenant is the portable representation of a tenant
+type Tenant struct {
+	Header   `yaml:",inline"`
+	Metadata Metadata   `json:"metadata" yaml:"metadata" description:"Name and project of the tenant"`
+	Spec     TenantSpec `json:"spec,omitempty" yaml:"spec,omitempty" description:"Location, version, and quotas of the tenant"`
+}
+
+// TenantSpec holds the settings a tenant is created with. Empty fields take
+// the same defaults as the flags of 'spacectl tenant create'.
+type TenantSpec struct {
+	CloudProvider     string `json:"cloud_provider,omitempty" yaml:"cloud_provider,omitempty" description:"Cloud provider to run the tenant on, such as eks; defaults to default_cloud from the config"`
+	Region            string `json:"region,omitempty" yaml:"region,omitempty" description:"Region to run the tenant in; defaults to default_region from the config"`
+	KubernetesVersion string `json:"kubernetes_version,omitempty" yaml:"kubernetes_version,omitempty" description:"Kubernetes version of the tenant; defaults to the latest available version"`
+	ComputeQuota      int    `json:"compute_quota,omitempty" yaml:"compute_quota,omitempty" description:"Compute quota in cores; defaults to default_compute from the config"`
+	MemoryQuotaGB     int    `json:"memory_quota_gb,omitempty" yaml:"memory_quota_gb,omitempty" description:"Memory quota in GB; defaults to default_memory from the config"`
+	NamespaceSuffix   string `json:"namespace_suffix,omitempty" yaml:"namespace_suffix,omitempty" description:"Suffix appended to the tenant's namespace on the host cluster"`
+}
+
+// NewOrganization returns an Organization manifest with the header filled in
+func NewOrganization(name string) *Organization {
+	return &Organization{
+		Header:   Header{APIVersion: APIVersion, Kind: KindOrganization},
+		Metadata: Metadata{Name: name},
+	}
+}
+
+// NewProject returns a Project manifest with the header filled in
+func NewProject(name string) *Project {
+	return &Project{
+		Header:   Header{APIVersion: APIVersion, Kind: KindProject},
+		Metadata: Metadata{Name: name},
+	}
+}
+
+// NewTenant returns a Tenant manifest with the header filled in
+func NewTenant(name string) *Tenant {
+	return &Tenant{
+		Header:   Header{APIVersion: APIVersion, Kind: KindTenant},
+		Metadata: Metadata{Name: name},
+	}
+}
+
+// ReadFile decodes all manifests in a YAML or JSON file after expanding its
+// variables with values (see Expand). Use "-" to read stdin.
+func ReadFile(path string, values Values) ([]interface{}, error) {
+	data, err := ReadData(path)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = Expand(data, values); err != nil {
+		return nil, err
+	}
+	return Decode(data)
+}
+
+// ReadData returns the contents of a manifest file. Use "-" to read stdin.
+func ReadData(path string) ([]byte, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	return data, nil
+}
+
+// ReadPath decodes all manifests in a file or, for a directory, in the .yaml,
+// .yml, and .json files directly inside it, in name order, expanding their
+// variables with values. Use "-" to read stdin.
+func ReadPath(path string, values Values) ([]interface{}, error) {
+	files, err := Files(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 1 && files[0] == path {
+		return ReadFile(path, values)
+	}
+
+	var resources []interface{}
+	for _, file := range files {
+		decoded, err := ReadFile(file, values)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		resources = append(resources, decoded...)
+	}
+	return resources, nil
+}
+
+// Files returns the manifest files a path stands for: the path itself, or the
+// .yaml, .yml, and .json files directly inside a directory, in name order
+func Files(path string) ([]string, error) {
+	if path == "-" {
+		return []string{path}, nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest directory: %w", err)
+	}
+	var files []string
+	for _, entry := range entries {
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".yaml", ".yml", ".json":
+			if !entry.IsDir() {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no manifests found in %s", path)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// Decode decodes one or more YAML (or JSON) documents into typed manifests
+func Decode(data []byte) ([]interface{}, error) {
+	var resources []interface{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for i := 1; ; i++ {
+		var node yaml.Node
+		if err := decoder.Decode(&node); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse document %d: %w", i, err)
+		}
+
+		var header Header
+		if err := node.Decode(&header); err != nil {
+			return nil, fmt.Errorf("failed to parse document %d: %w", i, err)
+		}
+		if header.APIVersion != APIVersion {
+			return nil, fmt.Errorf("document %d: unsupported apiVersion %q (expected %q)", i, header.APIVersion, APIVersion)
+		}
+
+		switch header.Kind {
+		case KindOrganization:
+			var o Organization
+			if err := node.Decode(&o); err != nil {
+				return nil, fmt.Errorf("failed to parse document %d: %w", i, err)
+			}
+			resources = append(resources, &o)
+		case KindProject:
+			var p Project
+			if err := node.Decode(&p); err != nil {
+				return nil, fmt.Errorf("failed to parse document %d: %w", i, err)
+			}
+			if err := checkMembers(p.Spec.Members); err != nil {
+				return nil, fmt.Errorf("document %d: %w", i, err)
+			}
+			resources = append(resources, &p)
+		case KindTenant:
+			var t Tenant
+			if err := node.Decode(&t); err != nil {
+				return nil, fmt.Errorf("failed to parse document %d: %w", i, err)
+			}
+			resources = append(resources, &t)
+		default:
+			return nil, fmt.Errorf("document %d: unsupported kind %q", i, header.Kind)
+		}
+	}
+
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("no manifests found")
+	}
+	return resources, nil
+}
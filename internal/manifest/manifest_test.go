@@ -0,0 +1,315 @@
+package manifest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestDecodeProjectRoundTrip(t *testing.T) {
+	p := NewProject("web")
+	p.Metadata.Organization = "acme"
+	p.Spec = ProjectSpec{
+		Description: "Web app",
+		MaxTenants:  5,
+		MaxCompute:  64,
+		MaxMemoryGB: 256,
+		Members:     []Member{{Email: "alice@example.com", UserID: "u1", Role: "admin"}},
+	}
+
+	data, err := yaml.Marshal(p)
+	if err != nil {
+		t.Fatalf("failed to marshal project: %v", err)
+	}
+
+	resources, err := Decode(data)
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if len(resources) != 1 {
+		t.Fatalf("expected 1 resource, got %d", len(resources))
+	}
+	got, ok := resources[0].(*Project)
+	if !ok {
+		t.Fatalf("expected *Project, got %T", resources[0])
+	}
+	if got.Metadata.Name != "web" || got.Metadata.Organization != "acme" {
+		t.Fatalf("unexpected metadata: %+v", got.Metadata)
+	}
+	if got.Spec.MaxCompute != 64 || len(got.Spec.Members) != 1 || got.Spec.Members[0] != p.Spec.Members[0] {
+		t.Fatalf("unexpected spec: %+v", got.Spec)
+	}
+}
+
+func TestDecodeMultipleDocuments(t *testing.T) {
+	data := []byte(`apiVersion: spacectl.kubespaces.io/v1
+kind: Project
+metadata:
+  name: one
+---
+apiVersion: spacectl.kubespaces.io/v1
+kind: Project
+metadata:
+  name: two
+`)
+
+	resources, err := Decode(data)
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if len(resources) != 2 {
+		t.Fatalf("expected 2 resources, got %d", len(resources))
+	}
+}
+
+func TestDecodeRejectsUnknownKindAndVersion(t *testing.T) {
+	cases := map[string]string{
+		"kind":    "apiVersion: spacectl.kubespaces.io/v1\nkind: Widget\n",
+		"version": "apiVersion: v0\nkind: Project\n",
+		"empty":   "",
+	}
+	for name, doc := range cases {
+		if _, err := Decode([]byte(doc)); err == nil {
+			t.Fatalf("%s: expected Decode to return an error", name)
+		}
+	}
+}
+
+func TestDecodeTenant(t *testing.T) {
+	data := []byte(`apiVersion: spacectl.kubespaces.io/v1
+kind: Tenant
+metadata:
+  name: dev
+  project: web
+spec:
+  region: eu
+  compute_quota: 4
+`)
+
+	resources, err := Decode(data)
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	got, ok := resources[0].(*Tenant)
+	if !ok {
+		t.Fatalf("expected *Tenant, got %T", resources[0])
+	}
+	if got.Metadata.Project != "web" || got.Spec.Region != "eu" || got.Spec.ComputeQuota != 4 {
+		t.Fatalf("unexpected tenant: %+v", got)
+	}
+}
+
+func TestDecodeRejectsMemberWithoutUser(t *testing.T) {
+	data := []byte(`apiVersion: spacectl.kubespaces.io/v1
+kind: Project
+metadata:
+  name: web
+spec:
+  max_tenants: 1
+  max_compute: 2
+  max_memory_gb: 4
+  members:
+  - {email: alice@example.com, role: admin}
+  - {role: member}
+`)
+
+	_, err := Decode(data)
+	if err == nil || err.Error() != "document 1: spec.members[1]: either email or user_id is required" {
+		t.Fatalf("expected the member without email or user_id to be rejected, got %v", err)
+	}
+}
+
+func TestReadPathDirectory(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"b-tenant.yaml": "apiVersion: spacectl.kubespaces.io/v1\nkind: Tenant\nmetadata:\n  name: dev\n",
+		"a-org.yml":     "apiVersion: spacectl.kubespaces.io/v1\nkind: Organization\nmetadata:\n  name: acme\n",
+		"notes.txt":     "not a manifest",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	resources, err := ReadPath(dir, nil)
+	if err != nil {
+		t.Fatalf("ReadPath returned error: %v", err)
+	}
+	if len(resources) != 2 {
+		t.Fatalf("expected 2 resources, got %d", len(resources))
+	}
+	if org, ok := resources[0].(*Organization); !ok || org.Metadata.Name != "acme" {
+		t.Fatalf("expected organization acme first, got %+v", resources[0])
+	}
+	if _, ok := resources[1].(*Tenant); !ok {
+		t.Fatalf("expected *Tenant second, got %T", resources[1])
+	}
+
+	if _, err := ReadPath(t.TempDir(), nil); err == nil {
+		t.Fatalf("expected an error for a directory without manifests")
+	}
+}
+
+func TestExplain(t *testing.T) {
+	kind, field, err := Explain("tenant", "")
+	if err != nil {
+		t.Fatalf("Explain returned error: %v", err)
+	}
+	if kind != KindTenant {
+		t.Fatalf("expected kind %s, got %s", KindTenant, kind)
+	}
+	var names []string
+	for _, f := range field.Fields {
+		names = append(names, f.Name)
+	}
+	if want := "apiVersion kind metadata spec"; strings.Join(names, " ") != want {
+		t.Fatalf("expected top-level fields %q, got %q", want, names)
+	}
+
+	_, field, err = Explain("Tenant", "spec.region")
+	if err != nil {
+		t.Fatalf("Explain returned error: %v", err)
+	}
+	if field.Type != "string" || field.Required || field.Description == "" {
+		t.Fatalf("unexpected spec.region field: %+v", field)
+	}
+
+	_, field, err = Explain("project", "spec.members")
+	if err != nil {
+		t.Fatalf("Explain returned error: %v", err)
+	}
+	if field.Type != "[]object" || len(field.Fields) != 3 || field.Fields[0].Required || !field.Fields[2].Required {
+		t.Fatalf("unexpected spec.members field: %+v", field)
+	}
+
+	if _, _, err := Explain("tenant", "spec.nope"); err == nil {
+		t.Fatalf("expected an error for an unknown field")
+	}
+	if _, _, err := Explain("widget", ""); err == nil {
+		t.Fatalf("expected an error for an unknown kind")
+	}
+}
+
+func TestKindSchema(t *testing.T) {
+	s, err := KindSchema("project")
+	if err != nil {
+		t.Fatalf("KindSchema returned error: %v", err)
+	}
+	if s.Title != KindProject || s.Dialect != SchemaDialect {
+		t.Fatalf("unexpected schema header: %+v", s)
+	}
+	if got := s.Properties["kind"].Enum; len(got) != 1 || got[0] != KindProject {
+		t.Fatalf("expected kind to be fixed to %s, got %v", KindProject, got)
+	}
+	if got := strings.Join(s.Properties["spec"].Required, " "); got != "max_tenants max_compute max_memory_gb" {
+		t.Fatalf("unexpected required spec fields: %s", got)
+	}
+	if s.Property("spec.members.role") == nil || s.Property("spec.members").Type != "array" {
+		t.Fatalf("expected spec.members to be a list of objects with a role")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	v := NewValidator()
+	if err := v.SetEnum(KindTenant, "spec.region", []string{"eu", "us"}); err != nil {
+		t.Fatalf("SetEnum returned error: %v", err)
+	}
+	if err := v.SetEnum(KindTenant, "spec.nope", nil); err == nil {
+		t.Fatalf("expected SetEnum to reject an unknown field")
+	}
+
+	valid := []byte(`apiVersion: spacectl.kubespaces.io/v1
+kind: Tenant
+metadata:
+  name: dev
+spec:
+  region: eu
+  compute_quota: 4
+`)
+	problems, err := v.Validate(valid)
+	if err != nil {
+		t.Fatalf("Validate returned error: %v", err)
+	}
+	if len(problems) != 0 {
+		t.Fatalf("expected no problems, got %v", problems)
+	}
+
+	invalid := []byte(`apiVersion: spacectl.kubespaces.io/v1
+kind: Tenant
+metadata:
+  name: dev
+spec:
+  region: mars
+  compute_quota: lots
+  colour: blue
+---
+apiVersion: spacectl.kubespaces.io/v1
+kind: Project
+metadata: {}
+spec:
+  max_tenants: 1
+  max_compute: 2
+---
+kind: Widget
+`)
+	problems, err = v.Validate(invalid)
+	if err != nil {
+		t.Fatalf("Validate returned error: %v", err)
+	}
+	var got []string
+	for _, p := range problems {
+		got = append(got, fmt.Sprintf("%d %s", p.Document, p.Field))
+	}
+	want := "1 spec.colour,1 spec.compute_quota,1 spec.region,2 metadata.name,2 spec.max_memory_gb,3 kind"
+	if strings.Join(got, ",") != want {
+		t.Fatalf("expected problems %s, got %v", want, problems)
+	}
+
+	if _, err := v.Validate([]byte("a: [")); err == nil {
+		t.Fatalf("expected an error for invalid YAML")
+	}
+}
+
+func TestLoadValues(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "values.yaml")
+	if err := os.WriteFile(file, []byte("env: staging\ntenant:\n  region: eu\n  compute: 4\n"), 0o600); err != nil {
+		t.Fatalf("failed to write values: %v", err)
+	}
+
+	values, err := LoadValues([]string{file}, []string{"env=prod", "note=a=b"})
+	if err != nil {
+		t.Fatalf("LoadValues returned error: %v", err)
+	}
+	want := Values{"env": "prod", "tenant.region": "eu", "tenant.compute": "4", "note": "a=b"}
+	for key, value := range want {
+		if values[key] != value {
+			t.Fatalf("expected %s=%q, got %q", key, value, values[key])
+		}
+	}
+
+	if _, err := LoadValues(nil, []string{"novalue"}); err == nil {
+		t.Fatalf("expected an error for a --set without =")
+	}
+}
+
+func TestExpand(t *testing.T) {
+	t.Setenv("SPACECTL_TEST_REGION", "us")
+
+	got, err := Expand([]byte("name: dev-${env}\nregion: ${SPACECTL_TEST_REGION}\ncost: $5\nliteral: $${env}\n"), Values{"env": "prod"})
+	if err != nil {
+		t.Fatalf("Expand returned error: %v", err)
+	}
+	if want := "name: dev-prod\nregion: us\ncost: $5\nliteral: ${env}\n"; string(got) != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+
+	_, err = Expand([]byte("${b} ${a} ${b}"), nil)
+	if err == nil || !strings.Contains(err.Error(), "a, b (") {
+		t.Fatalf("expected an error naming a and b once, got %v", err)
+	}
+}
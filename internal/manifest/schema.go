@@ -0,0 +1,287 @@
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SchemaDialect is the JSON Schema version of the generated schemas
+const SchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is the subset of JSON Schema needed to describe manifests. Schemas
+// are generated from the manifest types, like the output of explain.
+type Schema struct {
+	Dialect              string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 string             `json:"type"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+}
+
+// KindSchema returns the JSON Schema of a manifest kind, matched case-insensitively
+func KindSchema(kind string) (*Schema, error) {
+	name, field, err := Explain(kind, "")
+	if err != nil {
+		return nil, err
+	}
+	s := fieldSchema(*field)
+	s.Dialect = SchemaDialect
+	s.Title = name
+	s.Properties["apiVersion"].Enum = []string{APIVersion}
+	s.Properties["kind"].Enum = []string{name}
+	return s, nil
+}
+
+// fieldSchema converts an explained field to a schema
+func fieldSchema(f Field) *Schema {
+	s := &Schema{Description: f.Description}
+	switch {
+	case strings.HasPrefix(f.Type, "[]"):
+		s.Type = "array"
+		s.Items = fieldSchema(Field{Type: strings.TrimPrefix(f.Type, "[]"), Fields: f.Fields})
+	case strings.HasPrefix(f.Type, "map["):
+		s.Type = "object"
+	case f.Type == "object":
+		s.Type = "object"
+		s.Properties = make(map[string]*Schema, len(f.Fields))
+		for _, child := range f.Fields {
+			s.Properties[child.Name] = fieldSchema(child)
+			if child.Required {
+				s.Required = append(s.Required, child.Name)
+			}
+		}
+		closed := false
+		s.AdditionalProperties = &closed
+	default:
+		s.Type = f.Type
+	}
+	return s
+}
+
+// Property returns the schema of a dotted property path such as "spec.region", or nil
+func (s *Schema) Property(path string) *Schema {
+	for _, part := range strings.Split(path, ".") {
+		if s == nil {
+			return nil
+		}
+		if s.Type == "array" {
+			s = s.Items
+		}
+		s = s.Properties[part]
+	}
+	return s
+}
+
+// Problem is a way in which a manifest document does not match its schema
+type Problem struct {
+	// Document is the 1-based position of the document in its file, or 0 for
+	// problems with the whole file
+	Document int    `json:"document" yaml:"document"`
+	Kind     string `json:"kind,omitempty" yaml:"kind,omitempty"`
+	Name     string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Field is the dotted path of the offending field, empty for the document itself
+	Field   string `json:"field,omitempty" yaml:"field,omitempty"`
+	Message string `json:"message" yaml:"message"`
+}
+
+func (p Problem) String() string {
+	if p.Document == 0 {
+		return p.Message
+	}
+	doc := fmt.Sprintf("document %d", p.Document)
+	if p.Kind != "" && p.Name != "" {
+		doc = fmt.Sprintf("%s (%s %s)", doc, p.Kind, p.Name)
+	}
+	if p.Field == "" {
+		return fmt.Sprintf("%s: %s", doc, p.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", doc, p.Field, p.Message)
+}
+
+// Validator checks manifest documents against the schemas of their kinds
+type Validator struct {
+	schemas map[string]*Schema
+}
+
+// NewValidator returns a Validator using the generated schema of every kind
+func NewValidator() *Validator {
+	v := &Validator{schemas: make(map[string]*Schema, len(kindTypes))}
+	for _, kind := range Kinds() {
+		s, _ := KindSchema(kind)
+		v.schemas[kind] = s
+	}
+	return v
+}
+
+// SetEnum restricts a string property of a kind, such as "spec.region" of
+// Tenant, to the given values
+func (v *Validator) SetEnum(kind, path string, values []string) error {
+	s := v.schemas[kind].Property(path)
+	if s == nil {
+		return fmt.Errorf("field %q does not exist in %s", path, kind)
+	}
+	s.Enum = append([]string(nil), values...)
+	return nil
+}
+
+// Validate checks every document in data. It returns an error only when data
+// is not valid YAML or JSON.
+func (v *Validator) Validate(data []byte) ([]Problem, error) {
+	var problems []Problem
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	i := 0
+	for {
+		var doc interface{}
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse document %d: %w", i+1, err)
+		}
+		i++
+
+		m, ok := doc.(map[string]interface{})
+		if !ok {
+			problems = append(problems, Problem{Document: i, Message: "expected a mapping with apiVersion, kind, and metadata"})
+			continue
+		}
+		kind, _ := m["kind"].(string)
+		name := ""
+		if metadata, ok := m["metadata"].(map[string]interface{}); ok {
+			name, _ = metadata["name"].(string)
+		}
+		s, ok := v.schemas[kind]
+		if !ok {
+			problems = append(problems, Problem{Document: i, Field: "kind", Message: fmt.Sprintf("unsupported kind %q (supported: %s)", kind, strings.Join(Kinds(), ", "))})
+			continue
+		}
+		for _, p := range validateValue(s, doc, "") {
+			p.Document, p.Kind, p.Name = i, kind, name
+			problems = append(problems, p)
+		}
+	}
+	if i == 0 {
+		return nil, fmt.Errorf("no manifests found")
+	}
+	return problems, nil
+}
+
+// validateValue checks a decoded value against a schema
+func validateValue(s *Schema, value interface{}, path string) []Problem {
+	problem := func(format string, args ...interface{}) []Problem {
+		return []Problem{{Field: path, Message: fmt.Sprintf(format, args...)}}
+	}
+
+	switch s.Type {
+	case "object":
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return problem("expected an object, got %s", typeName(value))
+		}
+		var problems []Problem
+		for _, name := range s.Required {
+			if m[name] == nil {
+				problems = append(problems, Problem{Field: joinPath(path, name), Message: "required field is missing"})
+			}
+		}
+		keys := make([]string, 0, len(m))
+		for key := range m {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			child, ok := s.Properties[key]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					problems = append(problems, Problem{Field: joinPath(path, key), Message: "unknown field"})
+				}
+				continue
+			}
+			if m[key] != nil {
+				problems = append(problems, validateValue(child, m[key], joinPath(path, key))...)
+			}
+		}
+		return problems
+
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return problem("expected a list, got %s", typeName(value))
+		}
+		var problems []Problem
+		for i, item := range items {
+			problems = append(problems, validateValue(s.Items, item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return problems
+
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return problem("expected a string, got %s", typeName(value))
+		}
+		if len(s.Enum) > 0 && !slices.Contains(s.Enum, str) {
+			return problem("unsupported value %q (expected one of: %s)", str, strings.Join(s.Enum, ", "))
+		}
+
+	case "integer":
+		switch n := value.(type) {
+		case int:
+		case float64:
+			if n != math.Trunc(n) {
+				return problem("expected an integer, got %v", n)
+			}
+		default:
+			return problem("expected an integer, got %s", typeName(value))
+		}
+
+	case "number":
+		switch value.(type) {
+		case int, float64:
+		default:
+			return problem("expected a number, got %s", typeName(value))
+		}
+
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return problem("expected a boolean, got %s", typeName(value))
+		}
+	}
+	return nil
+}
+
+// typeName names the type of a decoded value in problem messages
+func typeName(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "a list"
+	case string:
+		return "a string"
+	case int, float64:
+		return "a number"
+	case bool:
+		return "a boolean"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
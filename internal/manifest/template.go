@@ -0,0 +1,96 @@
+package manifest
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Values are the variables substituted into manifest templates, keyed by
+// name. Nested keys of values files are joined with dots, e.g. "tenant.region".
+type Values map[string]string
+
+// variablePattern matches ${NAME} references and the $${ escape
+var variablePattern = regexp.MustCompile(`\$\$\{|\$\{([A-Za-z_][A-Za-z0-9_.-]*)\}`)
+
+// LoadValues reads values files, in order, and then applies key=value
+// assignments, so later files and assignments win
+func LoadValues(files, sets []string) (Values, error) {
+	values := Values{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read values: %w", err)
+		}
+		var raw map[string]interface{}
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("failed to parse values file %s: %w", file, err)
+		}
+		if err := values.merge("", raw); err != nil {
+			return nil, fmt.Errorf("values file %s: %w", file, err)
+		}
+	}
+	for _, set := range sets {
+		key, value, ok := strings.Cut(set, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid value %q (expected key=value)", set)
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
+// merge adds the scalars of a decoded values file, flattening nested mappings
+func (v Values) merge(prefix string, raw map[string]interface{}) error {
+	for key, value := range raw {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		switch value := value.(type) {
+		case map[string]interface{}:
+			if err := v.merge(key, value); err != nil {
+				return err
+			}
+		case []interface{}:
+			return fmt.Errorf("%s: lists are not supported as values", key)
+		case nil:
+			v[key] = ""
+		default:
+			v[key] = fmt.Sprint(value)
+		}
+	}
+	return nil
+}
+
+// Expand replaces each ${NAME} in a manifest with the value of NAME, falling
+// back to the environment variable of that name. $${ stands for a literal ${.
+// It fails, naming all of them, if any variable is undefined.
+func Expand(data []byte, values Values) ([]byte, error) {
+	var missing []string
+	expanded := variablePattern.ReplaceAllFunc(data, func(match []byte) []byte {
+		if string(match) == "$${" {
+			return []byte("${")
+		}
+		name := string(match[2 : len(match)-1])
+		if value, ok := values[name]; ok {
+			return []byte(value)
+		}
+		if value, ok := os.LookupEnv(name); ok {
+			return []byte(value)
+		}
+		if !slices.Contains(missing, name) {
+			missing = append(missing, name)
+		}
+		return match
+	})
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("undefined variables in manifest: %s (pass them with --set or --values, or set them in the environment)", strings.Join(missing, ", "))
+	}
+	return expanded, nil
+}
@@ -0,0 +1,464 @@
+// Package models provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package models
+
+import (
+	"time"
+)
+
+// AccessCheck is whether the current user may take an action, and why
+type AccessCheck struct {
+	Allowed bool `json:"allowed"`
+
+	// Reason Why the action is allowed or denied, e.g. the role that grants it
+	Reason string `json:"reason"`
+
+	// Role Role of the user in the scope checked, if any
+	Role string `json:"role,omitempty"`
+}
+
+// AccessCheckRequest asks whether the current user may take an action on a kind of resource in an organization or project
+type AccessCheckRequest struct {
+	// Action e.g. create, update, delete or list
+	Action string `json:"action"`
+
+	// Resource Kind of resource, organization, project, tenant or member
+	Resource       string `json:"resource"`
+	OrganizationID string `json:"organization_id,omitempty"`
+	ProjectID      string `json:"project_id,omitempty"`
+}
+
+// AddUserToOrganizationRequest adds a user to an organization
+type AddUserToOrganizationRequest struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+// AddUserToProjectRequest adds a user to a project
+type AddUserToProjectRequest struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+// AuditEntry is a record of an action taken in an organization, such as a member being added or a tenant deleted
+type AuditEntry struct {
+	ID string `json:"id"`
+
+	// Action What was done, e.g. project.member_added, tenant.deleted or organization.settings_updated
+	Action string `json:"action"`
+
+	// ActorID ID of the user who took the action
+	ActorID    string `json:"actor_id"`
+	ActorEmail string `json:"actor_email,omitempty"`
+
+	// ResourceType Kind of resource acted on, organization, project or tenant
+	ResourceType   string `json:"resource_type"`
+	ResourceID     string `json:"resource_id"`
+	ResourceName   string `json:"resource_name,omitempty"`
+	ProjectID      string `json:"project_id,omitempty"`
+	OrganizationID string `json:"organization_id"`
+
+	// SourceIP Address the request came from
+	SourceIP string `json:"source_ip,omitempty"`
+
+	// Details Action-specific details, e.g. the role given to a member
+	Details   map[string]string `json:"details,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// ChangePasswordRequest changes the user's password
+type ChangePasswordRequest struct {
+	CurrentPassword     string `json:"current_password"`
+	NewPassword         string `json:"new_password"`
+	RevokeOtherSessions bool   `json:"revoke_other_sessions"`
+}
+
+// ChangeProjectUserRoleRequest changes a user's role in a project
+type ChangeProjectUserRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// ChangeUserRoleRequest changes a user's role in an organization
+type ChangeUserRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// CreateInvitationRequest invites a user to an organization
+type CreateInvitationRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+// CreateOrganizationRequest creates an organization
+type CreateOrganizationRequest struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+}
+
+// CreateProjectInvitationRequest invites a user to a project
+type CreateProjectInvitationRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+// CreateProjectRequest creates a project
+type CreateProjectRequest struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	MaxTenants  int     `json:"max_tenants"`
+	MaxCompute  int     `json:"max_compute"`
+	MaxMemoryGB int     `json:"max_memory_gb"`
+}
+
+// CreateTenantRequest creates a tenant
+type CreateTenantRequest struct {
+	Name              string `json:"name"`
+	CloudProvider     string `json:"cloud_provider"`
+	Region            string `json:"region"`
+	KubernetesVersion string `json:"kubernetes_version"`
+	ComputeQuota      int    `json:"compute_quota"`
+	MemoryQuotaGB     int    `json:"memory_quota_gb"`
+	NamespaceSuffix   string `json:"namespace_suffix"`
+}
+
+// ErrorResponse is the body of an API error
+type ErrorResponse struct {
+	Error     string `json:"error"`
+	Code      string `json:"code,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// Event is a lifecycle event of a tenant or project, such as a tenant becoming ready
+type Event struct {
+	ID string `json:"id"`
+
+	// Type Event type, e.g. tenant.created, tenant.status_changed or project.archived
+	Type string `json:"type"`
+
+	// ResourceType Kind of resource the event is about, tenant or project
+	ResourceType   string    `json:"resource_type"`
+	ResourceID     string    `json:"resource_id"`
+	ResourceName   string    `json:"resource_name,omitempty"`
+	ProjectID      string    `json:"project_id,omitempty"`
+	OrganizationID string    `json:"organization_id,omitempty"`
+	Status         string    `json:"status,omitempty"`
+	Message        string    `json:"message,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// Health reports whether the API server and the services it depends on are up
+type Health struct {
+	// Status ok, or degraded when a dependency is down
+	Status string `json:"status"`
+
+	// Checks Status of each dependency, such as the database, by name
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Invitation represents an organization invitation
+type Invitation struct {
+	ID string `json:"id"`
+
+	// Organization represents an organization
+	Organization  Organization `json:"organization"`
+	InviterUserID string       `json:"inviter_user_id"`
+	InviteeEmail  string       `json:"invitee_email"`
+	Role          string       `json:"role"`
+	Status        string       `json:"status"`
+	ExpiresAt     time.Time    `json:"expires_at"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+// KubernetesVersion represents an available Kubernetes version
+type KubernetesVersion struct {
+	Version   string `json:"version"`
+	IsDefault bool   `json:"is_default"`
+
+	// Deprecated Whether the version should no longer be used for new tenants
+	Deprecated bool `json:"deprecated,omitempty"`
+
+	// EndOfLife Date support for the version ends, as YYYY-MM-DD
+	EndOfLife string `json:"end_of_life,omitempty"`
+}
+
+// Location represents a cloud location
+type Location struct {
+	CloudProvider string `json:"cloud_provider"`
+	Region        string `json:"region"`
+	Zone          string `json:"zone"`
+}
+
+// LoginRequest holds the credentials for logging in or registering
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// LoginResponse holds the tokens issued for a session
+type LoginResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+
+	// User represents a user in the system
+	User User `json:"user"`
+}
+
+// Organization represents an organization
+type Organization struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// OrganizationMembershipResponse is an organization the user belongs to, with the user's role
+type OrganizationMembershipResponse struct {
+	// Organization represents an organization
+	Organization Organization `json:"organization"`
+	Role         string       `json:"role"`
+	IsDefault    bool         `json:"is_default"`
+}
+
+// OrganizationSettings holds organization-wide defaults and restrictions for tenants
+type OrganizationSettings struct {
+	DefaultKubernetesVersion   string   `json:"default_kubernetes_version"`
+	AllowedCloudProviders      []string `json:"allowed_cloud_providers"`
+	AllowedRegions             []string `json:"allowed_regions"`
+	DefaultTenantComputeQuota  int      `json:"default_tenant_compute_quota"`
+	DefaultTenantMemoryQuotaGB int      `json:"default_tenant_memory_quota_gb"`
+}
+
+// PatchProjectRequest is a partial project update; nil fields are left unchanged
+type PatchProjectRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	MaxTenants  *int    `json:"max_tenants,omitempty"`
+	MaxCompute  *int    `json:"max_compute,omitempty"`
+	MaxMemoryGB *int    `json:"max_memory_gb,omitempty"`
+}
+
+// Project represents a project
+type Project struct {
+	ID             string    `json:"id"`
+	OrganizationID string    `json:"organization_id"`
+	Name           string    `json:"name"`
+	Description    *string   `json:"description,omitempty"`
+	MaxTenants     int       `json:"max_tenants"`
+	MaxCompute     int       `json:"max_compute"`
+	MaxMemoryGB    int       `json:"max_memory_gb"`
+	Status         string    `json:"status,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// ProjectInvitation represents a project invitation
+type ProjectInvitation struct {
+	ID string `json:"id"`
+
+	// Project represents a project
+	Project        Project   `json:"project"`
+	OrganizationID string    `json:"organization_id"`
+	InviterUserID  string    `json:"inviter_user_id"`
+	InviteeEmail   string    `json:"invitee_email"`
+	Role           string    `json:"role"`
+	Status         string    `json:"status"`
+	ExpiresAt      time.Time `json:"expires_at"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ProjectMember is a user's membership in a project
+type ProjectMember struct {
+	UserID    string    `json:"user_id"`
+	ProjectID string    `json:"project_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ProjectMembership is a project the user belongs to, with the user's role
+type ProjectMembership struct {
+	// Project represents a project
+	Project   Project   `json:"project"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RefreshTokenRequest exchanges a refresh token for new tokens
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// ResendVerificationRequest asks for a new verification code
+type ResendVerificationRequest struct {
+	Email string `json:"email"`
+}
+
+// Role is a role that can be given to members of an organization or project, with the permissions it grants
+type Role struct {
+	Name string `json:"name"`
+
+	// Scope Where the role applies, org or project
+	Scope       string `json:"scope"`
+	Description string `json:"description"`
+
+	// Permissions Actions the role allows, e.g. tenants:create or members:manage
+	Permissions []string `json:"permissions"`
+}
+
+// ServerVersion reports the version of the Kubespaces API server and the clients it supports
+type ServerVersion struct {
+	Version string `json:"version"`
+
+	// MinClientVersion Oldest spacectl version the server supports; empty if any version is supported
+	MinClientVersion string `json:"min_client_version,omitempty"`
+}
+
+// Tenant represents a Kubernetes tenant
+type Tenant struct {
+	ID                string    `json:"id"`
+	ProjectID         string    `json:"project_id"`
+	OrganizationID    string    `json:"organization_id"`
+	HostClusterID     string    `json:"host_cluster_id"`
+	Name              string    `json:"name"`
+	CloudProvider     string    `json:"cloud_provider"`
+	Region            string    `json:"region"`
+	LocationShort     string    `json:"location_short"`
+	KubernetesVersion string    `json:"kubernetes_version"`
+	ComputeQuota      int       `json:"compute_quota"`
+	MemoryQuotaGB     int       `json:"memory_quota_gb"`
+	Status            string    `json:"status"`
+	Namespace         string    `json:"namespace"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// TenantCost is what a tenant cost in a calendar month, for chargeback. Tenants deleted during the month are included.
+type TenantCost struct {
+	TenantID    string `json:"tenant_id"`
+	TenantName  string `json:"tenant_name"`
+	ProjectID   string `json:"project_id"`
+	ProjectName string `json:"project_name"`
+
+	// Month Calendar month, as YYYY-MM
+	Month string `json:"month"`
+
+	// CPUCoreHours Compute quota in cores multiplied by the hours the tenant existed
+	CPUCoreHours float64 `json:"cpu_core_hours"`
+
+	// MemoryGBHours Memory quota in GB multiplied by the hours the tenant existed
+	MemoryGBHours float64 `json:"memory_gb_hours"`
+	ComputeCost   float64 `json:"compute_cost"`
+	MemoryCost    float64 `json:"memory_cost"`
+	TotalCost     float64 `json:"total_cost"`
+
+	// Currency ISO 4217 code of the costs, e.g. EUR
+	Currency string `json:"currency"`
+}
+
+// TenantStatusResponse reports the provisioning status of a tenant
+type TenantStatusResponse struct {
+	ID                string    `json:"id"`
+	Name              string    `json:"name"`
+	Status            string    `json:"status"`
+	Namespace         string    `json:"namespace"`
+	CloudProvider     string    `json:"cloud_provider"`
+	Region            string    `json:"region"`
+	KubernetesVersion string    `json:"kubernetes_version"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// TenantUsage is the resource usage of a tenant's workloads, as last collected
+type TenantUsage struct {
+	TenantID string `json:"tenant_id"`
+
+	// CPUCores CPU cores in use, averaged over the collection interval
+	CPUCores float64 `json:"cpu_cores"`
+
+	// MemoryGB Memory in use, in GB
+	MemoryGB    float64   `json:"memory_gb"`
+	CollectedAt time.Time `json:"collected_at"`
+}
+
+// TransferOwnershipRequest makes another member the organization owner
+type TransferOwnershipRequest struct {
+	NewOwnerEmail string `json:"new_owner_email"`
+}
+
+// UpdateOrganizationRequest renames an organization
+type UpdateOrganizationRequest struct {
+	Name string `json:"name"`
+}
+
+// UpdateOrganizationSettingsRequest is a partial update of organization settings; nil fields are left unchanged
+type UpdateOrganizationSettingsRequest struct {
+	DefaultKubernetesVersion   *string   `json:"default_kubernetes_version,omitempty"`
+	AllowedCloudProviders      *[]string `json:"allowed_cloud_providers,omitempty"`
+	AllowedRegions             *[]string `json:"allowed_regions,omitempty"`
+	DefaultTenantComputeQuota  *int      `json:"default_tenant_compute_quota,omitempty"`
+	DefaultTenantMemoryQuotaGB *int      `json:"default_tenant_memory_quota_gb,omitempty"`
+}
+
+// UpdateProjectQuotasRequest changes a project's quotas; nil fields are left unchanged
+type UpdateProjectQuotasRequest struct {
+	MaxTenants  *int `json:"max_tenants,omitempty"`
+	MaxCompute  *int `json:"max_compute,omitempty"`
+	MaxMemoryGB *int `json:"max_memory_gb,omitempty"`
+}
+
+// UpdateProjectRequest replaces a project's settings
+type UpdateProjectRequest struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	MaxTenants  int     `json:"max_tenants"`
+	MaxCompute  int     `json:"max_compute"`
+	MaxMemoryGB int     `json:"max_memory_gb"`
+}
+
+// UpdateTenantRequest changes a tenant; nil fields are left unchanged
+type UpdateTenantRequest struct {
+	KubernetesVersion *string `json:"kubernetes_version"`
+	ComputeQuota      *int    `json:"compute_quota"`
+	MemoryQuotaGB     *int    `json:"memory_quota_gb"`
+}
+
+// UpdateUserRequest is a partial update of the user's profile
+type UpdateUserRequest struct {
+	DisplayName *string `json:"display_name,omitempty"`
+}
+
+// User represents a user in the system
+type User struct {
+	ID            string           `json:"id"`
+	Email         string           `json:"email"`
+	DisplayName   string           `json:"display_name,omitempty"`
+	AvatarURL     string           `json:"avatar_url,omitempty"`
+	Provider      string           `json:"provider"`
+	Approved      bool             `json:"approved"`
+	EmailVerified bool             `json:"email_verified"`
+	IsAdmin       bool             `json:"is_admin"`
+	Preferences   *UserPreferences `json:"preferences"`
+	CreatedAt     time.Time        `json:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+}
+
+// UserOrganization is a user's membership in an organization
+type UserOrganization struct {
+	UserID         string    `json:"user_id"`
+	OrganizationID string    `json:"organization_id"`
+	Role           string    `json:"role"`
+	IsDefault      bool      `json:"is_default"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// UserPreferences holds a user's UI preferences
+type UserPreferences struct {
+	WelcomeDismissed bool   `json:"welcome_dismissed"`
+	Theme            string `json:"theme"`
+}
+
+// VerifyEmailRequest confirms an email address with the code sent to it
+type VerifyEmailRequest struct {
+	Email string `json:"email"`
+	Code  string `json:"code"`
+}
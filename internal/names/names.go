@@ -0,0 +1,65 @@
+// Package names checks tenant and project names against the platform's naming
+// rules, so a bad name is reported with the rule it breaks before any request
+// is sent, rather than as a generic 400 from the API.
+//
+// Names are used for Kubernetes namespaces on the host cluster, so they must be
+// RFC 1123 labels: lowercase letters, digits, and '-', starting and ending with
+// a letter or digit.
+package names
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const (
+	// MaxTenantLength leaves room for the namespace suffix within the 63
+	// characters of a namespace name
+	MaxTenantLength = 53
+	// MaxProjectLength is the length limit of an RFC 1123 label
+	MaxProjectLength = 63
+)
+
+// ReservedSuffixes end the names of namespaces the platform keeps for itself
+var ReservedSuffixes = []string{"-system", "-kubespaces"}
+
+// ValidateTenant returns an error describing the rule a tenant name breaks, or
+// nil if it is valid
+func ValidateTenant(name string) error {
+	return validate("tenant", name, MaxTenantLength)
+}
+
+// ValidateProject returns an error describing the rule a project name breaks,
+// or nil if it is valid
+func ValidateProject(name string) error {
+	return validate("project", name, MaxProjectLength)
+}
+
+func validate(kind, name string, maxLength int) error {
+	if name == "" {
+		return fmt.Errorf("%s name must not be empty", kind)
+	}
+	if len(name) > maxLength {
+		return fmt.Errorf("invalid %s name %q: it is %d characters long, the maximum is %d", kind, name, len(name), maxLength)
+	}
+	for i, r := range name {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' {
+			continue
+		}
+		hint := ""
+		if lower := strings.ToLower(name); unicode.IsUpper(r) && validate(kind, lower, maxLength) == nil {
+			hint = fmt.Sprintf(" (use %q)", lower)
+		}
+		return fmt.Errorf("invalid %s name %q: character %q at position %d is not allowed, only lowercase letters, digits, and '-' are%s", kind, name, r, i+1, hint)
+	}
+	if name[0] == '-' || name[len(name)-1] == '-' {
+		return fmt.Errorf("invalid %s name %q: it must start and end with a letter or digit", kind, name)
+	}
+	for _, suffix := range ReservedSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return fmt.Errorf("invalid %s name %q: the suffix %q is reserved", kind, name, suffix)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package names
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateTenant(t *testing.T) {
+	tests := []struct {
+		name string
+		want string // substring of the error, "" for valid names
+	}{
+		{name: "dev", want: ""},
+		{name: "web-2", want: ""},
+		{name: "1st", want: ""},
+		{name: strings.Repeat("a", MaxTenantLength), want: ""},
+		{name: "", want: "must not be empty"},
+		{name: strings.Repeat("a", MaxTenantLength+1), want: "the maximum is 53"},
+		{name: "Dev", want: `character 'D' at position 1 is not allowed, only lowercase letters, digits, and '-' are (use "dev")`},
+		{name: "my_tenant", want: "character '_' at position 3"},
+		{name: "-dev", want: "must start and end with a letter or digit"},
+		{name: "dev-", want: "must start and end with a letter or digit"},
+		{name: "kube-system", want: `the suffix "-system" is reserved`},
+	}
+	for _, tt := range tests {
+		err := ValidateTenant(tt.name)
+		switch {
+		case tt.want == "" && err != nil:
+			t.Errorf("ValidateTenant(%q) = %v, want nil", tt.name, err)
+		case tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)):
+			t.Errorf("ValidateTenant(%q) = %v, want an error containing %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestValidateTenantNoInvalidHint(t *testing.T) {
+	// Lowercasing does not fix the underscore, so it is not suggested
+	err := ValidateTenant("My_Dev")
+	if err == nil || strings.Contains(err.Error(), "use") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestValidateProjectLength(t *testing.T) {
+	if err := ValidateProject(strings.Repeat("a", MaxProjectLength)); err != nil {
+		t.Errorf("unexpected error for a %d character name: %v", MaxProjectLength, err)
+	}
+	err := ValidateProject(strings.Repeat("a", MaxProjectLength+1))
+	if err == nil || !strings.HasPrefix(err.Error(), "invalid project name") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
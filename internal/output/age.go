@@ -0,0 +1,79 @@
+package output
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatAge renders the time elapsed since t in the compact style used by kubectl
+// (e.g. 45s, 12m, 5h, 3d). A zero time is shown as "<unknown>".
+func FormatAge(t time.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+	return formatDuration(time.Since(t))
+}
+
+// Age is the creation time of a resource in a table row. It is shown like
+// FormatAge and sorted by the time elapsed, so that 9d comes before 10d.
+type Age time.Time
+
+// String renders the age like FormatAge
+func (a Age) String() string {
+	return FormatAge(time.Time(a))
+}
+
+func formatDuration(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	case d < 365*24*time.Hour:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	default:
+		return fmt.Sprintf("%dy", int(d.Hours()/24/365))
+	}
+}
+
+// ParseTime parses a point in time given on the command line: an RFC 3339 time
+// (2025-01-02T15:04:05Z), a date (2025-01-02, midnight local time), or an age in
+// the style of FormatAge or time.ParseDuration, such as 3d or 36h, meaning that
+// long before now.
+func ParseTime(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, now.Location()); err == nil {
+		return t, nil
+	}
+	if d, err := parseAge(value); err == nil {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q (use e.g. 2025-01-02, 2025-01-02T15:04:05Z, or an age like 7d or 12h)", value)
+}
+
+// parseAge parses a duration, additionally accepting days (d) and weeks (w)
+func parseAge(value string) (time.Duration, error) {
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if n, ok := strings.CutSuffix(value, suffix); ok {
+			count, err := strconv.Atoi(n)
+			if err != nil || count < 0 {
+				return 0, fmt.Errorf("invalid age %q", value)
+			}
+			return time.Duration(count) * unit, nil
+		}
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid age %q", value)
+	}
+	return d, nil
+}
@@ -0,0 +1,58 @@
+package output
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDuration(t *testing.T) {
+	cases := []struct {
+		in   time.Duration
+		want string
+	}{
+		{-time.Second, "0s"},
+		{42 * time.Second, "42s"},
+		{12 * time.Minute, "12m"},
+		{5 * time.Hour, "5h"},
+		{47 * time.Hour, "47h"},
+		{72 * time.Hour, "3d"},
+		{800 * 24 * time.Hour, "2y"},
+	}
+	for _, c := range cases {
+		if got := formatDuration(c.in); got != c.want {
+			t.Fatalf("formatDuration(%s) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestFormatAgeZeroTime(t *testing.T) {
+	if got := FormatAge(time.Time{}); got != "<unknown>" {
+		t.Fatalf("FormatAge(zero) = %q, want %q", got, "<unknown>")
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{in: "2025-01-02T15:04:05Z", want: time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{in: "2025-01-02", want: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{in: "7d", want: now.Add(-7 * 24 * time.Hour)},
+		{in: "2w", want: now.Add(-14 * 24 * time.Hour)},
+		{in: "90m", want: now.Add(-90 * time.Minute)},
+		{in: "yesterday", wantErr: true},
+		{in: "-3d", wantErr: true},
+	}
+	for _, c := range cases {
+		got, err := ParseTime(c.in, now)
+		if (err != nil) != c.wantErr {
+			t.Fatalf("ParseTime(%q) error = %v, wantErr %v", c.in, err, c.wantErr)
+		}
+		if !got.Equal(c.want) {
+			t.Fatalf("ParseTime(%q) = %s, want %s", c.in, got, c.want)
+		}
+	}
+}
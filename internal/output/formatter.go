@@ -1,6 +1,7 @@
 package output
 
 import (
+	"bytes"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"strings"
 
 	"spacectl/internal/models"
+	"spacectl/internal/units"
 
 	"github.com/olekukonko/tablewriter"
 	"gopkg.in/yaml.v3"
@@ -23,13 +25,24 @@ const (
 	FormatJSON  Format = "json"
 	FormatYAML  Format = "yaml"
 	FormatCSV   Format = "csv"
+	// FormatWide is the table format with extra columns, such as IDs
+	FormatWide Format = "wide"
+	// FormatName prints only the name of each resource, one per line, for
+	// piping into other commands
+	FormatName Format = "name"
+	// FormatTerraformExternal prints a single resource as a flat JSON object of
+	// strings, the result format of Terraform's external data source
+	FormatTerraformExternal Format = "terraform-external"
 )
 
 // Formatter handles output formatting
 type Formatter struct {
-	format    Format
-	noHeaders bool
-	writer    io.Writer
+	format      Format
+	noHeaders   bool
+	writer      io.Writer
+	listOptions ListOptions
+	// columns is the column order of the rows being formatted by FormatRows
+	columns []string
 }
 
 // NewFormatter creates a new formatter
@@ -43,6 +56,14 @@ func NewFormatter(format Format, noHeaders bool, writer io.Writer) *Formatter {
 
 // FormatData formats and outputs data
 func (f *Formatter) FormatData(data interface{}) error {
+	if f.listOptions.active() {
+		filtered, err := f.applyListOptions(data)
+		if err != nil {
+			return err
+		}
+		data = filtered
+	}
+
 	switch f.format {
 	case FormatJSON:
 		return f.formatJSON(data)
@@ -50,13 +71,36 @@ func (f *Formatter) FormatData(data interface{}) error {
 		return f.formatYAML(data)
 	case FormatCSV:
 		return f.formatCSV(data)
-	case FormatTable:
+	case FormatTable, FormatWide:
 		return f.formatTable(data)
+	case FormatName:
+		return f.formatName(data)
+	case FormatTerraformExternal:
+		return f.formatTerraformExternal(data)
 	default:
 		return fmt.Errorf("unsupported format: %s", f.format)
 	}
 }
 
+// FormatRows formats table rows, showing their columns in the given order in
+// tables and CSV. Columns missing from the first row are left out, so the list
+// can include optional columns such as those of the wide format. Other formats
+// output the rows like FormatData.
+func (f *Formatter) FormatRows(rows []map[string]interface{}, columns []string) error {
+	f.columns = columns
+	defer func() { f.columns = nil }()
+	return f.FormatData(rows)
+}
+
+// headers returns the columns of a table or CSV in display order: the columns
+// passed to FormatRows, or else an order guessed from the record
+func (f *Formatter) headers(record map[string]interface{}) []string {
+	if f.columns != nil {
+		return presentColumns(record, f.columns)
+	}
+	return getOrderedHeadersFromRecord(record)
+}
+
 func (f *Formatter) formatJSON(data interface{}) error {
 	encoder := json.NewEncoder(f.writer)
 	encoder.SetIndent("", "  ")
@@ -86,7 +130,7 @@ func (f *Formatter) formatCSV(data interface{}) error {
 	// Get headers from first record (deterministic order)
 	var headers []string
 	if !f.noHeaders {
-		headers = getOrderedHeadersFromRecord(records[0])
+		headers = f.headers(records[0])
 		if err := writer.Write(headers); err != nil {
 			return err
 		}
@@ -136,7 +180,7 @@ func (f *Formatter) formatTable(data interface{}) error {
 
 	// Get headers from first record (deterministic order)
 	var headers []string
-	for _, key := range getOrderedHeadersFromRecord(records[0]) {
+	for _, key := range f.headers(records[0]) {
 		headers = append(headers, strings.Title(key))
 	}
 	table.SetHeader(headers)
@@ -154,6 +198,83 @@ func (f *Formatter) formatTable(data interface{}) error {
 	return nil
 }
 
+// formatTerraformExternal writes data as one JSON object whose values are all
+// strings. Nested objects are flattened into keys joined with dots; lists are
+// kept as JSON-encoded strings and nulls become empty strings.
+func (f *Formatter) formatTerraformExternal(data interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var object map[string]interface{}
+	if err := decoder.Decode(&object); err != nil {
+		return fmt.Errorf("output format %q needs a single resource, not a list", FormatTerraformExternal)
+	}
+
+	result := make(map[string]string)
+	if err := flattenStrings(result, "", object); err != nil {
+		return err
+	}
+	return json.NewEncoder(f.writer).Encode(result)
+}
+
+// flattenStrings adds the values of a decoded JSON object to result as strings
+func flattenStrings(result map[string]string, prefix string, object map[string]interface{}) error {
+	for key, value := range object {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		switch value := value.(type) {
+		case map[string]interface{}:
+			if err := flattenStrings(result, key, value); err != nil {
+				return err
+			}
+		case []interface{}:
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return err
+			}
+			result[key] = string(encoded)
+		case nil:
+			result[key] = ""
+		default:
+			result[key] = fmt.Sprint(value)
+		}
+	}
+	return nil
+}
+
+// nameKeys are the record fields used as the name in name output, in order of preference
+var nameKeys = []string{"name", "organization", "project"}
+
+func (f *Formatter) formatName(data interface{}) error {
+	records, err := f.convertToRecords(data)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		name, ok := recordName(record)
+		if !ok {
+			return fmt.Errorf("output format %q is not supported for this data", FormatName)
+		}
+		fmt.Fprintln(f.writer, name)
+	}
+	return nil
+}
+
+// recordName returns the name of the resource a record describes
+func recordName(record map[string]interface{}) (string, bool) {
+	for _, key := range nameKeys {
+		if v, ok := record[key]; ok {
+			return fmt.Sprintf("%v", v), true
+		}
+	}
+	return "", false
+}
+
 // convertToRecords converts data to a slice of maps for table/CSV formatting
 func (f *Formatter) convertToRecords(data interface{}) ([]map[string]interface{}, error) {
 	v := reflect.ValueOf(data)
@@ -211,6 +332,7 @@ func (f *Formatter) convertToRecords(data interface{}) ([]map[string]interface{}
 					"id":              m.ID,
 					"name":            m.Name,
 					"organization_id": m.OrganizationID,
+					"status":          m.DisplayStatus(),
 				})
 			case *models.Project:
 				if m != nil {
@@ -218,6 +340,7 @@ func (f *Formatter) convertToRecords(data interface{}) ([]map[string]interface{}
 						"id":              m.ID,
 						"name":            m.Name,
 						"organization_id": m.OrganizationID,
+						"status":          m.DisplayStatus(),
 					})
 				}
 			case models.Location:
@@ -247,26 +370,10 @@ func (f *Formatter) convertToRecords(data interface{}) ([]map[string]interface{}
 					})
 				}
 			case models.Tenant:
-				records = append(records, map[string]interface{}{
-					"name":               m.Name,
-					"cloud_provider":     m.CloudProvider,
-					"region":             m.Region,
-					"kubernetes_version": m.KubernetesVersion,
-					"compute_quota":      m.ComputeQuota,
-					"memory_quota_gb":    m.MemoryQuotaGB,
-					"status":             m.Status,
-				})
+				records = append(records, f.TenantRecord(m))
 			case *models.Tenant:
 				if m != nil {
-					records = append(records, map[string]interface{}{
-						"name":               m.Name,
-						"cloud_provider":     m.CloudProvider,
-						"region":             m.Region,
-						"kubernetes_version": m.KubernetesVersion,
-						"compute_quota":      m.ComputeQuota,
-						"memory_quota_gb":    m.MemoryQuotaGB,
-						"status":             m.Status,
-					})
+					records = append(records, f.TenantRecord(*m))
 				}
 			case map[string]interface{}:
 				records = append(records, item.(map[string]interface{}))
@@ -328,6 +435,7 @@ func (f *Formatter) convertToRecords(data interface{}) ([]map[string]interface{}
 				"id":              m.ID,
 				"name":            m.Name,
 				"organization_id": m.OrganizationID,
+				"status":          m.DisplayStatus(),
 			}}, nil
 		case *models.Project:
 			if m != nil {
@@ -335,6 +443,7 @@ func (f *Formatter) convertToRecords(data interface{}) ([]map[string]interface{}
 					"id":              m.ID,
 					"name":            m.Name,
 					"organization_id": m.OrganizationID,
+					"status":          m.DisplayStatus(),
 				}}, nil
 			}
 			return nil, nil
@@ -367,26 +476,10 @@ func (f *Formatter) convertToRecords(data interface{}) ([]map[string]interface{}
 			}
 			return nil, nil
 		case models.Tenant:
-			return []map[string]interface{}{map[string]interface{}{
-				"name":               m.Name,
-				"cloud_provider":     m.CloudProvider,
-				"region":             m.Region,
-				"kubernetes_version": m.KubernetesVersion,
-				"compute_quota":      m.ComputeQuota,
-				"memory_quota_gb":    m.MemoryQuotaGB,
-				"status":             m.Status,
-			}}, nil
+			return []map[string]interface{}{f.TenantRecord(m)}, nil
 		case *models.Tenant:
 			if m != nil {
-				return []map[string]interface{}{map[string]interface{}{
-					"name":               m.Name,
-					"cloud_provider":     m.CloudProvider,
-					"region":             m.Region,
-					"kubernetes_version": m.KubernetesVersion,
-					"compute_quota":      m.ComputeQuota,
-					"memory_quota_gb":    m.MemoryQuotaGB,
-					"status":             m.Status,
-				}}, nil
+				return []map[string]interface{}{f.TenantRecord(*m)}, nil
 			}
 			return nil, nil
 		case map[string]interface{}:
@@ -403,6 +496,31 @@ func (f *Formatter) convertToRecords(data interface{}) ([]map[string]interface{}
 	}
 }
 
+// TenantColumns are the columns of tenant records in display order
+var TenantColumns = []string{"project", "name", "namespace", "status", "cloud_provider", "region", "kubernetes_version", "version_support", "compute_quota", "memory_quota", "age", "host_cluster", "id"}
+
+// TenantRecord returns the table row of a tenant. The wide format adds its host
+// cluster and ID; callers that know the tenant's project name add it as "project".
+// Tables show the columns in the order of TenantColumns.
+func (f *Formatter) TenantRecord(t models.Tenant) map[string]interface{} {
+	record := map[string]interface{}{
+		"name":               t.Name,
+		"namespace":          t.Namespace,
+		"status":             t.Status,
+		"cloud_provider":     t.CloudProvider,
+		"region":             t.Region,
+		"kubernetes_version": t.KubernetesVersion,
+		"compute_quota":      t.ComputeQuota,
+		"memory_quota":       units.GiB(t.MemoryQuotaGB),
+		"age":                Age(t.CreatedAt),
+	}
+	if f.format == FormatWide {
+		record["host_cluster"] = t.HostClusterID
+		record["id"] = t.ID
+	}
+	return record
+}
+
 // getOrderedHeadersFromRecord returns a deterministic header order for a record.
 // If the record looks like an organization membership row, we enforce a
 // human-friendly order. Otherwise, keys are sorted alphabetically.
@@ -436,6 +554,17 @@ func getOrderedHeadersFromRecord(record map[string]interface{}) []string {
 	return keys
 }
 
+// presentColumns returns the columns that are keys of the record, in order
+func presentColumns(record map[string]interface{}, columns []string) []string {
+	var headers []string
+	for _, key := range columns {
+		if _, ok := record[key]; ok {
+			headers = append(headers, key)
+		}
+	}
+	return headers
+}
+
 func hasKeys(m map[string]interface{}, keys ...string) bool {
 	for _, k := range keys {
 		if _, ok := m[k]; !ok {
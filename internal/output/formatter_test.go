@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+
+	"spacectl/internal/models"
 )
 
 func TestFormatDataJSON(t *testing.T) {
@@ -98,6 +100,110 @@ func TestGetOrderedHeadersFromRecord(t *testing.T) {
 	}
 }
 
+func TestFormatDataWideTenants(t *testing.T) {
+	buf := &bytes.Buffer{}
+	formatter := NewFormatter(FormatWide, false, buf)
+	record := formatter.TenantRecord(models.Tenant{ID: "t-1", Name: "dev", Namespace: "web-dev", HostClusterID: "hc-1", MemoryQuotaGB: 4})
+	record["project"] = "web"
+
+	if err := formatter.FormatRows([]map[string]interface{}{record}, TenantColumns); err != nil {
+		t.Fatalf("FormatRows(wide) returned error: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "HOST CLUSTER") || !strings.Contains(out, "hc-1") || !strings.Contains(out, "4Gi") {
+		t.Fatalf("unexpected wide output:\n%s", out)
+	}
+	if !strings.HasPrefix(out, "PROJECT") {
+		t.Errorf("expected the project column first, got:\n%s", out)
+	}
+
+	if _, ok := NewFormatter(FormatTable, false, buf).TenantRecord(models.Tenant{})["id"]; ok {
+		t.Errorf("expected the table format to leave out IDs")
+	}
+}
+
+func TestFormatRows(t *testing.T) {
+	rows := []map[string]interface{}{
+		{"version": "1.29", "support": "deprecated", "is_default": false},
+		{"version": "1.31", "support": "", "is_default": true},
+	}
+	columns := []string{"version", "is_default", "end_of_life", "support"}
+
+	// Columns are shown in the given order, leaving out those the rows lack
+	buf := &bytes.Buffer{}
+	if err := NewFormatter(FormatCSV, false, buf).FormatRows(rows, columns); err != nil {
+		t.Fatalf("FormatRows(CSV) returned error: %v", err)
+	}
+	if got, want := buf.String(), "version,is_default,support\n1.29,false,deprecated\n1.31,true,\n"; got != want {
+		t.Errorf("unexpected CSV output:\nwant: %q\ngot:  %q", want, got)
+	}
+
+	// Structured formats get the rows as they are
+	buf.Reset()
+	if err := NewFormatter(FormatJSON, false, buf).FormatRows(rows[:1], []string{"version"}); err != nil {
+		t.Fatalf("FormatRows(JSON) returned error: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"support": "deprecated"`) {
+		t.Errorf("expected every field in JSON output, got:\n%s", buf.String())
+	}
+
+	// The columns only apply to the call they are passed to
+	formatter := NewFormatter(FormatCSV, false, buf)
+	formatter.FormatRows(rows, []string{"support"})
+	buf.Reset()
+	if err := formatter.FormatData(rows[:1]); err != nil {
+		t.Fatalf("FormatData(CSV) returned error: %v", err)
+	}
+	if got, want := buf.String(), "version,is_default\n1.29,false\n"; got != want {
+		t.Errorf("unexpected CSV output after FormatRows:\nwant: %q\ngot:  %q", want, got)
+	}
+}
+
+func TestFormatDataName(t *testing.T) {
+	buf := &bytes.Buffer{}
+	formatter := NewFormatter(FormatName, false, buf)
+
+	data := []map[string]interface{}{
+		{"name": "dev", "status": "Ready"},
+		{"name": "prod", "status": "Failed"},
+	}
+	if err := formatter.FormatData(data); err != nil {
+		t.Fatalf("FormatData returned error: %v", err)
+	}
+	if got, want := buf.String(), "dev\nprod\n"; got != want {
+		t.Fatalf("unexpected name output: want %q, got %q", want, got)
+	}
+
+	if err := formatter.FormatData([]map[string]interface{}{{"resource": "cpu"}}); err == nil {
+		t.Fatalf("expected an error for records without a name")
+	}
+}
+
+func TestFormatDataTerraformExternal(t *testing.T) {
+	buf := &bytes.Buffer{}
+	formatter := NewFormatter(FormatTerraformExternal, false, buf)
+
+	data := map[string]interface{}{
+		"name":          "dev",
+		"compute_quota": 4,
+		"ready":         true,
+		"zone":          nil,
+		"labels":        map[string]interface{}{"team": "web"},
+		"ports":         []int{80, 443},
+	}
+	if err := formatter.FormatData(data); err != nil {
+		t.Fatalf("FormatData returned error: %v", err)
+	}
+	want := `{"compute_quota":"4","labels.team":"web","name":"dev","ports":"[80,443]","ready":"true","zone":""}` + "\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("unexpected terraform-external output: want %q, got %q", want, got)
+	}
+
+	if err := formatter.FormatData([]map[string]interface{}{{"name": "dev"}}); err == nil {
+		t.Fatalf("expected an error for a list")
+	}
+}
+
 func TestFormatDataUnsupportedFormat(t *testing.T) {
 	buf := &bytes.Buffer{}
 	formatter := NewFormatter(Format("unsupported"), false, buf)
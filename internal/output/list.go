@@ -0,0 +1,182 @@
+package output
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ListOptions controls how list output is filtered and sorted before rendering.
+// Filters and sort keys refer to the record fields shown in table/CSV output.
+type ListOptions struct {
+	// SortBy is a field name; prefix it with "-" for descending order.
+	SortBy string
+	// Filters are expressions of the form field=value or field!=value.
+	// Values may contain shell-style wildcards (e.g. name=dev-*).
+	Filters []string
+}
+
+func (o ListOptions) active() bool {
+	return o.SortBy != "" || len(o.Filters) > 0
+}
+
+// SetListOptions configures filtering and sorting for subsequent list output
+func (f *Formatter) SetListOptions(opts ListOptions) {
+	f.listOptions = opts
+}
+
+type listFilter struct {
+	field   string
+	pattern string
+	negate  bool
+}
+
+func parseListFilter(expr string) (listFilter, error) {
+	if idx := strings.Index(expr, "!="); idx > 0 {
+		return listFilter{field: normalizeField(expr[:idx]), pattern: expr[idx+2:], negate: true}, nil
+	}
+	if idx := strings.Index(expr, "="); idx > 0 {
+		return listFilter{field: normalizeField(expr[:idx]), pattern: expr[idx+1:]}, nil
+	}
+	return listFilter{}, fmt.Errorf("invalid filter %q: expected field=value or field!=value", expr)
+}
+
+func (lf listFilter) matches(record map[string]interface{}) (bool, error) {
+	value, ok := record[lf.field]
+	if !ok {
+		return false, fmt.Errorf("unknown filter field %q (available: %s)", lf.field, strings.Join(getOrderedHeadersFromRecord(record), ", "))
+	}
+	actual := strings.ToLower(fmt.Sprintf("%v", value))
+	matched, err := path.Match(strings.ToLower(lf.pattern), actual)
+	if err != nil {
+		return false, fmt.Errorf("invalid filter pattern %q: %w", lf.pattern, err)
+	}
+	return matched != lf.negate, nil
+}
+
+// applyListOptions filters and sorts a slice, returning a new slice of the same type.
+// Non-slice data is returned unchanged.
+func (f *Formatter) applyListOptions(data interface{}) (interface{}, error) {
+	v := reflect.ValueOf(data)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice {
+		return data, nil
+	}
+
+	var filters []listFilter
+	for _, expr := range f.listOptions.Filters {
+		lf, err := parseListFilter(expr)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, lf)
+	}
+
+	type row struct {
+		item   reflect.Value
+		record map[string]interface{}
+	}
+
+	// Build one record per element so filtering and sorting see the displayed fields
+	var rows []row
+	for i := 0; i < v.Len(); i++ {
+		single := reflect.Append(reflect.MakeSlice(v.Type(), 0, 1), v.Index(i))
+		records, err := f.convertToRecords(single.Interface())
+		if err != nil {
+			return nil, err
+		}
+		if len(records) == 0 {
+			continue
+		}
+
+		keep := true
+		for _, lf := range filters {
+			ok, err := lf.matches(records[0])
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			rows = append(rows, row{item: v.Index(i), record: records[0]})
+		}
+	}
+
+	if f.listOptions.SortBy != "" {
+		field := normalizeField(strings.TrimPrefix(f.listOptions.SortBy, "-"))
+		descending := strings.HasPrefix(f.listOptions.SortBy, "-")
+		for _, r := range rows {
+			if _, ok := r.record[field]; !ok {
+				return nil, fmt.Errorf("unknown sort field %q (available: %s)", field, strings.Join(getOrderedHeadersFromRecord(r.record), ", "))
+			}
+		}
+		sort.SliceStable(rows, func(i, j int) bool {
+			if descending {
+				return compareValues(rows[j].record[field], rows[i].record[field]) < 0
+			}
+			return compareValues(rows[i].record[field], rows[j].record[field]) < 0
+		})
+	}
+
+	result := reflect.MakeSlice(v.Type(), 0, len(rows))
+	for _, r := range rows {
+		result = reflect.Append(result, r.item)
+	}
+	return result.Interface(), nil
+}
+
+// compareValues compares two record values numerically when both are numbers,
+// including numbers displayed with units such as "4Gi", and by elapsed time
+// when both are ages, falling back to a case-insensitive string comparison.
+func compareValues(a, b interface{}) int {
+	// Ages grow as creation times go back
+	if aa, ok := a.(Age); ok {
+		if ba, ok := b.(Age); ok {
+			return time.Time(ba).Compare(time.Time(aa))
+		}
+	}
+	as, bs := fmt.Sprintf("%v", a), fmt.Sprintf("%v", b)
+	af, aOK := numericValue(a)
+	bf, bOK := numericValue(b)
+	if aOK && bOK {
+		switch {
+		case af < bf:
+			return -1
+		case af > bf:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(strings.ToLower(as), strings.ToLower(bs))
+}
+
+// numericValue returns the value of a number, whether it is stored as one or
+// as a numeric string
+func numericValue(value interface{}) (float64, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	f, err := strconv.ParseFloat(fmt.Sprintf("%v", value), 64)
+	return f, err == nil
+}
+
+// normalizeField maps user input such as "Tenant-Count" to the record key "tenant_count"
+func normalizeField(field string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(field)), "-", "_")
+}
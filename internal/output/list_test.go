@@ -0,0 +1,109 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"spacectl/internal/units"
+)
+
+func TestFormatDataAppliesFilterAndSort(t *testing.T) {
+	buf := &bytes.Buffer{}
+	formatter := NewFormatter(FormatCSV, false, buf)
+	formatter.SetListOptions(ListOptions{
+		SortBy:  "-tenant_count",
+		Filters: []string{"name=web-*"},
+	})
+
+	data := []map[string]interface{}{
+		{"name": "web-a", "tenant_count": 2},
+		{"name": "api", "tenant_count": 9},
+		{"name": "web-b", "tenant_count": 10},
+	}
+
+	if err := formatter.FormatData(data); err != nil {
+		t.Fatalf("FormatData returned error: %v", err)
+	}
+
+	want := "name,tenant_count\nweb-b,10\nweb-a,2\n"
+	if got := buf.String(); got != want {
+		t.Fatalf("unexpected CSV output:\nwant: %q\ngot:  %q", want, got)
+	}
+}
+
+func TestFormatDataSortsQuantitiesByValue(t *testing.T) {
+	buf := &bytes.Buffer{}
+	formatter := NewFormatter(FormatCSV, true, buf)
+	formatter.SetListOptions(ListOptions{SortBy: "memory"})
+
+	data := []map[string]interface{}{
+		{"memory": units.GiB(1024)},
+		{"memory": units.GiB(16)},
+		{"memory": units.GiB(4)},
+	}
+
+	if err := formatter.FormatData(data); err != nil {
+		t.Fatalf("FormatData returned error: %v", err)
+	}
+
+	if got := buf.String(); got != "4Gi\n16Gi\n1Ti\n" {
+		t.Fatalf("unexpected CSV output: %q", got)
+	}
+}
+
+func TestFormatDataSortsAgesByTime(t *testing.T) {
+	buf := &bytes.Buffer{}
+	formatter := NewFormatter(FormatCSV, true, buf)
+	formatter.SetListOptions(ListOptions{SortBy: "age"})
+
+	now := time.Now()
+	data := []map[string]interface{}{
+		{"name": "old", "age": Age(now.Add(-10 * 24 * time.Hour))},
+		{"name": "new", "age": Age(now.Add(-9 * 24 * time.Hour))},
+		{"name": "newest", "age": Age(now.Add(-3 * time.Hour))},
+	}
+
+	if err := formatter.FormatRows(data, []string{"name", "age"}); err != nil {
+		t.Fatalf("FormatRows returned error: %v", err)
+	}
+
+	if got := buf.String(); got != "newest,3h\nnew,9d\nold,10d\n" {
+		t.Fatalf("unexpected CSV output: %q", got)
+	}
+}
+
+func TestFormatDataNegatedFilter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	formatter := NewFormatter(FormatCSV, true, buf)
+	formatter.SetListOptions(ListOptions{Filters: []string{"Status!=ready"}})
+
+	data := []map[string]interface{}{
+		{"status": "Ready"},
+		{"status": "Failed"},
+	}
+
+	if err := formatter.FormatData(data); err != nil {
+		t.Fatalf("FormatData returned error: %v", err)
+	}
+
+	if got := buf.String(); got != "Failed\n" {
+		t.Fatalf("unexpected CSV output: %q", got)
+	}
+}
+
+func TestFormatDataUnknownSortField(t *testing.T) {
+	formatter := NewFormatter(FormatJSON, false, &bytes.Buffer{})
+	formatter.SetListOptions(ListOptions{SortBy: "missing"})
+
+	err := formatter.FormatData([]map[string]interface{}{{"name": "a"}})
+	if err == nil {
+		t.Fatalf("expected unknown sort field to return an error")
+	}
+}
+
+func TestParseListFilterRejectsInvalidExpression(t *testing.T) {
+	if _, err := parseListFilter("name"); err == nil {
+		t.Fatalf("expected filter without operator to be rejected")
+	}
+}
@@ -0,0 +1,35 @@
+package output
+
+import (
+	"io"
+	"strings"
+)
+
+// WithWriter returns a copy of the formatter that writes to w, such as a buffer
+// that a watch compares between refreshes
+func (f *Formatter) WithWriter(w io.Writer) *Formatter {
+	c := *f
+	c.writer = w
+	return &c
+}
+
+// HighlightChanges marks the lines of a rendering that were not in the previous
+// rendering in reverse video, so watching a list shows what changed between
+// refreshes. Nothing is marked on the first rendering, when previous is "".
+func HighlightChanges(previous, current string) string {
+	if previous == "" {
+		return current
+	}
+	seen := make(map[string]bool)
+	for _, line := range strings.Split(previous, "\n") {
+		seen[line] = true
+	}
+
+	lines := strings.Split(current, "\n")
+	for i, line := range lines {
+		if line != "" && !seen[line] {
+			lines[i] = "\033[7m" + line + "\033[0m"
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,35 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestHighlightChanges(t *testing.T) {
+	previous := "NAME\tSTATUS\ndev\tcreating\nprod\tready\n"
+	current := "NAME\tSTATUS\ndev\tready\nprod\tready\n"
+
+	want := "NAME\tSTATUS\n\033[7mdev\tready\033[0m\nprod\tready\n"
+	if got := HighlightChanges(previous, current); got != want {
+		t.Errorf("HighlightChanges() = %q, want %q", got, want)
+	}
+	if got := HighlightChanges("", current); got != current {
+		t.Errorf("expected nothing to be marked on the first rendering, got %q", got)
+	}
+}
+
+func TestWithWriter(t *testing.T) {
+	var stdout, buf bytes.Buffer
+	formatter := NewFormatter(FormatTable, false, &stdout)
+	formatter.SetListOptions(ListOptions{Filters: []string{"name=dev"}})
+
+	if err := formatter.WithWriter(&buf).FormatData([]map[string]interface{}{{"name": "dev"}, {"name": "prod"}}); err != nil {
+		t.Fatal(err)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("expected nothing written to the original writer, got %q", stdout.String())
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("dev")) || bytes.Contains(buf.Bytes(), []byte("prod")) {
+		t.Errorf("expected the filtered list in the buffer, got %q", buf.String())
+	}
+}
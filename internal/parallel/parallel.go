@@ -0,0 +1,41 @@
+// Package parallel runs independent pieces of work, such as the API requests of
+// a command that fans out over many projects or tenants, with a bounded number
+// of goroutines so the API is not flooded.
+package parallel
+
+import "sync"
+
+// DefaultConcurrency is the number of calls run at once when a command's
+// --concurrency flag is not given
+const DefaultConcurrency = 8
+
+// ForEach calls fn with every index from 0 to n-1, running at most limit calls
+// at once, and returns when all of them have returned. A limit below 1 runs
+// one call at a time.
+func ForEach(n, limit int, fn func(i int)) {
+	if limit < 1 {
+		limit = 1
+	}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, limit)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// Map calls fn with every item, running at most limit calls at once, and
+// returns the results in the order of the items
+func Map[T, R any](items []T, limit int, fn func(item T) R) []R {
+	results := make([]R, len(items))
+	ForEach(len(items), limit, func(i int) {
+		results[i] = fn(items[i])
+	})
+	return results
+}
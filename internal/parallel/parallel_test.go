@@ -0,0 +1,52 @@
+package parallel
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestForEachBoundsConcurrency(t *testing.T) {
+	var running, peak atomic.Int32
+	var calls atomic.Int32
+	ForEach(20, 3, func(i int) {
+		calls.Add(1)
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+	})
+	if calls.Load() != 20 {
+		t.Fatalf("expected 20 calls, got %d", calls.Load())
+	}
+	if peak.Load() > 3 {
+		t.Fatalf("expected at most 3 calls at once, got %d", peak.Load())
+	}
+}
+
+func TestForEachRunsWithLimitBelowOne(t *testing.T) {
+	calls := 0
+	ForEach(3, 0, func(i int) { calls++ })
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestMapKeepsOrder(t *testing.T) {
+	items := []int{5, 1, 4, 2, 3}
+	got := Map(items, 2, func(n int) int {
+		time.Sleep(time.Duration(n) * time.Millisecond)
+		return n * 10
+	})
+	want := []int{50, 10, 40, 20, 30}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
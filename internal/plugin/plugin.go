@@ -0,0 +1,104 @@
+// Package plugin discovers external spacectl plugins: executables on PATH named
+// spacectl-<name>, which are run as "spacectl <name>".
+package plugin
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// Prefix is the file name prefix of plugin executables
+const Prefix = "spacectl-"
+
+// Plugin is an executable found on PATH
+type Plugin struct {
+	// Name is the command as typed after spacectl, e.g. "foo bar" for
+	// spacectl-foo-bar and "foo-bar" for spacectl-foo_bar
+	Name string
+	Path string
+}
+
+// Find looks up the plugin for a command line. Like kubectl, the longest match
+// wins: "foo bar baz" runs spacectl-foo-bar with argument "baz" if it exists,
+// otherwise spacectl-foo with "bar baz". Dashes in arguments are matched as
+// underscores, so spacectl-foo_bar is "spacectl foo-bar". It returns the
+// executable and the arguments to pass to it.
+func Find(args []string) (path string, rest []string, ok bool) {
+	var names []string
+	for _, arg := range args {
+		if arg == "" || strings.HasPrefix(arg, "-") {
+			break
+		}
+		names = append(names, strings.ReplaceAll(arg, "-", "_"))
+	}
+
+	for n := len(names); n > 0; n-- {
+		path, err := exec.LookPath(Prefix + strings.Join(names[:n], "-"))
+		if err == nil {
+			return path, args[n:], true
+		}
+	}
+	return "", nil, false
+}
+
+// List returns the plugins found in the directories of the PATH environment
+// variable, sorted by name. When several directories hold a plugin of the same
+// name, only the first one is returned, since that is the one that runs.
+func List() []Plugin {
+	seen := make(map[string]bool)
+	var plugins []Plugin
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			name, ok := pluginName(entry.Name())
+			if !ok || seen[name] || entry.IsDir() {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			if !isExecutable(path) {
+				continue
+			}
+			seen[name] = true
+			plugins = append(plugins, Plugin{Name: name, Path: path})
+		}
+	}
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].Name < plugins[j].Name })
+	return plugins
+}
+
+// pluginName returns the command name of a plugin file name
+func pluginName(file string) (string, bool) {
+	if !strings.HasPrefix(file, Prefix) {
+		return "", false
+	}
+	name := strings.TrimPrefix(file, Prefix)
+	if runtime.GOOS == "windows" {
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	if name == "" {
+		return "", false
+	}
+	return strings.ReplaceAll(strings.ReplaceAll(name, "-", " "), "_", "-"), true
+}
+
+// isExecutable reports whether path is a file the user can run
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	return info.Mode()&0o111 != 0
+}
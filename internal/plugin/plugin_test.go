@@ -0,0 +1,78 @@
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+// writePlugin creates an executable script in dir
+func writePlugin(t *testing.T, dir, name string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestFindPrefersLongestMatch(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts in this test")
+	}
+	dir := t.TempDir()
+	foo := writePlugin(t, dir, "spacectl-foo")
+	fooBar := writePlugin(t, dir, "spacectl-foo-bar")
+	dashed := writePlugin(t, dir, "spacectl-my_tool")
+	t.Setenv("PATH", dir)
+
+	tests := []struct {
+		args     []string
+		wantPath string
+		wantRest []string
+	}{
+		{args: []string{"foo"}, wantPath: foo, wantRest: []string{}},
+		{args: []string{"foo", "baz", "--x"}, wantPath: foo, wantRest: []string{"baz", "--x"}},
+		{args: []string{"foo", "bar", "baz"}, wantPath: fooBar, wantRest: []string{"baz"}},
+		{args: []string{"foo", "--flag", "bar"}, wantPath: foo, wantRest: []string{"--flag", "bar"}},
+		{args: []string{"my-tool"}, wantPath: dashed, wantRest: []string{}},
+	}
+	for _, tt := range tests {
+		path, rest, ok := Find(tt.args)
+		if !ok {
+			t.Errorf("Find(%q) found nothing", tt.args)
+			continue
+		}
+		if path != tt.wantPath || !reflect.DeepEqual(rest, tt.wantRest) {
+			t.Errorf("Find(%q) = %s %q, want %s %q", tt.args, path, rest, tt.wantPath, tt.wantRest)
+		}
+	}
+
+	for _, args := range [][]string{{"missing"}, {"--foo"}, {}} {
+		if path, _, ok := Find(args); ok {
+			t.Errorf("Find(%q) = %s, want no plugin", args, path)
+		}
+	}
+}
+
+func TestListSkipsShadowedAndNonExecutable(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("executable bits are not used on windows")
+	}
+	first, second := t.TempDir(), t.TempDir()
+	foo := writePlugin(t, first, "spacectl-foo")
+	writePlugin(t, second, "spacectl-foo")
+	bar := writePlugin(t, second, "spacectl-foo-bar")
+	writePlugin(t, second, "kubectl-foo")
+	if err := os.WriteFile(filepath.Join(second, "spacectl-notes"), []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", first+string(os.PathListSeparator)+second)
+
+	want := []Plugin{{Name: "foo", Path: foo}, {Name: "foo bar", Path: bar}}
+	if got := List(); !reflect.DeepEqual(got, want) {
+		t.Errorf("List() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,8 @@
+// Package pty runs commands attached to a pseudo-terminal, so interactive
+// programs such as 'kubectl exec -it' work the same wherever spacectl runs.
+package pty
+
+import "errors"
+
+// ErrUnsupported is returned on platforms without pseudo-terminals
+var ErrUnsupported = errors.New("pseudo-terminals are not supported on this platform")
@@ -0,0 +1,28 @@
+//go:build linux || darwin
+
+package pty
+
+import (
+	"io"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestStart(t *testing.T) {
+	cmd := exec.Command("sh", "-c", "test -t 0 && test -t 1 && echo on-a-terminal")
+	master, err := Start(cmd)
+	if err != nil {
+		t.Skipf("cannot allocate a pseudo-terminal: %v", err)
+	}
+	defer master.Close()
+
+	// Reading ends with an error once the command exits
+	out, _ := io.ReadAll(master)
+	if err := cmd.Wait(); err != nil {
+		t.Fatalf("command failed: %v (output %q)", err, out)
+	}
+	if !strings.Contains(string(out), "on-a-terminal") {
+		t.Errorf("expected the command to run on a terminal, got %q", out)
+	}
+}
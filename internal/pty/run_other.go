@@ -0,0 +1,18 @@
+//go:build !linux && !darwin
+
+package pty
+
+import (
+	"os"
+	"os/exec"
+)
+
+// Start returns ErrUnsupported on this platform
+func Start(cmd *exec.Cmd) (*os.File, error) {
+	return nil, ErrUnsupported
+}
+
+// Run returns ErrUnsupported on this platform
+func Run(cmd *exec.Cmd) error {
+	return ErrUnsupported
+}
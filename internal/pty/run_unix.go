@@ -0,0 +1,93 @@
+//go:build linux || darwin
+
+package pty
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+	"golang.org/x/term"
+)
+
+// Start starts cmd with a new pseudo-terminal as its controlling terminal,
+// stdin, stdout, and stderr, and returns the master end to talk to it through
+func Start(cmd *exec.Cmd) (*os.File, error) {
+	master, slave, err := open()
+	if err != nil {
+		return nil, err
+	}
+	if err := start(cmd, slave); err != nil {
+		master.Close()
+		return nil, err
+	}
+	return master, nil
+}
+
+// start starts cmd on the slave end of a pseudo-terminal, which the caller no
+// longer needs afterwards
+func start(cmd *exec.Cmd, slave *os.File) error {
+	defer slave.Close()
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = slave, slave, slave
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setsid = true
+	cmd.SysProcAttr.Setctty = true
+	return cmd.Start()
+}
+
+// Run runs cmd on a pseudo-terminal relayed to the user's terminal, which must be
+// stdin: the terminal is put in raw mode, input and output are copied, and size
+// changes are forwarded, so full-screen and line-editing programs behave as if
+// they were run directly. It returns the error of cmd.Wait.
+func Run(cmd *exec.Cmd) error {
+	master, slave, err := open()
+	if err != nil {
+		return err
+	}
+	defer master.Close()
+
+	stdinFd := int(os.Stdin.Fd())
+	masterFd := int(master.Fd())
+	resize := func() {
+		if size, err := unix.IoctlGetWinsize(stdinFd, unix.TIOCGWINSZ); err == nil {
+			unix.IoctlSetWinsize(masterFd, unix.TIOCSWINSZ, size)
+		}
+	}
+
+	// Give the command the terminal's size from the start, and follow changes
+	resize()
+	winch := make(chan os.Signal, 1)
+	signal.Notify(winch, syscall.SIGWINCH)
+	defer signal.Stop(winch)
+	go func() {
+		for range winch {
+			resize()
+		}
+	}()
+
+	if err := start(cmd, slave); err != nil {
+		return err
+	}
+
+	// Keys, including Ctrl+C, go to the command's terminal rather than to spacectl
+	if state, err := term.MakeRaw(stdinFd); err == nil {
+		defer term.Restore(stdinFd, state)
+	}
+
+	go io.Copy(master, os.Stdin)
+	done := make(chan struct{})
+	go func() {
+		// Reading fails once the command and its children have closed the terminal
+		io.Copy(os.Stdout, master)
+		close(done)
+	}()
+
+	err = cmd.Wait()
+	<-done
+	return err
+}
@@ -0,0 +1,129 @@
+// Package suggest finds the names closest to one that was mistyped, for
+// "did you mean" hints.
+package suggest
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxSuggestions is the most names Closest returns
+const maxSuggestions = 3
+
+// Closest returns up to three candidates that look like a mistyped name, best
+// match first. A candidate matches when it contains the name or is within a few
+// edits of it (roughly one per three characters, and at least two). Matching is
+// case-insensitive.
+func Closest(name string, candidates []string) []string {
+	name = strings.ToLower(name)
+	if name == "" {
+		return nil
+	}
+	maxDistance := maxEdits(name)
+
+	type match struct {
+		name     string
+		distance int
+	}
+	var matches []match
+	seen := make(map[string]bool)
+	for _, candidate := range candidates {
+		if seen[candidate] {
+			continue
+		}
+		seen[candidate] = true
+
+		lower := strings.ToLower(candidate)
+		distance := Levenshtein(name, lower)
+		if distance <= maxDistance || strings.Contains(lower, name) {
+			matches = append(matches, match{name: candidate, distance: distance})
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].distance != matches[j].distance {
+			return matches[i].distance < matches[j].distance
+		}
+		return matches[i].name < matches[j].name
+	})
+	if len(matches) > maxSuggestions {
+		matches = matches[:maxSuggestions]
+	}
+
+	names := make([]string, len(matches))
+	for i, m := range matches {
+		names[i] = m.name
+	}
+	return names
+}
+
+// Score rates how well a candidate matches a search term, case-insensitively;
+// lower is better. An exact match scores 0, a prefix 1, a substring 2, and the
+// term's characters appearing in order (as "pdeu" in "prod-eu") 3. Otherwise a
+// candidate within a few edits of the whole term, as in Closest, scores 4 plus
+// the number of edits. ok is false when the candidate does not match at all.
+func Score(term, candidate string) (score int, ok bool) {
+	term, candidate = strings.ToLower(term), strings.ToLower(candidate)
+	switch {
+	case term == "" || candidate == "":
+		return 0, false
+	case candidate == term:
+		return 0, true
+	case strings.HasPrefix(candidate, term):
+		return 1, true
+	case strings.Contains(candidate, term):
+		return 2, true
+	case isSubsequence(term, candidate):
+		return 3, true
+	}
+	if distance := Levenshtein(term, candidate); distance <= maxEdits(term) {
+		return 4 + distance, true
+	}
+	return 0, false
+}
+
+// isSubsequence reports whether the runes of term appear in s in order
+func isSubsequence(term, s string) bool {
+	rest := []rune(term)
+	for _, r := range s {
+		if len(rest) == 0 {
+			break
+		}
+		if r == rest[0] {
+			rest = rest[1:]
+		}
+	}
+	return len(rest) == 0
+}
+
+// maxEdits is the most edits for a candidate to still count as a typo of name:
+// roughly one per three characters, and at least two
+func maxEdits(name string) int {
+	if n := len([]rune(name)) / 3; n > 2 {
+		return n
+	}
+	return 2
+}
+
+// Levenshtein returns the number of single-character insertions, deletions, and
+// substitutions needed to turn a into b
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,71 @@
+package suggest
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"prod", "", 4},
+		{"prod", "prod", 0},
+		{"prod", "prdo", 2},
+		{"staging", "stagign", 2},
+		{"kitten", "sitting", 3},
+	}
+	for _, tt := range tests {
+		if got := Levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestClosest(t *testing.T) {
+	candidates := []string{"prodd-eu", "staging", "dev", "Prod-US", "sandbox", "dev"}
+
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{name: "prod", want: []string{"Prod-US", "prodd-eu"}},
+		{name: "stagin", want: []string{"staging"}},
+		{name: "deb", want: []string{"dev"}},
+		{name: "analytics", want: []string{}},
+		{name: "", want: nil},
+	}
+	for _, tt := range tests {
+		got := Closest(tt.name, candidates)
+		if len(got) == 0 && len(tt.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Closest(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		term, candidate string
+		want            int
+		wantOK          bool
+	}{
+		{"prod-eu", "Prod-EU", 0, true},
+		{"prod", "prod-eu", 1, true},
+		{"eu", "prod-eu", 2, true},
+		{"pdeu", "prod-eu", 3, true},
+		{"prdo", "prod", 6, true},
+		{"analytics", "prod-eu", 0, false},
+		{"", "prod", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := Score(tt.term, tt.candidate)
+		if ok != tt.wantOK || got != tt.want {
+			t.Errorf("Score(%q, %q) = %d, %v, want %d, %v", tt.term, tt.candidate, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ErrCanceled is returned when the user quits a picker without confirming
+var ErrCanceled = errors.New("canceled")
+
+// Response is the answer picked for an item, such as an invitation
+type Response int
+
+const (
+	NoResponse Response = iota
+	Accept
+	Decline
+)
+
+func (r Response) String() string {
+	switch r {
+	case Accept:
+		return "accept"
+	case Decline:
+		return "decline"
+	default:
+		return ""
+	}
+}
+
+// respondModel is a list whose items can each be marked accept or decline
+type respondModel struct {
+	title     string
+	items     []string
+	responses []Response
+	// preset is what space and enter mark, e.g. Accept for 'invitations accept'
+	preset   Response
+	cursor   int
+	done     bool
+	canceled bool
+}
+
+func newRespondModel(title string, items []string, preset Response) respondModel {
+	return respondModel{
+		title:     title,
+		items:     items,
+		responses: make([]Response, len(items)),
+		preset:    preset,
+	}
+}
+
+// Respond lists items and lets the user mark each accept or decline with the
+// arrow keys until they confirm with enter. Enter with nothing marked picks
+// the preset response for the selected item. Items left unmarked get
+// NoResponse; quitting returns ErrCanceled.
+func Respond(ctx context.Context, title string, items []string, preset Response) ([]Response, error) {
+	program := tea.NewProgram(newRespondModel(title, items, preset), tea.WithContext(ctx))
+	final, err := program.Run()
+	if err != nil {
+		return nil, err
+	}
+	m := final.(respondModel)
+	if m.canceled {
+		return nil, ErrCanceled
+	}
+	return m.responses, nil
+}
+
+// Init does nothing; the list is static
+func (m respondModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update moves the selection and marks responses
+func (m respondModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || len(m.items) == 0 {
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "q", "esc", "ctrl+c":
+		m.canceled = true
+		return m, tea.Quit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.items)-1 {
+			m.cursor++
+		}
+	case "a":
+		m.responses[m.cursor] = Accept
+	case "d", "x":
+		m.responses[m.cursor] = Decline
+	case "u":
+		m.responses[m.cursor] = NoResponse
+	case " ":
+		if m.responses[m.cursor] == m.preset {
+			m.responses[m.cursor] = NoResponse
+		} else {
+			m.responses[m.cursor] = m.preset
+		}
+	case "enter":
+		if !m.marked() {
+			m.responses[m.cursor] = m.preset
+		}
+		m.done = true
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// marked reports whether any item has a response
+func (m respondModel) marked() bool {
+	for _, r := range m.responses {
+		if r != NoResponse {
+			return true
+		}
+	}
+	return false
+}
+
+// View renders the list with each item's response, or nothing once finished so
+// the command's own output follows the prompt
+func (m respondModel) View() string {
+	if m.done || m.canceled {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(m.title) + "\n")
+	for i, item := range m.items {
+		line := "[" + padResponse(m.responses[i]) + "]  " + item
+		if i == m.cursor {
+			line = selectedStyle.Render("> " + line)
+		} else {
+			line = "  " + line
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString(helpStyle.Render("↑/↓ move · a accept · d decline · space "+m.preset.String()+" · u clear · enter confirm · q cancel") + "\n")
+	return b.String()
+}
+
+// padResponse renders a response at the width of the longest one
+func padResponse(r Response) string {
+	s := r.String()
+	return s + strings.Repeat(" ", len("decline")-len(s))
+}
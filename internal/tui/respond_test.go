@@ -0,0 +1,52 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func pressKeys(m respondModel, keys ...tea.KeyMsg) respondModel {
+	for _, k := range keys {
+		next, _ := m.Update(k)
+		m = next.(respondModel)
+	}
+	return m
+}
+
+func TestRespondEnterPicksSelected(t *testing.T) {
+	m := newRespondModel("Pending invitations", []string{"org acme", "project web"}, Accept)
+	m = pressKeys(m, key("j"), key("enter"))
+
+	if !m.done || m.responses[0] != NoResponse || m.responses[1] != Accept {
+		t.Errorf("expected only the selected item to be accepted, got done %v responses %v", m.done, m.responses)
+	}
+}
+
+func TestRespondMarksEachItem(t *testing.T) {
+	m := newRespondModel("Pending invitations", []string{"a", "b", "c"}, Decline)
+	m = pressKeys(m, key("a"), key("j"), key(" "), key("j"), key("d"), key("u"))
+
+	if view := m.View(); !strings.Contains(view, "[accept ]  a") || !strings.Contains(view, "[decline]  b") {
+		t.Errorf("expected the marks in the view, got:\n%s", view)
+	}
+
+	m = pressKeys(m, key("enter"))
+	want := []Response{Accept, Decline, NoResponse}
+	for i := range want {
+		if m.responses[i] != want[i] {
+			t.Errorf("responses = %v, want %v", m.responses, want)
+			break
+		}
+	}
+}
+
+func TestRespondCancel(t *testing.T) {
+	m := newRespondModel("Pending invitations", []string{"a"}, Accept)
+	m = pressKeys(m, key("a"), key("q"))
+
+	if !m.canceled || m.View() != "" {
+		t.Errorf("expected the picker to be canceled and cleared, got canceled %v view %q", m.canceled, m.View())
+	}
+}
@@ -0,0 +1,470 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"spacectl/internal/api"
+	"spacectl/internal/output"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// level is a step of the organizations → projects → tenants hierarchy
+type level int
+
+const (
+	levelOrganizations level = iota
+	levelProjects
+	levelTenants
+)
+
+// Options configures the terminal UI
+type Options struct {
+	// RefreshInterval is how often the current list is reloaded; 0 disables refresh
+	RefreshInterval time.Duration
+	// KubeconfigDir is where tenant kubeconfigs are saved by the download key
+	KubeconfigDir string
+	// Kubeconfig returns the path of a tenant's kubeconfig for a tenant shell
+	Kubeconfig func(ctx context.Context, tenantID string) (string, error)
+	// Shell is the program started for a tenant shell
+	Shell string
+}
+
+// row is one selectable line of the current list
+type row struct {
+	id    string
+	name  string
+	cells []string
+}
+
+var (
+	titleStyle    = lipgloss.NewStyle().Bold(true)
+	headerStyle   = lipgloss.NewStyle().Bold(true).Faint(true)
+	selectedStyle = lipgloss.NewStyle().Reverse(true)
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	helpStyle     = lipgloss.NewStyle().Faint(true)
+)
+
+// Model is the state of the UI
+type Model struct {
+	ctx       context.Context
+	opts      Options
+	orgAPI    *api.OrganizationAPI
+	projAPI   *api.ProjectAPI
+	tenantAPI *api.TenantAPI
+
+	level   level
+	org     row
+	project row
+	rows    []row
+	cursor  int
+	// selectID is selected once the pending load finishes, e.g. the project
+	// that was open before going back
+	selectID string
+
+	// seq identifies the current load so results for a list that is no longer
+	// shown are dropped
+	seq     int
+	loading bool
+	err     error
+	status  string
+
+	confirmDelete bool
+	height        int
+}
+
+// Messages produced by commands
+type (
+	loadedMsg struct {
+		seq  int
+		rows []row
+		err  error
+	}
+	tickMsg   struct{}
+	actionMsg struct {
+		status string
+		err    error
+		reload bool
+	}
+	shellMsg struct {
+		tenant     row
+		kubeconfig string
+	}
+)
+
+// New returns the UI model, starting at the user's organizations
+func New(ctx context.Context, client *api.Client, opts Options) Model {
+	return Model{
+		ctx:       ctx,
+		opts:      opts,
+		orgAPI:    api.NewOrganizationAPI(client),
+		projAPI:   api.NewProjectAPI(client),
+		tenantAPI: api.NewTenantAPI(client),
+		loading:   true,
+	}
+}
+
+// Run shows the UI until the user quits or the context is canceled
+func Run(ctx context.Context, client *api.Client, opts Options) error {
+	program := tea.NewProgram(New(ctx, client, opts), tea.WithAltScreen(), tea.WithContext(ctx))
+	_, err := program.Run()
+	return err
+}
+
+// Init starts loading the organizations and the refresh timer
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(m.load(), m.tick())
+}
+
+// Update handles keys, loaded lists, and action results
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.height = msg.Height
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+
+	case loadedMsg:
+		if msg.seq != m.seq {
+			return m, nil
+		}
+		m.loading = false
+		m.err = msg.err
+		if msg.err != nil {
+			return m, nil
+		}
+		selected := m.selectID
+		if selected == "" && m.cursor < len(m.rows) {
+			selected = m.rows[m.cursor].id
+		}
+		m.rows = msg.rows
+		m.selectID = ""
+		m.cursor = 0
+		for i, r := range m.rows {
+			if r.id == selected {
+				m.cursor = i
+			}
+		}
+
+	case tickMsg:
+		cmds := []tea.Cmd{m.tick()}
+		if !m.loading && !m.confirmDelete {
+			m.loading = true
+			cmds = append(cmds, m.load())
+		}
+		return m, tea.Batch(cmds...)
+
+	case actionMsg:
+		m.status, m.err = msg.status, msg.err
+		if msg.reload {
+			m.loading = true
+			return m, m.load()
+		}
+
+	case shellMsg:
+		shell := exec.Command(m.opts.Shell)
+		shell.Env = append(os.Environ(),
+			"KUBECONFIG="+msg.kubeconfig,
+			"SPACECTL_TENANT="+msg.tenant.name)
+		return m, tea.ExecProcess(shell, func(err error) tea.Msg {
+			if err != nil {
+				return actionMsg{err: fmt.Errorf("shell for %s exited: %w", msg.tenant.name, err)}
+			}
+			return actionMsg{status: fmt.Sprintf("Closed shell for %s", msg.tenant.name)}
+		})
+	}
+	return m, nil
+}
+
+func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	if m.confirmDelete {
+		m.confirmDelete = false
+		if key == "y" || key == "Y" {
+			return m, m.deleteTenant(m.rows[m.cursor])
+		}
+		m.status = "Delete canceled"
+		return m, nil
+	}
+
+	switch key {
+	case "q", "ctrl+c":
+		return m, tea.Quit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.rows)-1 {
+			m.cursor++
+		}
+	case "enter", "right", "l":
+		if m.level == levelTenants || len(m.rows) == 0 {
+			return m, nil
+		}
+		if m.level == levelOrganizations {
+			m.org = m.rows[m.cursor]
+		} else {
+			m.project = m.rows[m.cursor]
+		}
+		return m.open(m.level+1, "")
+	case "esc", "left", "h", "backspace":
+		switch m.level {
+		case levelProjects:
+			return m.open(levelOrganizations, m.org.id)
+		case levelTenants:
+			return m.open(levelProjects, m.project.id)
+		}
+	case "r":
+		m.loading = true
+		m.status = ""
+		return m, m.load()
+	case "d":
+		if m.level == levelTenants && len(m.rows) > 0 {
+			m.confirmDelete = true
+		}
+	case "c":
+		if m.level == levelTenants && len(m.rows) > 0 {
+			return m, m.saveKubeconfig(m.rows[m.cursor])
+		}
+	case "s":
+		if m.level == levelTenants && len(m.rows) > 0 && m.opts.Kubeconfig != nil {
+			return m, m.openShell(m.rows[m.cursor])
+		}
+	}
+	return m, nil
+}
+
+// open switches to another level, selecting the row with selectID once loaded
+func (m Model) open(l level, selectID string) (tea.Model, tea.Cmd) {
+	m.level = l
+	m.rows = nil
+	m.cursor = 0
+	m.selectID = selectID
+	m.status = ""
+	m.err = nil
+	m.loading = true
+	m.seq++
+	return m, m.load()
+}
+
+// load fetches the rows of the current level
+func (m Model) load() tea.Cmd {
+	ctx, seq, l, orgID, projectID := m.ctx, m.seq, m.level, m.org.id, m.project.id
+	return func() tea.Msg {
+		var rows []row
+		var err error
+		switch l {
+		case levelOrganizations:
+			rows, err = m.organizationRows(ctx)
+		case levelProjects:
+			rows, err = m.projectRows(ctx, orgID)
+		case levelTenants:
+			rows, err = m.tenantRows(ctx, projectID)
+		}
+		return loadedMsg{seq: seq, rows: rows, err: err}
+	}
+}
+
+func (m Model) organizationRows(ctx context.Context) ([]row, error) {
+	memberships, err := m.orgAPI.ListUserOrganizations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	var rows []row
+	for _, om := range memberships {
+		def := ""
+		if om.IsDefault {
+			def = "*"
+		}
+		rows = append(rows, row{
+			id:    om.Organization.ID,
+			name:  om.Organization.Name,
+			cells: []string{om.Organization.Name, om.Role, def},
+		})
+	}
+	return rows, nil
+}
+
+func (m Model) projectRows(ctx context.Context, orgID string) ([]row, error) {
+	projects, err := m.projAPI.ListOrganizationProjects(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	var rows []row
+	for _, p := range projects {
+		rows = append(rows, row{
+			id:    p.ID,
+			name:  p.Name,
+			cells: []string{p.Name, p.DisplayStatus(), output.FormatAge(p.CreatedAt)},
+		})
+	}
+	return rows, nil
+}
+
+func (m Model) tenantRows(ctx context.Context, projectID string) ([]row, error) {
+	tenants, err := m.tenantAPI.ListProjectTenants(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenants: %w", err)
+	}
+	var rows []row
+	for _, t := range tenants {
+		rows = append(rows, row{
+			id:   t.ID,
+			name: t.Name,
+			cells: []string{t.Name, t.Status, t.CloudProvider, t.Region, t.KubernetesVersion,
+				output.FormatAge(t.CreatedAt)},
+		})
+	}
+	return rows, nil
+}
+
+func (m Model) tick() tea.Cmd {
+	if m.opts.RefreshInterval <= 0 {
+		return nil
+	}
+	return tea.Tick(m.opts.RefreshInterval, func(time.Time) tea.Msg { return tickMsg{} })
+}
+
+func (m Model) deleteTenant(tenant row) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.tenantAPI.DeleteTenant(m.ctx, tenant.id); err != nil {
+			return actionMsg{err: fmt.Errorf("failed to delete tenant %s: %w", tenant.name, err)}
+		}
+		return actionMsg{status: fmt.Sprintf("Tenant %s deleted", tenant.name), reload: true}
+	}
+}
+
+func (m Model) saveKubeconfig(tenant row) tea.Cmd {
+	return func() tea.Msg {
+		kubeconfig, err := m.tenantAPI.GetTenantKubeconfig(m.ctx, tenant.id)
+		if err != nil {
+			return actionMsg{err: fmt.Errorf("failed to get kubeconfig: %w", err)}
+		}
+		if err := os.MkdirAll(m.opts.KubeconfigDir, 0700); err != nil {
+			return actionMsg{err: fmt.Errorf("failed to create kubeconfig directory: %w", err)}
+		}
+		path := filepath.Join(m.opts.KubeconfigDir, tenant.name+".yaml")
+		if err := os.WriteFile(path, []byte(kubeconfig), 0600); err != nil {
+			return actionMsg{err: fmt.Errorf("failed to write kubeconfig file: %w", err)}
+		}
+		return actionMsg{status: fmt.Sprintf("Kubeconfig saved to %s", path)}
+	}
+}
+
+func (m Model) openShell(tenant row) tea.Cmd {
+	return func() tea.Msg {
+		path, err := m.opts.Kubeconfig(m.ctx, tenant.id)
+		if err != nil {
+			return actionMsg{err: fmt.Errorf("failed to get kubeconfig: %w", err)}
+		}
+		return shellMsg{tenant: tenant, kubeconfig: path}
+	}
+}
+
+// View renders the breadcrumb, the current list, and the key help
+func (m Model) View() string {
+	var b strings.Builder
+
+	crumbs := []string{"spacectl"}
+	headers := []string{"NAME", "ROLE", "DEFAULT"}
+	kind := "organizations"
+	switch m.level {
+	case levelProjects:
+		crumbs = append(crumbs, m.org.name)
+		headers = []string{"NAME", "STATUS", "AGE"}
+		kind = "projects"
+	case levelTenants:
+		crumbs = append(crumbs, m.org.name, m.project.name)
+		headers = []string{"NAME", "STATUS", "CLOUD", "REGION", "VERSION", "AGE"}
+		kind = "tenants"
+	}
+	crumbs = append(crumbs, strings.ToUpper(kind[:1])+kind[1:])
+	b.WriteString(titleStyle.Render(strings.Join(crumbs, " › ")))
+	b.WriteString("\n\n")
+
+	switch {
+	case len(m.rows) == 0 && m.loading:
+		b.WriteString("Loading...\n")
+	case len(m.rows) == 0 && m.err == nil:
+		fmt.Fprintf(&b, "No %s found\n", kind)
+	case len(m.rows) > 0:
+		b.WriteString(m.renderTable(headers))
+	}
+
+	b.WriteString("\n")
+	switch {
+	case m.confirmDelete:
+		fmt.Fprintf(&b, "Delete tenant %s? (y/N)\n", m.rows[m.cursor].name)
+	case m.err != nil:
+		b.WriteString(errorStyle.Render(m.err.Error()) + "\n")
+	case m.status != "":
+		b.WriteString(m.status + "\n")
+	default:
+		b.WriteString("\n")
+	}
+
+	help := "↑/↓ move • enter open • esc back • r refresh • q quit"
+	if m.level == levelTenants {
+		help = "↑/↓ move • esc back • d delete • c save kubeconfig • s shell • r refresh • q quit"
+	}
+	b.WriteString(helpStyle.Render(help))
+	return b.String()
+}
+
+// renderTable renders the rows with aligned columns, scrolled to keep the cursor
+// visible in the terminal
+func (m Model) renderTable(headers []string) string {
+	widths := make([]int, len(headers))
+	for i, h := range headers {
+		widths[i] = lipgloss.Width(h)
+	}
+	for _, r := range m.rows {
+		for i, cell := range r.cells {
+			if w := lipgloss.Width(cell); i < len(widths) && w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+	line := func(cells []string) string {
+		var parts []string
+		for i, cell := range cells {
+			parts = append(parts, cell+strings.Repeat(" ", widths[i]-lipgloss.Width(cell)))
+		}
+		return strings.Join(parts, "  ")
+	}
+
+	// Leave room for the breadcrumb, header, status, and help lines
+	first, last := 0, len(m.rows)
+	if visible := m.height - 7; m.height > 0 && visible > 0 && len(m.rows) > visible {
+		first = m.cursor - visible/2
+		if first < 0 {
+			first = 0
+		}
+		if first+visible > len(m.rows) {
+			first = len(m.rows) - visible
+		}
+		last = first + visible
+	}
+
+	var b strings.Builder
+	b.WriteString(headerStyle.Render(line(headers)) + "\n")
+	for i := first; i < last; i++ {
+		text := line(m.rows[i].cells)
+		if i == m.cursor {
+			text = selectedStyle.Render(text)
+		}
+		b.WriteString(text + "\n")
+	}
+	return b.String()
+}
+
+var _ tea.Model = Model{}
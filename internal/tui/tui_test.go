@@ -0,0 +1,160 @@
+package tui
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"spacectl/internal/api"
+	"spacectl/internal/config"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newTestModel returns a model backed by a fake API with one organization, one
+// project, and two tenants
+func newTestModel(t *testing.T, deleted *[]string) Model {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v1/organizations":
+			w.Write([]byte(`[{"organization":{"id":"org-1","name":"acme"},"role":"owner","is_default":true}]`))
+		case "GET /api/v1/organizations/org-1/projects":
+			w.Write([]byte(`[{"id":"proj-1","organization_id":"org-1","name":"web"}]`))
+		case "GET /api/v1/projects/proj-1/tenants":
+			w.Write([]byte(`[{"id":"t-1","name":"dev","status":"Ready"},{"id":"t-2","name":"staging","status":"Provisioning"}]`))
+		case "GET /api/v1/tenants/t-2/kubeconfig":
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte("apiVersion: v1\nkind: Config\n"))
+		case "DELETE /api/v1/tenants/t-2":
+			*deleted = append(*deleted, "t-2")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := api.NewClient(server.URL, &config.Config{AccessToken: "token"}, false)
+	client.SetRetries(0)
+	return New(context.Background(), client, Options{KubeconfigDir: t.TempDir()})
+}
+
+// send feeds a message to the model and runs the resulting commands until they
+// produce no more messages
+func send(t *testing.T, m Model, msg tea.Msg) Model {
+	t.Helper()
+
+	next, cmd := m.Update(msg)
+	m = next.(Model)
+	return run(t, m, cmd)
+}
+
+func run(t *testing.T, m Model, cmd tea.Cmd) Model {
+	t.Helper()
+
+	if cmd == nil {
+		return m
+	}
+	switch msg := cmd().(type) {
+	case nil:
+		return m
+	case tea.BatchMsg:
+		for _, c := range msg {
+			m = run(t, m, c)
+		}
+		return m
+	default:
+		return send(t, m, msg)
+	}
+}
+
+func key(s string) tea.KeyMsg {
+	switch s {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	default:
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+	}
+}
+
+func TestNavigateToTenants(t *testing.T) {
+	var deleted []string
+	m := newTestModel(t, &deleted)
+	m = run(t, m, m.Init())
+
+	if view := m.View(); !strings.Contains(view, "acme") || !strings.Contains(view, "owner") {
+		t.Fatalf("expected the organization list, got:\n%s", view)
+	}
+
+	m = send(t, m, key("enter"))
+	if view := m.View(); !strings.Contains(view, "spacectl › acme › Projects") || !strings.Contains(view, "web") {
+		t.Fatalf("expected the project list, got:\n%s", view)
+	}
+
+	m = send(t, m, key("enter"))
+	view := m.View()
+	if !strings.Contains(view, "acme › web › Tenants") || !strings.Contains(view, "staging") || !strings.Contains(view, "Provisioning") {
+		t.Fatalf("expected the tenant list, got:\n%s", view)
+	}
+
+	m = send(t, m, key("esc"))
+	if m.level != levelProjects || m.rows[m.cursor].id != "proj-1" {
+		t.Errorf("expected to go back to the project that was open, got level %d cursor %d", m.level, m.cursor)
+	}
+}
+
+func TestDeleteTenantAsksForConfirmation(t *testing.T) {
+	var deleted []string
+	m := newTestModel(t, &deleted)
+	m = run(t, m, m.Init())
+	m = send(t, m, key("enter"))
+	m = send(t, m, key("enter"))
+	m = send(t, m, key("j"))
+
+	m = send(t, m, key("d"))
+	if !strings.Contains(m.View(), "Delete tenant staging? (y/N)") {
+		t.Fatalf("expected a confirmation prompt, got:\n%s", m.View())
+	}
+	m = send(t, m, key("n"))
+	if len(deleted) != 0 || m.status != "Delete canceled" {
+		t.Fatalf("expected the delete to be canceled, deleted %v", deleted)
+	}
+
+	m = send(t, m, key("d"))
+	m = send(t, m, key("y"))
+	if len(deleted) != 1 || m.status != "Tenant staging deleted" {
+		t.Errorf("expected the tenant to be deleted, deleted %v, status %q", deleted, m.status)
+	}
+}
+
+func TestSaveKubeconfig(t *testing.T) {
+	var deleted []string
+	m := newTestModel(t, &deleted)
+	m = run(t, m, m.Init())
+	m = send(t, m, key("enter"))
+	m = send(t, m, key("enter"))
+	m = send(t, m, key("j"))
+	m = send(t, m, key("c"))
+
+	path := filepath.Join(m.opts.KubeconfigDir, "staging.yaml")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected the kubeconfig to be saved: %v (status %q, err %v)", err, m.status, m.err)
+	}
+	if !strings.HasPrefix(string(data), "apiVersion: v1") {
+		t.Errorf("unexpected kubeconfig %q", data)
+	}
+	if m.status != "Kubeconfig saved to "+path {
+		t.Errorf("unexpected status %q", m.status)
+	}
+}
@@ -0,0 +1,152 @@
+// Package units parses and formats tenant compute and memory quotas in the
+// notation of Kubernetes resource quantities, such as "2", "2000m", "4Gi", or
+// "0.5Ti", so users do not have to guess the units of bare integers.
+//
+// The API takes compute in whole cores and memory in whole GiB, so quantities
+// that do not come to a whole number of those are rejected.
+package units
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// memoryUnits are the binary suffixes accepted for memory, in GiB
+var memoryUnits = map[string]*big.Rat{
+	"Ki": big.NewRat(1, 1<<20),
+	"Mi": big.NewRat(1, 1<<10),
+	"Gi": big.NewRat(1, 1),
+	"Ti": big.NewRat(1<<10, 1),
+	"Pi": big.NewRat(1<<20, 1),
+}
+
+// Cores is a compute quota in CPU cores. It is a pflag.Value, so flags accept
+// quantities such as "2" or "2000m".
+type Cores int
+
+// ParseCores parses a compute quantity such as "2" or "2000m" into cores
+func ParseCores(s string) (Cores, error) {
+	number, unit := split(s)
+	value, ok := new(big.Rat).SetString(number)
+	if !ok || (unit != "" && unit != "m") {
+		return 0, fmt.Errorf("invalid compute quantity %q: use cores, such as 2, or millicores, such as 2000m", s)
+	}
+	if unit == "m" {
+		value.Quo(value, big.NewRat(1000, 1))
+	}
+	if !value.IsInt() || value.Sign() < 0 {
+		return 0, fmt.Errorf("invalid compute quantity %q: it is %s cores, but tenants get a whole number of cores", s, decimal(value))
+	}
+	return Cores(value.Num().Int64()), nil
+}
+
+// String formats the quota as a plain number of cores
+func (c Cores) String() string {
+	return strconv.Itoa(int(c))
+}
+
+// Set parses a flag value
+func (c *Cores) Set(s string) error {
+	cores, err := ParseCores(s)
+	if err != nil {
+		return err
+	}
+	*c = cores
+	return nil
+}
+
+// Type names the flag value in help
+func (c *Cores) Type() string {
+	return "cores"
+}
+
+// MarshalYAML writes the quota as a plain number
+func (c Cores) MarshalYAML() (interface{}, error) {
+	return int(c), nil
+}
+
+// UnmarshalYAML accepts a number or a quantity string
+func (c *Cores) UnmarshalYAML(node *yaml.Node) error {
+	return c.Set(node.Value)
+}
+
+// GiB is a memory quota in GiB. It is a pflag.Value, so flags accept
+// quantities such as "4Gi" or "0.5Ti"; bare numbers are GiB.
+type GiB int
+
+// ParseMemory parses a memory quantity such as "4Gi", "0.5Ti", or "4" into GiB
+func ParseMemory(s string) (GiB, error) {
+	number, unit := split(s)
+	value, ok := new(big.Rat).SetString(number)
+	scale, known := memoryUnits[unit]
+	if unit == "" {
+		scale, known = memoryUnits["Gi"], true
+	}
+	if !ok || !known {
+		return 0, fmt.Errorf("invalid memory quantity %q: use a number with a binary unit, such as 4Gi or 0.5Ti", s)
+	}
+	value.Mul(value, scale)
+	if !value.IsInt() || value.Sign() < 0 {
+		return 0, fmt.Errorf("invalid memory quantity %q: it is %sGi, but tenants get a whole number of Gi", s, decimal(value))
+	}
+	return GiB(value.Num().Int64()), nil
+}
+
+// String formats the quota in Gi, or in Ti from 1Ti when that is shorter, e.g.
+// "4Gi", "1Ti", or "1.5Ti"
+func (g GiB) String() string {
+	if g == 0 {
+		return "0"
+	}
+	if g >= 1024 && (g*100)%1024 == 0 {
+		return strconv.FormatFloat(float64(g)/1024, 'f', -1, 64) + "Ti"
+	}
+	return strconv.Itoa(int(g)) + "Gi"
+}
+
+// Set parses a flag value
+func (g *GiB) Set(s string) error {
+	memory, err := ParseMemory(s)
+	if err != nil {
+		return err
+	}
+	*g = memory
+	return nil
+}
+
+// Type names the flag value in help
+func (g *GiB) Type() string {
+	return "quantity"
+}
+
+// MarshalYAML writes the quota as a quantity such as "4Gi"
+func (g GiB) MarshalYAML() (interface{}, error) {
+	return g.String(), nil
+}
+
+// UnmarshalYAML accepts a quantity string or a number of GiB
+func (g *GiB) UnmarshalYAML(node *yaml.Node) error {
+	return g.Set(node.Value)
+}
+
+// decimal formats a fraction with up to three decimals and no trailing zeros
+func decimal(r *big.Rat) string {
+	s := r.FloatString(3)
+	return strings.TrimRight(strings.TrimRight(s, "0"), ".")
+}
+
+// split separates the number of a quantity from its unit suffix
+func split(s string) (number, unit string) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if i < 0 {
+		return s, ""
+	}
+	return s[:i], s[i:]
+}
@@ -0,0 +1,91 @@
+package units
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestParseMemory(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    GiB
+		wantErr string
+	}{
+		{in: "4", want: 4},
+		{in: "4Gi", want: 4},
+		{in: "0.5Ti", want: 512},
+		{in: "2048Mi", want: 2},
+		{in: "1536Mi", wantErr: "it is 1.5Gi"},
+		{in: "4G", wantErr: "binary unit"},
+		{in: "lots", wantErr: "binary unit"},
+		{in: "", wantErr: "binary unit"},
+	}
+	for _, tt := range tests {
+		got, err := ParseMemory(tt.in)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseMemory(%q) error = %v, want it to contain %q", tt.in, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseMemory(%q) = %d, %v, want %d", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseCores(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Cores
+		wantErr string
+	}{
+		{in: "2", want: 2},
+		{in: "2000m", want: 2},
+		{in: "1.0", want: 1},
+		{in: "500m", wantErr: "it is 0.5 cores"},
+		{in: "2Gi", wantErr: "millicores"},
+	}
+	for _, tt := range tests {
+		got, err := ParseCores(tt.in)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseCores(%q) error = %v, want it to contain %q", tt.in, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseCores(%q) = %d, %v, want %d", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestGiBString(t *testing.T) {
+	for in, want := range map[GiB]string{0: "0", 4: "4Gi", 1024: "1Ti", 1536: "1.5Ti", 1000: "1000Gi", 2100: "2100Gi"} {
+		if got := in.String(); got != want {
+			t.Errorf("GiB(%d).String() = %q, want %q", int(in), got, want)
+		}
+	}
+}
+
+func TestYAML(t *testing.T) {
+	var quotas struct {
+		Compute Cores `yaml:"compute"`
+		Memory  GiB   `yaml:"memory"`
+	}
+	if err := yaml.Unmarshal([]byte("compute: 4000m\nmemory: 1Ti\n"), &quotas); err != nil {
+		t.Fatal(err)
+	}
+	if quotas.Compute != 4 || quotas.Memory != 1024 {
+		t.Errorf("unexpected quotas %+v", quotas)
+	}
+	data, err := yaml.Marshal(quotas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "compute: 4\nmemory: 1Ti\n" {
+		t.Errorf("unexpected YAML %q", data)
+	}
+}
@@ -1,5 +1,105 @@
 package version
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
 // Version holds the build version of spacectl.
 // It is overridden at build time via -ldflags.
 var Version = "v0.1.0-0000"
+
+// ReleaseURL is the GitHub API endpoint describing the latest spacectl release
+var ReleaseURL = "https://api.github.com/repos/kubespaces-io/spacectl/releases/latest"
+
+// Compare compares two versions of the form vMAJOR.MINOR.PATCH, returning -1, 0,
+// or +1. Anything after a dash, such as the build counter in v0.2.0-0042, is
+// ignored, so builds of the same release compare equal.
+func Compare(a, b string) (int, error) {
+	pa, err := parse(a)
+	if err != nil {
+		return 0, err
+	}
+	pb, err := parse(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := range pa {
+		switch {
+		case pa[i] < pb[i]:
+			return -1, nil
+		case pa[i] > pb[i]:
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// MinorStep returns how many minor versions to is above from, such as 1 from
+// 1.29.4 to 1.30, or a negative number when to is older. Versions of different
+// major versions have no minor step and return an error.
+func MinorStep(from, to string) (int, error) {
+	pf, err := parse(from)
+	if err != nil {
+		return 0, err
+	}
+	pt, err := parse(to)
+	if err != nil {
+		return 0, err
+	}
+	if pf[0] != pt[0] {
+		return 0, fmt.Errorf("versions %q and %q differ in major version", from, to)
+	}
+	return pt[1] - pf[1], nil
+}
+
+// parse returns the major, minor, and patch numbers of a version
+func parse(v string) ([3]int, error) {
+	var parts [3]int
+	core, _, _ := strings.Cut(strings.TrimPrefix(strings.TrimSpace(v), "v"), "-")
+	fields := strings.Split(core, ".")
+	if len(fields) == 0 || len(fields) > 3 {
+		return parts, fmt.Errorf("invalid version %q", v)
+	}
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return parts, fmt.Errorf("invalid version %q", v)
+		}
+		parts[i] = n
+	}
+	return parts, nil
+}
+
+// LatestRelease returns the version tag of the latest spacectl release
+func LatestRelease(ctx context.Context, client *http.Client) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ReleaseURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch the latest release: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to fetch the latest release: %s", resp.Status)
+	}
+
+	var release struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return "", fmt.Errorf("failed to decode the latest release: %w", err)
+	}
+	if release.TagName == "" {
+		return "", fmt.Errorf("the latest release has no version tag")
+	}
+	return release.TagName, nil
+}
@@ -0,0 +1,81 @@
+package version
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"v0.2.0", "v0.2.0", 0},
+		{"v0.2.0-0042", "v0.2.0", 0},
+		{"v0.1.9", "v0.2.0", -1},
+		{"v1.0.0", "v0.10.0", 1},
+		{"0.10.0", "v0.9.3", 1},
+		{"v1.2", "v1.2.0", 0},
+	}
+	for _, tt := range tests {
+		got, err := Compare(tt.a, tt.b)
+		if err != nil {
+			t.Fatalf("Compare(%q, %q) returned error: %v", tt.a, tt.b, err)
+		}
+		if got != tt.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	for _, v := range []string{"", "dev", "v1.x.0", "v1.2.3.4"} {
+		if _, err := Compare(v, "v1.0.0"); err == nil {
+			t.Errorf("expected an error comparing %q", v)
+		}
+	}
+}
+
+func TestMinorStep(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     int
+	}{
+		{"1.29", "1.30", 1},
+		{"1.29.4", "v1.31.0", 2},
+		{"1.30.1", "1.30.5", 0},
+		{"1.30", "1.28", -2},
+	}
+	for _, tt := range tests {
+		got, err := MinorStep(tt.from, tt.to)
+		if err != nil {
+			t.Fatalf("MinorStep(%q, %q) returned error: %v", tt.from, tt.to, err)
+		}
+		if got != tt.want {
+			t.Errorf("MinorStep(%q, %q) = %d, want %d", tt.from, tt.to, got, tt.want)
+		}
+	}
+
+	if _, err := MinorStep("1.30", "2.0"); err == nil {
+		t.Error("expected an error for different major versions")
+	}
+}
+
+func TestLatestRelease(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"tag_name": "v0.3.1", "name": "spacectl v0.3.1"}`))
+	}))
+	defer server.Close()
+
+	old := ReleaseURL
+	ReleaseURL = server.URL
+	defer func() { ReleaseURL = old }()
+
+	got, err := LatestRelease(context.Background(), server.Client())
+	if err != nil {
+		t.Fatalf("LatestRelease returned error: %v", err)
+	}
+	if got != "v0.3.1" {
+		t.Fatalf("expected v0.3.1, got %q", got)
+	}
+}
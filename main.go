@@ -2,12 +2,12 @@ package main
 
 import (
 	"os"
+
 	"spacectl/cmd"
 )
 
 func main() {
 	if err := cmd.Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(cmd.ExitCode(err))
 	}
 }
-
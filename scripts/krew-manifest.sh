@@ -0,0 +1,74 @@
+#!/bin/bash
+
+# Generate the krew plugin manifest for "kubectl spaces"
+# Usage: krew-manifest.sh <version> <dist-dir>
+#
+# Expects the release archives built by "make krew" in <dist-dir>, named
+# kubectl-spaces_<os>_<arch>.tar.gz, and writes <dist-dir>/spaces.yaml with
+# their download URLs and checksums. Upload the archives to the GitHub release
+# of <version> and submit spaces.yaml to the krew-index repository.
+
+set -e
+
+VERSION="$1"
+DIST_DIR="$2"
+REPO_URL="https://github.com/kubespaces-io/spacectl"
+
+if [ -z "$VERSION" ] || [ -z "$DIST_DIR" ]; then
+    echo "Usage: $0 <version> <dist-dir>" >&2
+    exit 1
+fi
+
+sha256() {
+    if command -v sha256sum &> /dev/null; then
+        sha256sum "$1" | cut -d' ' -f1
+    else
+        shasum -a 256 "$1" | cut -d' ' -f1
+    fi
+}
+
+MANIFEST="$DIST_DIR/spaces.yaml"
+
+cat > "$MANIFEST" <<YAML
+apiVersion: krew.googlecontainertools.github.com/v1alpha2
+kind: Plugin
+metadata:
+  name: spaces
+spec:
+  version: ${VERSION}
+  homepage: ${REPO_URL}
+  shortDescription: Manage Kubespaces organizations, projects, and tenants
+  description: |
+    Manage Kubespaces organizations, projects, and tenants from kubectl.
+    This is spacectl packaged as a kubectl plugin: every spacectl command
+    is available as "kubectl spaces <command>", e.g. "kubectl spaces tenant
+    list" or "kubectl spaces tenant kubeconfig <name>".
+  platforms:
+YAML
+
+for archive in "$DIST_DIR"/kubectl-spaces_*.tar.gz; do
+    if [ ! -f "$archive" ]; then
+        echo "Error: no archives found in $DIST_DIR (run make krew)" >&2
+        exit 1
+    fi
+    name=$(basename "$archive" .tar.gz)
+    platform=${name#kubectl-spaces_}
+    os=${platform%_*}
+    arch=${platform#*_}
+    bin="kubectl-spaces"
+    if [ "$os" = "windows" ]; then
+        bin="kubectl-spaces.exe"
+    fi
+
+    cat >> "$MANIFEST" <<YAML
+  - selector:
+      matchLabels:
+        os: ${os}
+        arch: ${arch}
+    uri: ${REPO_URL}/releases/download/${VERSION}/$(basename "$archive")
+    sha256: $(sha256 "$archive")
+    bin: ${bin}
+YAML
+done
+
+echo "Wrote $MANIFEST"